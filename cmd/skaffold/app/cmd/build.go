@@ -49,6 +49,8 @@ func NewCmdBuild() *cobra.Command {
 		WithExample("Build artifacts whose image name contains <db>", "build -b <db>").
 		WithExample("Quietly build artifacts and output the image names as json", "build -q > build_result.json").
 		WithExample("Build the artifacts and then deploy them", "build -q | skaffold deploy --build-artifacts -").
+		WithExample("Quietly build artifacts and output the tag of the first image", "build -q -o '{{(index .Builds 0).Tag}}'").
+		WithExample("Quietly build artifacts and output the digest of each image", "build -q -o '{{range .Builds}}{{.ImageName}} {{.Digest}}{{println}}{{end}}'").
 		WithExample("Print the final image names", "build -q --dry-run").
 		WithCommonFlags().
 		WithFlags(func(f *pflag.FlagSet) {
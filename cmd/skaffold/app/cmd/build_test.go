@@ -89,6 +89,12 @@ func TestQuietFlag(t *testing.T) {
 			expectedOutput: []byte("gcr.io/skaffold/example -> test\n"),
 			shouldErr:      false,
 		},
+		{
+			description:    "quiet flag print the tag of the first image",
+			template:       "{{(index .Builds 0).Tag}}",
+			expectedOutput: []byte("test"),
+			shouldErr:      false,
+		},
 		{
 			description:    "build errors out when incorrect template specified",
 			template:       "{{.Incorrect}}",
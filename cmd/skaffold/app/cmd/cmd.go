@@ -31,8 +31,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/survey"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/update"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 )
@@ -42,9 +44,10 @@ var (
 	v                 string
 	defaultColor      int
 	forceColors       bool
+	outputFormat      string
 	overwrite         bool
-	interactive       bool
 	shutdownAPIServer func() error
+	shutdownTracing   func() error
 )
 
 // Annotation for commands that should allow post execution housekeeping messages like updates and surveys
@@ -70,11 +73,22 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 
 			opts.Command = cmd.Use
 
-			color.SetupColors(out, defaultColor, forceColors)
-			cmd.Root().SetOutput(out)
+			cmdOut, cmdErr := out, err
+			switch outputFormat {
+			case "text":
+			case "json":
+				cmdOut = output.NewJSONWriter(out)
+				cmdErr = output.NewJSONWriter(err)
+			default:
+				return fmt.Errorf("invalid output format %q. Valid values are 'text' or 'json'", outputFormat)
+			}
+
+			color.SetupColors(cmdOut, defaultColor, forceColors)
+			cmd.Root().SetOut(cmdOut)
+			cmd.Root().SetErr(cmdErr)
 
 			// Setup logs
-			if err := setUpLogs(err, v); err != nil {
+			if err := setUpLogs(cmdErr, v); err != nil {
 				return err
 			}
 
@@ -85,6 +99,9 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 			}
 			shutdownAPIServer = shutdown
 
+			// Export traces
+			shutdownTracing = tracing.Initialize()
+
 			// Print version
 			version := version.Get()
 			logrus.Infof("Skaffold %+v", version)
@@ -93,7 +110,7 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 				return nil
 			}
 			switch {
-			case !interactive:
+			case !opts.Interactive:
 				logrus.Debugf("Update check and survey prompt disabled in non-interactive mode")
 			case quietFlag:
 				logrus.Debugf("Update check and survey prompt disabled in quiet mode")
@@ -147,6 +164,7 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 				NewCmdDeploy(),
 				NewCmdDelete(),
 				NewCmdRender(),
+				NewCmdDiff(),
 			},
 		},
 		{
@@ -169,6 +187,7 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 	rootCmd.AddCommand(NewCmdCredits())
 	rootCmd.AddCommand(NewCmdSchema())
 	rootCmd.AddCommand(NewCmdFilter())
+	rootCmd.AddCommand(NewCmdTrigger())
 
 	rootCmd.AddCommand(NewCmdGeneratePipeline())
 	rootCmd.AddCommand(NewCmdSurvey())
@@ -177,8 +196,10 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 	rootCmd.PersistentFlags().StringVarP(&v, "verbosity", "v", constants.DefaultLogLevel.String(), "Log level (debug, info, warn, error, fatal, panic)")
 	rootCmd.PersistentFlags().IntVar(&defaultColor, "color", int(color.DefaultColorCode), "Specify the default output color in ANSI escape codes")
 	rootCmd.PersistentFlags().BoolVar(&forceColors, "force-colors", false, "Always print color codes (hidden)")
-	rootCmd.PersistentFlags().BoolVar(&interactive, "interactive", true, "Allow user prompts for more information")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output-format", "text", "Format of the output: `text` or `json`, that wraps each line in a record with its timestamp, phase and artifact")
+	rootCmd.PersistentFlags().BoolVar(&opts.Interactive, "interactive", true, "Allow user prompts for more information and keyboard controls in dev mode")
 	rootCmd.PersistentFlags().BoolVar(&update.EnableCheck, "update-check", true, "Check for a more recent version of Skaffold")
+	rootCmd.PersistentFlags().StringVar(&update.Channel, "update-channel", "", "Release channel of the update check: `stable`, or `latest` that includes pre-releases. Defaults to the `update-channel` global config, or `stable`")
 	rootCmd.PersistentFlags().MarkHidden("force-colors")
 
 	setFlagsFromEnvVariables(rootCmd)
@@ -18,13 +18,17 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 )
 
 // Builder is used to build cobra commands.
@@ -94,13 +98,9 @@ func (b *builder) Hidden() Builder {
 func (b *builder) ExactArgs(argCount int, action func(context.Context, io.Writer, []string) error) *cobra.Command {
 	b.cmd.Args = cobra.ExactArgs(argCount)
 	b.cmd.RunE = func(_ *cobra.Command, args []string) error {
-		err := handleWellKnownErrors(action(b.cmd.Context(), b.cmd.OutOrStdout(), args))
-		// clean up server at end of the execution since post run hooks are only executed if
-		// RunE is successful
-		if shutdownAPIServer != nil {
-			shutdownAPIServer()
-		}
-		return err
+		return b.run(func(ctx context.Context) error {
+			return action(ctx, b.cmd.OutOrStdout(), args)
+		})
 	}
 	return &b.cmd
 }
@@ -108,17 +108,68 @@ func (b *builder) ExactArgs(argCount int, action func(context.Context, io.Writer
 func (b *builder) NoArgs(action func(context.Context, io.Writer) error) *cobra.Command {
 	b.cmd.Args = cobra.NoArgs
 	b.cmd.RunE = func(*cobra.Command, []string) error {
-		err := handleWellKnownErrors(action(b.cmd.Context(), b.cmd.OutOrStdout()))
-		// clean up server at end of the execution since post run hooks are only executed if
-		// RunE is successful
-		if shutdownAPIServer != nil {
-			shutdownAPIServer()
-		}
-		return err
+		return b.run(func(ctx context.Context) error {
+			return action(ctx, b.cmd.OutOrStdout())
+		})
 	}
 	return &b.cmd
 }
 
+// run traces the action of the command and cleans up at the end of the execution.
+func (b *builder) run(action func(context.Context) error) error {
+	ctx := b.cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, endTrace := tracing.StartSpan(ctx, "skaffold "+b.cmd.Name(), nil)
+	err := action(ctx)
+	endTrace(err)
+	flushOutput(b.cmd.OutOrStdout(), b.cmd.ErrOrStderr())
+	if err != nil && !errors.Is(err, context.Canceled) {
+		writeErrorRecord(b.cmd.OutOrStdout(), err)
+	}
+	err = handleWellKnownErrors(err)
+
+	// clean up server and export the remaining traces at end of the execution since post run hooks are only executed if
+	// RunE is successful
+	if shutdownAPIServer != nil {
+		shutdownAPIServer()
+	}
+	if shutdownTracing != nil {
+		if err := shutdownTracing(); err != nil {
+			logrus.Warnln("exporting traces:", err)
+		}
+	}
+	return err
+}
+
+// flushOutput prints the last lines of output that were not terminated by a newline.
+func flushOutput(writers ...io.Writer) {
+	for _, w := range writers {
+		if err := output.Flush(w); err != nil {
+			logrus.Debugln("flushing output:", err)
+		}
+	}
+}
+
+// writeErrorRecord prints the error that ended the command, with its code and suggestions, as a final JSON record.
+func writeErrorRecord(out io.Writer, err error) {
+	record := sErrors.NewRecord(err)
+	if err := output.WriteError(out, output.Record{
+		Phase:    string(record.Phase),
+		Artifact: record.Artifact,
+		Payload:  record.Message,
+		Error: &output.Error{
+			Code:        record.Code.String(),
+			ExitCode:    record.ExitCode,
+			Suggestions: record.Suggestions,
+		},
+	}); err != nil {
+		logrus.Debugln("printing error record:", err)
+	}
+}
+
 func handleWellKnownErrors(err error) error {
 	if err == nil {
 		return err
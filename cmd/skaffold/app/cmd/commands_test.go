@@ -26,6 +26,8 @@ import (
 
 	"github.com/spf13/pflag"
 
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -80,6 +82,34 @@ func TestNewCmdError(t *testing.T) {
 	testutil.CheckErrorAndDeepEqual(t, true, err, "expected error", err.Error())
 }
 
+func TestNewCmdErrorRecord(t *testing.T) {
+	var buf bytes.Buffer
+
+	cmd := NewCmd("").NoArgs(func(ctx context.Context, out io.Writer) error {
+		return sErrors.WithExitCode(sErrors.BuildExitCode, sErrors.WithArtifact("image", errors.New("expected error")))
+	})
+	cmd.SetOutput(output.NewJSONWriter(&buf))
+
+	err := cmd.RunE(nil, nil)
+
+	testutil.CheckError(t, true, err)
+	testutil.CheckContains(t, `"phase":"Build","artifact":"image","payload":"expected error","error":{"code":"BUILD_UNKNOWN","exitCode":111}}`, buf.String())
+}
+
+func TestNewCmdErrorRecordText(t *testing.T) {
+	var buf bytes.Buffer
+
+	cmd := NewCmd("").NoArgs(func(ctx context.Context, out io.Writer) error {
+		return errors.New("expected error")
+	})
+	cmd.SetOutput(&buf)
+
+	err := cmd.RunE(nil, nil)
+
+	testutil.CheckError(t, true, err)
+	testutil.CheckDeepEqual(t, "", buf.String())
+}
+
 func TestNewCmdOutput(t *testing.T) {
 	var buf bytes.Buffer
 
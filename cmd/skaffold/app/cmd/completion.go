@@ -20,13 +20,21 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 const (
 	longDescription = `
-	Outputs shell completion for the given shell (bash or zsh)
+	Outputs shell completion for the given shell (bash, zsh or fish)
+
+	Besides commands and flags, profile names, module names and artifact image names
+	are completed from the Skaffold config in the current directory, or the one passed with --filename.
 
 	This depends on the bash-completion binary.  Example installation instructions:
 	OS X:
@@ -35,6 +43,7 @@ const (
 		$ skaffold completion bash > ~/.skaffold-completion  # for bash users
 		$ skaffold completion zsh > ~/.skaffold-completion   # for zsh users
 		$ source ~/.skaffold-completion
+		$ skaffold completion fish > ~/.config/fish/completions/skaffold.fish  # for fish users
 	Ubuntu:
 		$ apt-get install bash-completion
 		$ source /etc/bash-completion
@@ -44,7 +53,40 @@ const (
 	Additionally, you may want to output the completion to a file and source in your .bashrc
 `
 
-	zshCompdef = "\ncompdef _skaffold skaffold\n"
+	// zshCompletion asks skaffold for the completions of the words typed so far.
+	// The last line printed by __complete is the directive, a bit map of
+	// 1: error, 2: no space after the completion, 4: no file completion.
+	zshCompletion = `#compdef skaffold
+
+_skaffold() {
+  local -a completions
+  local out directive line comp
+
+  out=$(${words[1]} __complete "${(@)words[2,CURRENT]}" 2>/dev/null) || return
+  directive=${${(f)out}[-1]#:}
+  for line in "${(@)${(f)out}[1,-2]}"; do
+    comp=${${line%%$'\t'*}//:/\\:}
+    if [[ $line == *$'\t'* ]]; then
+      completions+=("${comp}:${line#*$'\t'}")
+    else
+      completions+=("${comp}")
+    fi
+  done
+
+  (( directive & 1 )) && return
+  if (( ${#completions} )); then
+    if (( directive & 2 )); then
+      _describe 'completions' completions -S ''
+    else
+      _describe 'completions' completions
+    fi
+  elif (( ! (directive & 4) )); then
+    _files
+  fi
+}
+
+compdef _skaffold skaffold
+`
 )
 
 func completion(cmd *cobra.Command, args []string) {
@@ -53,6 +95,8 @@ func completion(cmd *cobra.Command, args []string) {
 		rootCmd(cmd).GenBashCompletion(os.Stdout)
 	case "zsh":
 		runCompletionZsh(cmd, os.Stdout)
+	case "fish":
+		rootCmd(cmd).GenFishCompletion(os.Stdout, true)
 	}
 }
 
@@ -66,16 +110,17 @@ func NewCmdCompletion() *cobra.Command {
 			}
 			return cobra.OnlyValidArgs(cmd, args)
 		},
-		ValidArgs: []string{"bash", "zsh"},
-		Short:     "Output shell completion for the given shell (bash or zsh)",
+		ValidArgs: []string{"bash", "zsh", "fish"},
+		Short:     "Output shell completion for the given shell (bash, zsh or fish)",
 		Long:      longDescription,
 		Run:       completion,
 	}
 }
 
-func runCompletionZsh(cmd *cobra.Command, out io.Writer) {
-	rootCmd(cmd).GenZshCompletion(out)
-	io.WriteString(out, zshCompdef)
+// runCompletionZsh outputs a zsh completion that delegates to `skaffold __complete`,
+// since the zsh completion generated by cobra doesn't support dynamic values.
+func runCompletionZsh(_ *cobra.Command, out io.Writer) {
+	io.WriteString(out, zshCompletion)
 }
 
 func rootCmd(cmd *cobra.Command) *cobra.Command {
@@ -85,3 +130,58 @@ func rootCmd(cmd *cobra.Command) *cobra.Command {
 	}
 	return parent
 }
+
+// completeProfiles completes the names of the profiles of the Skaffold config.
+func completeProfiles(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFromConfigs(toComplete, func(configs []*latest.SkaffoldConfig) []string {
+		var names []string
+		for _, p := range configs[0].Profiles {
+			names = append(names, p.Name)
+		}
+		return names
+	})
+}
+
+// completeModules completes the names of the configs required by the Skaffold config.
+func completeModules(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFromConfigs(toComplete, func(configs []*latest.SkaffoldConfig) []string {
+		var names []string
+		for _, c := range configs[1:] {
+			if c.Metadata.Name != "" {
+				names = append(names, c.Metadata.Name)
+			}
+		}
+		return names
+	})
+}
+
+// completeImages completes the image names of the artifacts of the Skaffold config and of the configs it requires.
+func completeImages(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFromConfigs(toComplete, func(configs []*latest.SkaffoldConfig) []string {
+		var images []string
+		for _, c := range configs {
+			for _, a := range c.Build.Artifacts {
+				images = append(images, a.ImageName)
+			}
+		}
+		return images
+	})
+}
+
+func completeFromConfigs(toComplete string, values func([]*latest.SkaffoldConfig) []string) ([]string, cobra.ShellCompDirective) {
+	configs, err := schema.AllConfigs(opts.ConfigurationFile)
+	if err != nil {
+		logrus.Debugln("parsing configs for completion:", err)
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var completions []string
+	seen := map[string]bool{}
+	for _, v := range values(configs) {
+		if strings.HasPrefix(v, toComplete) && !seen[v] {
+			seen[v] = true
+			completions = append(completions, v)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
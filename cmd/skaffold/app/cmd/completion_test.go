@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCompleteFromConfigs(t *testing.T) {
+	files := map[string]string{
+		"skaffold.yaml": fmt.Sprintf(`apiVersion: %s
+kind: Config
+build:
+  artifacts:
+  - image: web
+  - image: worker
+requires:
+- path: backend
+profiles:
+- name: prod
+- name: dev-cluster
+`, latest.Version),
+		"backend/skaffold.yaml": fmt.Sprintf(`apiVersion: %s
+kind: Config
+metadata:
+  name: backend
+build:
+  artifacts:
+  - image: api
+  - image: web
+`, latest.Version),
+	}
+
+	tests := []struct {
+		description string
+		complete    func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective)
+		toComplete  string
+		expected    []string
+	}{
+		{
+			description: "profiles",
+			complete:    completeProfiles,
+			expected:    []string{"prod", "dev-cluster"},
+		},
+		{
+			description: "profiles with prefix",
+			complete:    completeProfiles,
+			toComplete:  "de",
+			expected:    []string{"dev-cluster"},
+		},
+		{
+			description: "modules",
+			complete:    completeModules,
+			expected:    []string{"backend"},
+		},
+		{
+			description: "images of all the configs",
+			complete:    completeImages,
+			expected:    []string{"web", "worker", "api"},
+		},
+		{
+			description: "images with prefix",
+			complete:    completeImages,
+			toComplete:  "w",
+			expected:    []string{"web", "worker"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().WriteFiles(files).Chdir()
+			t.Override(&opts.ConfigurationFile, "skaffold.yaml")
+
+			completions, directive := test.complete(nil, nil, test.toComplete)
+
+			t.CheckDeepEqual(test.expected, completions)
+			t.CheckDeepEqual(cobra.ShellCompDirectiveNoFileComp, directive)
+		})
+	}
+}
+
+func TestCompleteFromConfigsError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().Chdir()
+		t.Override(&opts.ConfigurationFile, "skaffold.yaml")
+
+		completions, directive := completeProfiles(nil, nil, "")
+
+		t.CheckEmpty(completions)
+		t.CheckDeepEqual(cobra.ShellCompDirectiveError, directive)
+	})
+}
+
+func TestCompletionZsh(t *testing.T) {
+	var buf bytes.Buffer
+
+	runCompletionZsh(nil, &buf)
+
+	testutil.CheckContains(t, "__complete", buf.String())
+	testutil.CheckContains(t, "compdef _skaffold skaffold", buf.String())
+}
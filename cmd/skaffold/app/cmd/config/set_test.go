@@ -89,6 +89,34 @@ func TestSetAndUnsetConfig(t *testing.T) {
 			value:          "not-a-bool",
 			expectedSetCfg: &config.GlobalConfig{},
 		},
+		{
+			description: "set watch poll interval",
+			key:         "watch-poll-interval",
+			value:       "500",
+			kubecontext: "this_is_a_context",
+			expectedSetCfg: &config.GlobalConfig{
+				ContextConfigs: []*config.ContextConfig{
+					{
+						Kubecontext:       "this_is_a_context",
+						WatchPollInterval: util.IntPtr(500),
+					},
+				},
+			},
+			expectedUnsetCfg: &config.GlobalConfig{
+				ContextConfigs: []*config.ContextConfig{
+					{
+						Kubecontext: "this_is_a_context",
+					},
+				},
+			},
+		},
+		{
+			description:    "set invalid watch poll interval",
+			key:            "watch-poll-interval",
+			shouldErr:      true,
+			value:          "not-an-int",
+			expectedSetCfg: &config.GlobalConfig{},
+		},
 		{
 			description:    "set fake value",
 			key:            "not_a_real_value",
@@ -162,6 +190,20 @@ func TestSetAndUnsetConfig(t *testing.T) {
 				ContextConfigs: []*config.ContextConfig{},
 			},
 		},
+		{
+			description: "set global update-channel",
+			key:         "update-channel",
+			value:       "latest",
+			global:      true,
+			expectedSetCfg: &config.GlobalConfig{
+				Global:         &config.ContextConfig{UpdateChannel: "latest"},
+				ContextConfigs: []*config.ContextConfig{},
+			},
+			expectedUnsetCfg: &config.GlobalConfig{
+				Global:         &config.ContextConfig{},
+				ContextConfigs: []*config.ContextConfig{},
+			},
+		},
 		{
 			description: "set global survey disable prompt",
 			key:         "disable-prompt",
@@ -47,7 +47,7 @@ func NewCmdDeploy() *cobra.Command {
 		WithExample("Deploy without first rendering the manifests", "deploy --skip-render").
 		WithCommonFlags().
 		WithFlags(func(f *pflag.FlagSet) {
-			f.VarP(&preBuiltImages, "images", "i", "A list of pre-built images to deploy")
+			f.VarP(&preBuiltImages, "images", "i", "A list of pre-built images to deploy, given as `image` or `artifact=image`")
 			f.VarP(&deployFromBuildOutputFile, "build-artifacts", "a", "File containing build result from a previous 'skaffold build --file-output'")
 			f.BoolVar(&opts.SkipRender, "skip-render", false, "Don't render the manifests, just deploy them")
 		}).
@@ -42,18 +42,14 @@ func NewCmdDev() *cobra.Command {
 
 func runDev(ctx context.Context, out io.Writer) error {
 	prune := func() {}
-	if opts.Prune() {
-		defer func() {
-			prune()
-		}()
-	}
+	defer func() {
+		prune()
+	}()
 
 	cleanup := func() {}
-	if opts.Cleanup {
-		defer func() {
-			cleanup()
-		}()
-	}
+	defer func() {
+		cleanup()
+	}()
 
 	for {
 		select {
@@ -63,7 +59,15 @@ func runDev(ctx context.Context, out io.Writer) error {
 			err := withRunner(ctx, func(r runner.Runner, config *latest.SkaffoldConfig) error {
 				err := r.Dev(ctx, out, config.Build.Artifacts)
 
-				if r.HasDeployed() {
+				// The run context holds the exit options resolved for the runner's kube context.
+				exitOpts := r.RunContext().Opts
+
+				if errors.Is(err, runner.ErrorQuitWithoutCleanup) {
+					exitOpts.Cleanup = false
+					exitOpts.NoPrune = true
+				}
+
+				if r.HasDeployed() && exitOpts.Cleanup {
 					cleanup = func() {
 						if err := r.Cleanup(context.Background(), out); err != nil {
 							logrus.Warnln("deployer cleanup:", err)
@@ -71,7 +75,7 @@ func runDev(ctx context.Context, out io.Writer) error {
 					}
 				}
 
-				if r.HasBuilt() {
+				if r.HasBuilt() && exitOpts.Prune() {
 					prune = func() {
 						if err := r.Prune(context.Background(), out); err != nil {
 							logrus.Warnln("builder cleanup:", err)
@@ -81,6 +85,9 @@ func runDev(ctx context.Context, out io.Writer) error {
 
 				return err
 			})
+			if errors.Is(err, runner.ErrorQuit) || errors.Is(err, runner.ErrorQuitWithoutCleanup) {
+				return nil
+			}
 			if err != nil {
 				if !errors.Is(err, runner.ErrorConfigurationChanged) {
 					return err
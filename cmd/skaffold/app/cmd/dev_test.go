@@ -24,6 +24,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -33,6 +34,7 @@ type mockDevRunner struct {
 	hasBuilt    bool
 	hasDeployed bool
 	errDev      error
+	opts        config.SkaffoldOptions
 	calls       []string
 }
 
@@ -61,12 +63,19 @@ func (r *mockDevRunner) Cleanup(context.Context, io.Writer) error {
 	return nil
 }
 
+func (r *mockDevRunner) RunContext() *runcontext.RunContext {
+	return &runcontext.RunContext{Opts: r.opts}
+}
+
 func TestDoDev(t *testing.T) {
 	tests := []struct {
 		description   string
 		hasBuilt      bool
 		hasDeployed   bool
+		opts          *config.SkaffoldOptions
+		errDev        error
 		expectedCalls []string
+		expectedErr   error
 	}{
 		{
 			description:   "cleanup and then prune",
@@ -86,27 +95,62 @@ func TestDoDev(t *testing.T) {
 			hasDeployed:   false,
 			expectedCalls: []string{"Dev", "HasDeployed", "HasBuilt"},
 		},
+		{
+			description:   "keep deployments",
+			hasBuilt:      true,
+			hasDeployed:   true,
+			opts:          &config.SkaffoldOptions{Cleanup: false},
+			expectedCalls: []string{"Dev", "HasDeployed", "HasBuilt", "Prune"},
+		},
+		{
+			description:   "keep images",
+			hasBuilt:      true,
+			hasDeployed:   true,
+			opts:          &config.SkaffoldOptions{Cleanup: true, NoPrune: true},
+			expectedCalls: []string{"Dev", "HasDeployed", "HasBuilt", "Cleanup"},
+		},
+		{
+			description:   "quit with a key",
+			hasBuilt:      true,
+			hasDeployed:   true,
+			errDev:        runner.ErrorQuit,
+			expectedCalls: []string{"Dev", "HasDeployed", "HasBuilt", "Cleanup", "Prune"},
+		},
+		{
+			description:   "quit with a key without cleaning up",
+			hasBuilt:      true,
+			hasDeployed:   true,
+			errDev:        runner.ErrorQuitWithoutCleanup,
+			expectedCalls: []string{"Dev", "HasDeployed", "HasBuilt"},
+		},
 	}
 
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
+			errDev := test.errDev
+			expectedErr := test.expectedErr
+			if errDev == nil {
+				errDev = context.Canceled
+				expectedErr = context.Canceled
+			}
+			runnerOpts := config.SkaffoldOptions{Cleanup: true}
+			if test.opts != nil {
+				runnerOpts = *test.opts
+			}
 			mockRunner := &mockDevRunner{
 				hasBuilt:    test.hasBuilt,
 				hasDeployed: test.hasDeployed,
-				errDev:      context.Canceled,
+				errDev:      errDev,
+				opts:        runnerOpts,
 			}
 			t.Override(&createRunner, func(config.SkaffoldOptions) (runner.Runner, *latest.SkaffoldConfig, error) {
 				return mockRunner, &latest.SkaffoldConfig{}, nil
 			})
-			t.Override(&opts, config.SkaffoldOptions{
-				Cleanup: true,
-				NoPrune: false,
-			})
 
 			err := doDev(context.Background(), ioutil.Discard)
 
 			t.CheckDeepEqual(test.expectedCalls, mockRunner.calls)
-			t.CheckTrue(err == context.Canceled)
+			t.CheckTrue(err == expectedErr)
 		})
 	}
 }
@@ -141,6 +185,10 @@ func (m *mockConfigChangeRunner) Cleanup(context.Context, io.Writer) error {
 	return nil
 }
 
+func (m *mockConfigChangeRunner) RunContext() *runcontext.RunContext {
+	return &runcontext.RunContext{Opts: config.SkaffoldOptions{Cleanup: true}}
+}
+
 func TestDevConfigChange(t *testing.T) {
 	testutil.Run(t, "test config change", func(t *testutil.T) {
 		mockRunner := &mockConfigChangeRunner{}
@@ -24,8 +24,11 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/diagnose"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
 )
@@ -57,8 +60,14 @@ func doDiagnose(ctx context.Context, out io.Writer) error {
 		fmt.Fprintln(out, "Skaffold version:", version.Get().GitCommit)
 		fmt.Fprintln(out, "Configuration version:", config.APIVersion)
 		fmt.Fprintln(out, "Number of artifacts:", len(config.Build.Artifacts))
+		diagnose.CheckCluster(runCtx, out)
 
-		if err := diagnose.CheckArtifacts(ctx, runCtx, out); err != nil {
+		if err := diagnose.CheckTagPolicies(runCtx); err != nil {
+			return fmt.Errorf("checking tag policies: %w", err)
+		}
+
+		taggerFor := func(a *latest.Artifact) (tag.Tagger, error) { return runner.TaggerForArtifact(runCtx, a) }
+		if err := diagnose.CheckArtifacts(ctx, runCtx, taggerFor, out); err != nil {
 			return fmt.Errorf("running diagnostic on artifacts: %w", err)
 		}
 
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/flags"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+var (
+	diffFromBuildOutputFile flags.BuildOutputFileFlag
+	failOnDiff              bool
+)
+
+// NewCmdDiff describes the CLI command to diff the rendered manifests against the cluster.
+func NewCmdDiff() *cobra.Command {
+	return NewCmd("diff").
+		WithDescription("[alpha] Show the changes that deploying would make to the cluster").
+		WithExample("Build the artifacts and diff the manifests against the cluster", "diff").
+		WithExample("Diff the manifests of previously built artifacts and fail if the cluster would change", "diff --build-artifacts=tags.json --fail-on-diff").
+		WithCommonFlags().
+		WithFlags(func(f *pflag.FlagSet) {
+			f.BoolVar(&showBuild, "loud", false, "Show the build logs and output")
+			f.VarP(&diffFromBuildOutputFile, "build-artifacts", "a", "File containing build result from a previous 'skaffold build --file-output'")
+			f.BoolVar(&failOnDiff, "fail-on-diff", false, "Exit with an error if deploying would change the cluster")
+		}).
+		WithHouseKeepingMessages().
+		NoArgs(doDiff)
+}
+
+func doDiff(ctx context.Context, out io.Writer) error {
+	buildOut := ioutil.Discard
+	if showBuild {
+		buildOut = out
+	}
+
+	return withRunner(ctx, func(r runner.Runner, config *latest.SkaffoldConfig) error {
+		var bRes []build.Artifact
+
+		if diffFromBuildOutputFile.String() != "" {
+			bRes = diffFromBuildOutputFile.BuildArtifacts()
+		} else {
+			var err error
+			bRes, err = r.BuildAndTest(ctx, buildOut, targetArtifacts(opts, config))
+			if err != nil {
+				return fmt.Errorf("executing build: %w", err)
+			}
+		}
+
+		changed, err := r.Diff(ctx, out, bRes)
+		if err != nil {
+			return err
+		}
+		if changed && failOnDiff {
+			return errors.New("deploying would change the cluster")
+		}
+		return nil
+	})
+}
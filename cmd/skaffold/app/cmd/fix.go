@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -29,30 +31,42 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/validation"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
 )
 
-var toVersion string
+var (
+	toVersion string
+	dryRun    bool
+)
 
 func NewCmdFix() *cobra.Command {
 	return NewCmd("fix").
 		WithDescription("Update old configuration to a newer schema version").
 		WithExample("Update \"skaffold.yaml\" in the current folder to the latest version", "fix").
 		WithExample("Update \"skaffold.yaml\" in the current folder to version \"skaffold/v1\"", "fix --version skaffold/v1").
+		WithExample("Show the changes that updating \"skaffold.yaml\" would make", "fix --dry-run").
 		WithCommonFlags().
 		WithFlags(func(f *pflag.FlagSet) {
 			f.BoolVar(&overwrite, "overwrite", false, "Overwrite original config with fixed config")
 			f.StringVar(&toVersion, "version", latest.Version, "Target schema version to upgrade to")
+			f.BoolVar(&dryRun, "dry-run", false, "Print the changes to the config instead of the fixed config, without writing them")
 		}).
 		NoArgs(doFix)
 }
 
 func doFix(_ context.Context, out io.Writer) error {
-	return fix(out, opts.ConfigurationFile, toVersion, overwrite)
+	return fix(out, opts.ConfigurationFile, toVersion, overwrite, dryRun)
 }
 
-func fix(out io.Writer, configFile string, toVersion string, overwrite bool) error {
-	cfg, err := schema.ParseConfig(configFile)
+func fix(out io.Writer, configFile string, toVersion string, overwrite, dryRun bool) error {
+	// Read the config only once since it can come from stdin.
+	original, err := util.ReadConfiguration(configFile)
+	if err != nil {
+		return fmt.Errorf("read skaffold config: %w", err)
+	}
+
+	cfg, err := schema.ParseConfigContent(original)
 	if err != nil {
 		return err
 	}
@@ -62,7 +76,7 @@ func fix(out io.Writer, configFile string, toVersion string, overwrite bool) err
 		return nil
 	}
 
-	cfg, err = schema.ParseConfigAndUpgrade(configFile, toVersion)
+	cfg, err = schema.UpgradeTo(cfg, toVersion)
 	if err != nil {
 		return err
 	}
@@ -80,14 +94,65 @@ func fix(out io.Writer, configFile string, toVersion string, overwrite bool) err
 		return fmt.Errorf("marshaling new config: %w", err)
 	}
 
-	if overwrite {
+	// Keep the comments of the keys that still exist in the new version.
+	if withComments, err := yaml.CopyComments(original, newCfg); err != nil {
+		logrus.Debugf("unable to preserve comments of %s: %v", configFile, err)
+	} else {
+		newCfg = withComments
+	}
+
+	switch {
+	case dryRun:
+		printDiff(out, string(original), string(newCfg))
+	case overwrite:
 		if err := ioutil.WriteFile(configFile, newCfg, 0644); err != nil {
 			return fmt.Errorf("writing config file: %w", err)
 		}
 		color.Default.Fprintf(out, "New config at version %s generated and written to %s\n", cfg.GetVersion(), opts.ConfigurationFile)
-	} else {
+	default:
 		out.Write(newCfg)
 	}
 
 	return nil
 }
+
+// printDiff prints a line by line diff of two configs.
+// Removed lines are prefixed with `-` and added lines with `+`.
+func printDiff(out io.Writer, before, after string) {
+	a := strings.Split(strings.TrimSuffix(before, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(after, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			color.Default.Fprintln(out, " "+a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			color.Red.Fprintln(out, "-"+a[i])
+			i++
+		default:
+			color.Green.Fprintln(out, "+"+b[j])
+			j++
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -158,7 +159,7 @@ build:
 			cfgFile := t.TempFile("config", []byte(test.inputYaml))
 
 			var b bytes.Buffer
-			err := fix(&b, cfgFile, test.targetVersion, false)
+			err := fix(&b, cfgFile, test.targetVersion, false, false)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.output, b.String())
 		})
@@ -203,7 +204,7 @@ deploy:
 		cfgFile := t.TempFile("config", []byte(inputYaml))
 
 		var b bytes.Buffer
-		err := fix(&b, cfgFile, latest.Version, true)
+		err := fix(&b, cfgFile, latest.Version, true, false)
 
 		output, _ := ioutil.ReadFile(cfgFile)
 
@@ -211,3 +212,117 @@ deploy:
 		t.CheckDeepEqual(expectedOutput, string(output))
 	})
 }
+
+func TestFixKeepsComments(t *testing.T) {
+	inputYaml := `# Build the app
+apiVersion: skaffold/v1alpha4
+kind: Config
+build:
+  artifacts:
+  - image: docker/image # the main image
+    docker:
+      dockerfile: dockerfile.test
+deploy:
+  # Deploy with kubectl
+  kubectl:
+    manifests:
+    - k8s/deployment.yaml
+`
+	expectedOutput := fmt.Sprintf(`# Build the app
+apiVersion: %s
+kind: Config
+build:
+  artifacts:
+  - image: docker/image # the main image
+    docker:
+      dockerfile: dockerfile.test
+deploy:
+  # Deploy with kubectl
+  kubectl:
+    manifests:
+    - k8s/deployment.yaml
+`, latest.Version)
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		cfgFile := t.TempFile("config", []byte(inputYaml))
+
+		var b bytes.Buffer
+		err := fix(&b, cfgFile, latest.Version, false, false)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expectedOutput, b.String())
+	})
+}
+
+func TestFixFromStdin(t *testing.T) {
+	inputYaml := `apiVersion: skaffold/v1alpha4
+kind: Config
+build:
+  artifacts:
+  - image: docker/image # the main image
+`
+	expectedOutput := fmt.Sprintf(`apiVersion: %s
+kind: Config
+build:
+  artifacts:
+  - image: docker/image # the main image
+`, latest.Version)
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		stdin, err := os.Open(t.TempFile("stdin", []byte(inputYaml)))
+		t.CheckNoError(err)
+		defer stdin.Close()
+		t.Override(&os.Stdin, stdin)
+
+		var b bytes.Buffer
+		err = fix(&b, "-", latest.Version, false, false)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expectedOutput, b.String())
+	})
+}
+
+func TestFixDryRun(t *testing.T) {
+	inputYaml := `apiVersion: skaffold/v1alpha1
+kind: Config
+build:
+  artifacts:
+  - imageName: docker/image
+    dockerfilePath: dockerfile.test
+deploy:
+  kubectl:
+    manifests:
+    - paths:
+      - k8s/deployment.yaml
+`
+	expectedOutput := fmt.Sprintf(`-apiVersion: skaffold/v1alpha1
++apiVersion: %s
+ kind: Config
+ build:
+   artifacts:
+-  - imageName: docker/image
+-    dockerfilePath: dockerfile.test
++  - image: docker/image
++    docker:
++      dockerfile: dockerfile.test
+ deploy:
+   kubectl:
+     manifests:
+-    - paths:
+-      - k8s/deployment.yaml
++    - k8s/deployment.yaml
+`, latest.Version)
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		cfgFile := t.TempFile("config", []byte(inputYaml))
+
+		var b bytes.Buffer
+		err := fix(&b, cfgFile, latest.Version, true, true)
+
+		output, _ := ioutil.ReadFile(cfgFile)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expectedOutput, b.String())
+		t.CheckDeepEqual(inputYaml, string(output))
+	})
+}
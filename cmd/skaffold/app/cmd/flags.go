@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -39,6 +40,10 @@ type Flag struct {
 	FlagAddMethod      string
 	DefinedOn          []string
 	Hidden             bool
+	// NoOptDefVal is the value of the flag when it's given without a value.
+	NoOptDefVal string
+	// CompletionFunc completes the values of the flag.
+	CompletionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
 
 	pflag *pflag.Flag
 }
@@ -61,13 +66,24 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"all"},
 	},
 	{
-		Name:          "profile",
-		Shorthand:     "p",
-		Usage:         "Activate profiles by name (prefixed with `-` to disable a profile)",
-		Value:         &opts.Profiles,
-		DefValue:      []string{},
-		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+		Name:           "profile",
+		Shorthand:      "p",
+		Usage:          "Activate profiles by name (prefixed with `-` to disable a profile)",
+		Value:          &opts.Profiles,
+		DefValue:       []string{},
+		FlagAddMethod:  "StringSliceVar",
+		DefinedOn:      []string{"dev", "run", "debug", "deploy", "render", "diff", "build", "delete", "diagnose"},
+		CompletionFunc: completeProfiles,
+	},
+	{
+		Name:           "module",
+		Shorthand:      "m",
+		Usage:          "Filter the configs required by the Skaffold config to only the provided named modules",
+		Value:          &opts.ConfigurationFilter,
+		DefValue:       []string{},
+		FlagAddMethod:  "StringSliceVar",
+		DefinedOn:      []string{"dev", "run", "debug", "deploy", "render", "diff", "build", "delete", "diagnose"},
+		CompletionFunc: completeModules,
 	},
 	{
 		Name:          "namespace",
@@ -76,7 +92,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.Namespace,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "diff", "build", "delete"},
 	},
 	{
 		Name:          "default-repo",
@@ -85,7 +101,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.DefaultRepo,
 		DefValue:      "",
 		FlagAddMethod: "Var",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "diff", "build", "delete"},
 	},
 	{
 		Name:          "cache-artifacts",
@@ -145,7 +161,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.CustomLabels,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "diff"},
 	},
 	{
 		Name:          "toot",
@@ -155,6 +171,14 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "BoolVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "timings",
+		Usage:         "Print how long each artifact took to build and push, and how long the deploy and the status check took, at the end of each run or dev loop iteration",
+		Value:         &opts.Timings,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug"},
+	},
 	{
 		Name:     "tail",
 		Usage:    "Stream logs from deployed objects (true by default for `skaffold dev` and `skaffold debug`)",
@@ -167,6 +191,22 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "BoolVar",
 		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "log-include",
+		Usage:         "Only stream the logs of Kubernetes containers whose `<pod name>/<container name>` matches one of these regular expressions",
+		Value:         &opts.LogInclude,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "log-exclude",
+		Usage:         "Don't stream the logs of Kubernetes containers whose `<pod name>/<container name>` matches one of these regular expressions",
+		Value:         &opts.LogExclude,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
 	{
 		Name:          "force",
 		Usage:         "Recreate Kubernetes resources if necessary for deployment, warning: might cause downtime!",
@@ -191,6 +231,14 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "BoolVar",
 		DefinedOn:     []string{"dev", "run", "debug"},
 	},
+	{
+		Name:          "delete-namespaces",
+		Usage:         "On cleanup, also delete the namespaces that Helm created for releases with `createNamespace: true`",
+		Value:         &opts.DeleteNamespaces,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "delete"},
+	},
 	{
 		Name:          "no-prune",
 		Usage:         "Skip removing images and containers built by Skaffold",
@@ -209,10 +257,10 @@ var flagRegistry = []Flag{
 	},
 	{
 		Name:          "port-forward",
-		Usage:         "Port-forward exposed container ports within pods",
-		Value:         &opts.PortForward.Enabled,
-		DefValue:      false,
-		FlagAddMethod: "BoolVar",
+		Usage:         "Port-forward exposed ports, with a comma-separated list of modes: `off`, `user` for the resources in skaffold.yaml, `services`, `debug` for the debugging ports or `pods` for all the container ports. Without a mode, `user` and `services`, and also `debug` for `skaffold debug`",
+		Value:         &opts.PortForward,
+		NoOptDefVal:   "true",
+		FlagAddMethod: "Var",
 		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
 	},
 	{
@@ -254,7 +302,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeContext,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "render", "diff", "filter"},
 	},
 	{
 		Name:          "kubeconfig",
@@ -262,7 +310,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeConfig,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "render", "diff", "filter"},
 	},
 	{
 		Name:          "tag",
@@ -291,7 +339,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.ProfileAutoActivation,
 		DefValue:      true,
 		FlagAddMethod: "BoolVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "diff", "build", "delete", "diagnose"},
 	},
 	{
 		Name:          "trigger",
@@ -359,12 +407,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "debug"},
 	},
 	{
-		Name:          "add-skaffold-labels",
-		Usage:         "Add Skaffold-specific labels to rendered manifest. If false, custom labels are still applied. Helpful for GitOps model where Skaffold is not the deployer.",
-		Value:         &opts.AddSkaffoldLabels,
-		DefValue:      true,
+		Name:     "add-skaffold-labels",
+		Usage:    "Add Skaffold-specific labels to rendered manifest. If false, custom labels are still applied. Helpful for GitOps model where Skaffold is not the deployer.",
+		Value:    &opts.AddSkaffoldLabels,
+		DefValue: true,
+		DefValuePerCommand: map[string]interface{}{
+			"diff": false,
+		},
 		FlagAddMethod: "BoolVar",
-		DefinedOn:     []string{"render"},
+		DefinedOn:     []string{"render", "diff"},
 	},
 	{
 		Name:          "mute-logs",
@@ -399,13 +450,14 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"deploy", "dev", "run", "debug"},
 	},
 	{
-		Name:          "build-image",
-		Shorthand:     "b",
-		Usage:         "Only build artifacts with image names that contain the given substring. Default is to build sources for all artifacts",
-		Value:         &opts.TargetImages,
-		DefValue:      []string{},
-		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"build", "run"},
+		Name:           "build-image",
+		Shorthand:      "b",
+		Usage:          "Only build artifacts with image names that contain the given substring. Default is to build sources for all artifacts",
+		Value:          &opts.TargetImages,
+		DefValue:       []string{},
+		FlagAddMethod:  "StringSliceVar",
+		DefinedOn:      []string{"build", "run"},
+		CompletionFunc: completeImages,
 	},
 	{
 		Name:          "detect-minikube",
@@ -434,6 +486,9 @@ func (fl *Flag) flag() *pflag.Flag {
 	f := fs.Lookup(fl.Name)
 	f.Shorthand = fl.Shorthand
 	f.Hidden = fl.Hidden
+	if fl.NoOptDefVal != "" {
+		f.NoOptDefVal = fl.NoOptDefVal
+	}
 
 	fl.pflag = f
 	return f
@@ -457,7 +512,14 @@ func AddFlags(cmd *cobra.Command) {
 			continue
 		}
 
+		firstUse := fl.pflag == nil
 		cmd.Flags().AddFlag(fl.flag())
+		// Completion functions are registered once per flag, since the flag is shared by all the commands.
+		if firstUse && fl.CompletionFunc != nil {
+			if err := cmd.RegisterFlagCompletionFunc(fl.Name, fl.CompletionFunc); err != nil {
+				logrus.Debugln("registering completion of flag", fl.Name, err)
+			}
+		}
 
 		flagsForCommand = append(flagsForCommand, fl)
 	}
@@ -489,6 +551,17 @@ func AddFlags(cmd *cobra.Command) {
 	}
 }
 
+// isFlagChanged tells if the user explicitly set the flag with the given name.
+// For testing
+var isFlagChanged = func(name string) bool {
+	for i := range flagRegistry {
+		if fl := &flagRegistry[i]; fl.Name == name {
+			return fl.pflag != nil && fl.pflag.Changed
+		}
+	}
+	return false
+}
+
 func hasCmdAnnotation(cmdName string, annotations []string) bool {
 	for _, a := range annotations {
 		if cmdName == a || a == "all" {
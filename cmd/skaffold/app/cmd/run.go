@@ -22,8 +22,10 @@ import (
 	"io"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/tips"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -35,18 +37,31 @@ func NewCmdRun() *cobra.Command {
 		WithLongDescription("Run a pipeline: build and test artifacts, tag them, update Kubernetes manifests and deploy to a cluster.").
 		WithExample("Build, test, deploy and tail the logs", "run --tail").
 		WithExample("Run with a given profile", "run -p <profile>").
+		WithExample("Run with a pre-built image instead of building it", "run --images app=gcr.io/project/app:v1").
 		WithCommonFlags().
+		WithFlags(func(f *pflag.FlagSet) {
+			f.VarP(&preBuiltImages, "images", "i", "A list of pre-built images to deploy instead of building them, given as `image` or `artifact=image`")
+		}).
 		WithHouseKeepingMessages().
 		NoArgs(doRun)
 }
 
 func doRun(ctx context.Context, out io.Writer) error {
 	return withRunner(ctx, func(r runner.Runner, config *latest.SkaffoldConfig) error {
-		bRes, err := r.BuildAndTest(ctx, out, targetArtifacts(opts, config))
+		preBuilt := preBuiltImages.Artifacts()
+		bRes, err := r.BuildAndTest(ctx, out, withoutPreBuilt(targetArtifacts(opts, config), preBuilt))
 		if err != nil {
 			return fmt.Errorf("failed to build: %w", err)
 		}
 
+		for _, a := range preBuilt {
+			tag, err := r.ApplyDefaultRepo(a.Tag)
+			if err != nil {
+				return err
+			}
+			bRes = append(bRes, build.Artifact{ImageName: a.ImageName, Tag: tag})
+		}
+
 		err = r.DeployAndLog(ctx, out, bRes)
 		if err == nil {
 			tips.PrintForRun(out, opts)
@@ -55,3 +70,21 @@ func doRun(ctx context.Context, out io.Writer) error {
 		return err
 	})
 }
+
+// withoutPreBuilt removes the artifacts for which a pre-built image is given.
+func withoutPreBuilt(artifacts []*latest.Artifact, preBuilt []build.Artifact) []*latest.Artifact {
+	var toBuild []*latest.Artifact
+	for _, a := range artifacts {
+		found := false
+		for _, p := range preBuilt {
+			if p.ImageName == a.ImageName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			toBuild = append(toBuild, a)
+		}
+	}
+	return toBuild
+}
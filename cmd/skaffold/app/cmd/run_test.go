@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/flags"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
@@ -47,6 +48,7 @@ func TestNewCmdRun(t *testing.T) {
 type mockRunRunner struct {
 	runner.Runner
 	artifactImageNames []string
+	deployed           []build.Artifact
 }
 
 func (r *mockRunRunner) BuildAndTest(_ context.Context, _ io.Writer, artifacts []*latest.Artifact) ([]build.Artifact, error) {
@@ -62,10 +64,15 @@ func (r *mockRunRunner) BuildAndTest(_ context.Context, _ io.Writer, artifacts [
 	return result, nil
 }
 
-func (r *mockRunRunner) DeployAndLog(context.Context, io.Writer, []build.Artifact) error {
+func (r *mockRunRunner) DeployAndLog(_ context.Context, _ io.Writer, builds []build.Artifact) error {
+	r.deployed = builds
 	return nil
 }
 
+func (r *mockRunRunner) ApplyDefaultRepo(tag string) (string, error) {
+	return tag, nil
+}
+
 func TestBuildImageFlag(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		mockRunner := &mockRunRunner{}
@@ -92,3 +99,30 @@ func TestBuildImageFlag(t *testing.T) {
 		t.CheckDeepEqual([]string{"second-test", "test"}, mockRunner.artifactImageNames)
 	})
 }
+
+func TestRunPreBuiltImages(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		mockRunner := &mockRunRunner{}
+		t.Override(&createRunner, func(config.SkaffoldOptions) (runner.Runner, *latest.SkaffoldConfig, error) {
+			return mockRunner, &latest.SkaffoldConfig{
+				Pipeline: latest.Pipeline{
+					Build: latest.BuildConfig{
+						Artifacts: []*latest.Artifact{
+							{ImageName: "first"},
+							{ImageName: "second"},
+						},
+					},
+				},
+			}, nil
+		})
+		t.Override(&opts, config.SkaffoldOptions{})
+		t.Override(&preBuiltImages, flags.Images{})
+		preBuiltImages.Set("second=gcr.io/project/second:v1")
+
+		err := doRun(context.Background(), ioutil.Discard)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"first"}, mockRunner.artifactImageNames)
+		t.CheckDeepEqual([]build.Artifact{{ImageName: "first"}, {ImageName: "second", Tag: "gcr.io/project/second:v1"}}, mockRunner.deployed)
+	})
+}
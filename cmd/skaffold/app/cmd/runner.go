@@ -70,30 +70,44 @@ func runContext(opts config.SkaffoldOptions) (*runcontext.RunContext, *latest.Sk
 	parsed, err := schema.ParseConfigAndUpgrade(opts.ConfigurationFile, latest.Version)
 	if err != nil {
 		if os.IsNotExist(errors.Unwrap(err)) {
-			return nil, nil, fmt.Errorf("skaffold config file %s not found - check your current working directory, or try running `skaffold init`", opts.ConfigurationFile)
+			return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("skaffold config file %s not found - check your current working directory, or try running `skaffold init`", opts.ConfigurationFile))
 		}
 
 		// If the error is NOT that the file doesn't exist, then we warn the user
 		// that maybe they are using an outdated version of Skaffold that's unable to read
 		// the configuration.
 		warnIfUpdateIsAvailable()
-		return nil, nil, fmt.Errorf("parsing skaffold config: %w", err)
+		return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("parsing skaffold config: %w", err))
 	}
 
 	config := parsed.(*latest.SkaffoldConfig)
 
 	if err = schema.ApplyProfiles(config, opts); err != nil {
-		return nil, nil, fmt.Errorf("applying profiles: %w", err)
+		return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("applying profiles: %w", err))
+	}
+
+	if err = schema.ApplyRequires(config, opts); err != nil {
+		return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("resolving required configs: %w", err))
+	}
+
+	if err = schema.ExpandEnvTemplates(config); err != nil {
+		return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("expanding environment variables: %w", err))
 	}
 
 	kubectx.ConfigureKubeConfig(opts.KubeConfig, opts.KubeContext, config.Deploy.KubeContext)
+	opts.WatchPollInterval = watchPollInterval(opts)
+	opts.Cleanup, opts.NoPrune = exitOptions(opts)
 
 	if err := defaults.Set(config); err != nil {
-		return nil, nil, fmt.Errorf("setting default values: %w", err)
+		return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("setting default values: %w", err))
 	}
 
 	if err := validation.Process(config); err != nil {
-		return nil, nil, fmt.Errorf("invalid skaffold config: %w", err)
+		return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("invalid skaffold config: %w", err))
+	}
+
+	if err := validation.ValidateLogFilterFlags(opts.LogInclude, opts.LogExclude); err != nil {
+		return nil, nil, sErrors.WithExitCode(sErrors.ConfigExitCode, fmt.Errorf("invalid log filter flags: %w", err))
 	}
 
 	runCtx, err := runcontext.GetRunContext(opts, config.Pipeline)
@@ -104,6 +118,48 @@ func runContext(opts config.SkaffoldOptions) (*runcontext.RunContext, *latest.Sk
 	return runCtx, config, nil
 }
 
+// watchPollInterval returns the value of `--watch-poll-interval`, or the one
+// from the global config when the flag isn't set.
+func watchPollInterval(opts config.SkaffoldOptions) int {
+	if isFlagChanged("watch-poll-interval") {
+		return opts.WatchPollInterval
+	}
+
+	interval, err := config.GetWatchPollInterval(opts.GlobalConfig)
+	if err != nil {
+		logrus.Debugf("unable to read watch-poll-interval from global config: %v", err)
+		return opts.WatchPollInterval
+	}
+	if interval == nil {
+		return opts.WatchPollInterval
+	}
+	return *interval
+}
+
+// exitOptions returns the values of `--cleanup` and `--no-prune`, or the ones
+// from the global config for the flags that aren't set.
+func exitOptions(opts config.SkaffoldOptions) (cleanup bool, noPrune bool) {
+	cleanup = boolFlagOrGlobalConfig(opts, "cleanup", opts.Cleanup, config.GetCleanup)
+	noPrune = boolFlagOrGlobalConfig(opts, "no-prune", opts.NoPrune, config.GetNoPrune)
+	return cleanup, noPrune
+}
+
+func boolFlagOrGlobalConfig(opts config.SkaffoldOptions, name string, value bool, fromGlobalConfig func(string) (*bool, error)) bool {
+	if isFlagChanged(name) {
+		return value
+	}
+
+	configured, err := fromGlobalConfig(opts.GlobalConfig)
+	if err != nil {
+		logrus.Debugf("unable to read %s from global config: %v", name, err)
+		return value
+	}
+	if configured == nil {
+		return value
+	}
+	return *configured
+}
+
 func warnIfUpdateIsAvailable() {
 	warning, err := update.CheckVersionOnError(opts.GlobalConfig)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/update"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -82,13 +83,24 @@ func TestCreateNewRunner(t *testing.T) {
 			shouldErr:     true,
 			expectedError: "unsupported trigger",
 		},
+		{
+			description: "invalid log filter",
+			config:      "",
+			options: config.SkaffoldOptions{
+				ConfigurationFile: "skaffold.yaml",
+				Trigger:           "polling",
+				LogInclude:        []string{"["},
+			},
+			shouldErr:     true,
+			expectedError: "invalid log filter flags",
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
 				return nil, nil
 			})
-			t.Override(&update.GetLatestAndCurrentVersion, func() (semver.Version, semver.Version, error) {
+			t.Override(&update.GetLatestAndCurrentVersion, func(string) (semver.Version, semver.Version, error) {
 				return semver.Version{}, semver.Version{}, nil
 			})
 			t.NewTempDir().
@@ -104,3 +116,101 @@ func TestCreateNewRunner(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchPollInterval(t *testing.T) {
+	tests := []struct {
+		description string
+		flagChanged bool
+		cfg         *config.ContextConfig
+		readErr     error
+		expected    int
+	}{
+		{
+			description: "flag value when set",
+			flagChanged: true,
+			cfg:         &config.ContextConfig{WatchPollInterval: util.IntPtr(500)},
+			expected:    2000,
+		},
+		{
+			description: "global config when flag isn't set",
+			cfg:         &config.ContextConfig{WatchPollInterval: util.IntPtr(500)},
+			expected:    500,
+		},
+		{
+			description: "default value when not configured",
+			cfg:         &config.ContextConfig{},
+			expected:    2000,
+		},
+		{
+			description: "default value when global config can't be read",
+			readErr:     fmt.Errorf("read error"),
+			expected:    2000,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&isFlagChanged, func(string) bool { return test.flagChanged })
+			t.Override(&config.GetConfigForCurrentKubectx, func(string) (*config.ContextConfig, error) { return test.cfg, test.readErr })
+
+			interval := watchPollInterval(config.SkaffoldOptions{WatchPollInterval: 2000})
+
+			t.CheckDeepEqual(test.expected, interval)
+		})
+	}
+}
+
+func TestExitOptions(t *testing.T) {
+	tests := []struct {
+		description     string
+		changedFlags    []string
+		cfg             *config.ContextConfig
+		expectedCleanup bool
+		expectedNoPrune bool
+	}{
+		{
+			description:     "flag values when not configured",
+			cfg:             &config.ContextConfig{},
+			expectedCleanup: true,
+		},
+		{
+			description:     "global config when flags aren't set",
+			cfg:             &config.ContextConfig{Cleanup: util.BoolPtr(false), NoPrune: util.BoolPtr(true)},
+			expectedNoPrune: true,
+		},
+		{
+			description:     "flags take precedence over global config",
+			changedFlags:    []string{"cleanup", "no-prune"},
+			cfg:             &config.ContextConfig{Cleanup: util.BoolPtr(false), NoPrune: util.BoolPtr(true)},
+			expectedCleanup: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&isFlagChanged, func(name string) bool { return util.StrSliceContains(test.changedFlags, name) })
+			t.Override(&config.GetConfigForCurrentKubectx, func(string) (*config.ContextConfig, error) { return test.cfg, nil })
+
+			cleanup, noPrune := exitOptions(config.SkaffoldOptions{Cleanup: true})
+
+			t.CheckDeepEqual(test.expectedCleanup, cleanup)
+			t.CheckDeepEqual(test.expectedNoPrune, noPrune)
+		})
+	}
+}
+
+func TestRunContextExitOptions(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&isFlagChanged, func(string) bool { return false })
+		t.Override(&config.GetConfigForCurrentKubectx, func(string) (*config.ContextConfig, error) {
+			return &config.ContextConfig{Cleanup: util.BoolPtr(false), NoPrune: util.BoolPtr(true)}, nil
+		})
+		t.NewTempDir().
+			Write("skaffold.yaml", fmt.Sprintf("apiVersion: %s\nkind: Config\n", latest.Version)).
+			Chdir()
+
+		runCtx, _, err := runContext(config.SkaffoldOptions{ConfigurationFile: "skaffold.yaml", Cleanup: true})
+
+		t.CheckNoError(err)
+		t.CheckFalse(runCtx.Opts.Cleanup)
+		t.CheckTrue(runCtx.Opts.NoPrune)
+	})
+}
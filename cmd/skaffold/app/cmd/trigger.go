@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+var (
+	triggerRPCPort int
+	triggerBuild   bool
+	triggerSync    bool
+	triggerDeploy  bool
+	triggerImages  []string
+)
+
+// NewCmdTrigger describes the CLI command to trigger actions in a running dev session.
+func NewCmdTrigger() *cobra.Command {
+	return NewCmd("trigger").
+		WithDescription("Trigger a build, sync or deploy in a running `skaffold dev` session").
+		WithLongDescription("Trigger a build, sync or deploy in a running `skaffold dev` session started with `--enable-rpc`. The actions are only triggered for the phases that don't run automatically (e.g. `--auto-build=false`), except for the artifacts selected with `--artifact`, which are always rebuilt.").
+		WithExample("Build, sync and deploy pending changes", "trigger").
+		WithExample("Only redeploy", "trigger --deploy").
+		WithExample("Rebuild one artifact, even if none of its files changed", "trigger --artifact gcr.io/k8s-skaffold/app").
+		WithFlags(func(f *pflag.FlagSet) {
+			f.IntVar(&triggerRPCPort, "rpc-port", constants.DefaultRPCPort, "tcp port of the running session's event API")
+			f.BoolVar(&triggerBuild, "build", false, "Build the pending changes")
+			f.BoolVar(&triggerSync, "sync", false, "Sync the pending changes")
+			f.BoolVar(&triggerDeploy, "deploy", false, "Deploy the pending changes")
+			f.StringSliceVar(&triggerImages, "artifact", nil, "Image name of an artifact to rebuild, even if none of its files changed. Can be repeated")
+		}).
+		NoArgs(doTrigger)
+}
+
+func doTrigger(ctx context.Context, out io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	address := fmt.Sprintf("%s:%d", util.Loopback, triggerRPCPort)
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return fmt.Errorf("connecting to skaffold on %s (was it started with --enable-rpc?): %w", address, err)
+	}
+	defer conn.Close()
+
+	intent := userIntent(triggerBuild, triggerSync, triggerDeploy, triggerImages)
+	client := proto.NewSkaffoldServiceClient(conn)
+	if _, err := client.Execute(ctx, &proto.UserIntentRequest{Intent: intent}); err != nil {
+		return fmt.Errorf("triggering skaffold: %w", err)
+	}
+
+	color.Default.Fprintln(out, "Triggered", intent.String())
+	return nil
+}
+
+// userIntent triggers all the phases when none is explicitly selected.
+// Selecting artifacts to rebuild doesn't count as selecting the build phase.
+func userIntent(build, sync, deploy bool, artifacts []string) *proto.Intent {
+	if !build && !sync && !deploy {
+		return &proto.Intent{Build: true, Sync: true, Deploy: true, Artifacts: artifacts}
+	}
+	return &proto.Intent{Build: build, Sync: sync, Deploy: deploy, Artifacts: artifacts}
+}
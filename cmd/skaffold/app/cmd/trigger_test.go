@@ -0,0 +1,65 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestUserIntent(t *testing.T) {
+	tests := []struct {
+		description         string
+		build, sync, deploy bool
+		artifacts           []string
+		expected            *proto.Intent
+	}{
+		{
+			description: "nothing selected triggers everything",
+			expected:    &proto.Intent{Build: true, Sync: true, Deploy: true},
+		},
+		{
+			description: "only deploy",
+			deploy:      true,
+			expected:    &proto.Intent{Deploy: true},
+		},
+		{
+			description: "build and sync",
+			build:       true,
+			sync:        true,
+			expected:    &proto.Intent{Build: true, Sync: true},
+		},
+		{
+			description: "rebuild an artifact",
+			artifacts:   []string{"app"},
+			expected:    &proto.Intent{Build: true, Sync: true, Deploy: true, Artifacts: []string{"app"}},
+		},
+		{
+			description: "rebuild an artifact and redeploy",
+			deploy:      true,
+			artifacts:   []string{"app"},
+			expected:    &proto.Intent{Deploy: true, Artifacts: []string{"app"}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, userIntent(test.build, test.sync, test.deploy, test.artifacts))
+		})
+	}
+}
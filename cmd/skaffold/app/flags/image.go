@@ -85,16 +85,30 @@ func NewEmptyImages(usage string) *Images {
 	}
 }
 
+// convertImageToArtifact parses either an image reference, used for the artifact of the same name,
+// or `name=image`, where `name` is the name of the artifact.
 func convertImageToArtifact(value string) (*build.Artifact, error) {
 	if value == "" {
 		return nil, errors.New("cannot add an empty image value")
 	}
-	parsed, err := docker.ParseReference(value)
+
+	name, image := "", value
+	if kv := strings.SplitN(value, "=", 2); len(kv) == 2 {
+		name, image = kv[0], kv[1]
+		if name == "" {
+			return nil, fmt.Errorf("missing artifact name in %q", value)
+		}
+	}
+
+	parsed, err := docker.ParseReference(image)
 	if err != nil {
 		return nil, err
 	}
+	if name == "" {
+		name = parsed.BaseName
+	}
 	return &build.Artifact{
-		ImageName: parsed.BaseName,
-		Tag:       value,
+		ImageName: name,
+		Tag:       image,
 	}, nil
 }
@@ -128,6 +128,21 @@ func TestConvertToArtifact(t *testing.T) {
 			image:       "skaffold/image1:tag1",
 			expected:    &build.Artifact{ImageName: "skaffold/image1", Tag: "skaffold/image1:tag1"},
 		},
+		{
+			description: "image for a given artifact",
+			image:       "skaffold/image1=gcr.io/project/other:tag1",
+			expected:    &build.Artifact{ImageName: "skaffold/image1", Tag: "gcr.io/project/other:tag1"},
+		},
+		{
+			description: "missing artifact name",
+			image:       "=gcr.io/project/other:tag1",
+			shouldErr:   true,
+		},
+		{
+			description: "invalid image for a given artifact",
+			image:       "skaffold/image1=busybox:1$",
+			shouldErr:   true,
+		},
 		{
 			description: "test invalid artifact",
 			image:       "busybox:1$",
@@ -34,6 +34,7 @@ import (
 	blackfriday "github.com/russross/blackfriday/v2"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 const (
@@ -179,6 +180,15 @@ func yamlFieldName(field *ast.Field) string {
 	return strings.Split(yamlTag, ",")[0]
 }
 
+// isInline checks whether the yaml tag of a field has the `inline` option.
+func isInline(field *ast.Field) bool {
+	tag := strings.Replace(field.Tag.Value, "`", "", -1)
+	tags := reflect.StructTag(tag)
+	yamlTag := tags.Get("yaml")
+
+	return util.StrSliceContains(strings.Split(yamlTag, ","), "inline")
+}
+
 //nolint:golint,goconst
 func setTypeOrRef(def *Definition, typeName string) {
 	switch typeName {
@@ -236,7 +246,7 @@ func (g *schemaGenerator) newDefinition(name string, t ast.Expr, comment string,
 		for _, field := range tt.Fields.List {
 			yamlName := yamlFieldName(field)
 
-			if strings.Contains(field.Tag.Value, "inline") {
+			if isInline(field) {
 				def.PreferredOrder = append(def.PreferredOrder, "<inline>")
 				def.inlines = append(def.inlines, &Definition{
 					Ref:      defPrefix + field.Type.(*ast.Ident).Name,
@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/docker/docker/api/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ecrRegistry matches the hostname of an ECR registry and captures its account ID and region.
+var ecrRegistry = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?$`)
+
+// IsECRRegistry tells if the given registry hostname is an ECR registry.
+func IsECRRegistry(registry string) bool {
+	return ecrRegistry.MatchString(registry)
+}
+
+// ecrTokenRefreshMargin is how long before its expiry an ECR token is renewed.
+const ecrTokenRefreshMargin = 5 * time.Minute
+
+var (
+	// For testing
+	timeNow = time.Now
+
+	ecrTokens   = map[string]ecrToken{}
+	ecrTokensMu sync.Mutex
+)
+
+// ecrToken is a cached ECR authorization token.
+type ecrToken struct {
+	auth      types.AuthConfig
+	expiresAt time.Time
+}
+
+// authorizationData is the output of `aws ecr get-authorization-token`.
+type authorizationData struct {
+	AuthorizationData []struct {
+		AuthorizationToken string          `json:"authorizationToken"`
+		ExpiresAt          json.RawMessage `json:"expiresAt"`
+	} `json:"authorizationData"`
+}
+
+// ECRAuthConfig returns the credentials for an ECR registry.
+// They are obtained with the `aws` CLI so no docker credential helper is needed,
+// and are reused until shortly before the token expires.
+func ECRAuthConfig(registry string) (types.AuthConfig, error) {
+	account, region, ok := parseECRRegistry(registry)
+	if !ok {
+		return types.AuthConfig{}, fmt.Errorf("%q is not an ECR registry", registry)
+	}
+
+	ecrTokensMu.Lock()
+	defer ecrTokensMu.Unlock()
+
+	if token, found := ecrTokens[registry]; found && timeNow().Add(ecrTokenRefreshMargin).Before(token.expiresAt) {
+		return token.auth, nil
+	}
+
+	logrus.Debugf("Getting ECR credentials for account %s in %s", account, region)
+	out, err := util.RunCmdOut(exec.Command("aws", "ecr", "get-authorization-token", "--registry-ids", account, "--region", region, "--output", "json"))
+	if err != nil {
+		return types.AuthConfig{}, fmt.Errorf("getting ECR credentials: %w", err)
+	}
+
+	token, err := parseAuthorizationToken(out, registry)
+	if err != nil {
+		return types.AuthConfig{}, fmt.Errorf("parsing ECR credentials: %w", err)
+	}
+
+	ecrTokens[registry] = token
+	return token.auth, nil
+}
+
+func parseAuthorizationToken(out []byte, registry string) (ecrToken, error) {
+	var data authorizationData
+	if err := json.Unmarshal(out, &data); err != nil {
+		return ecrToken{}, err
+	}
+	if len(data.AuthorizationData) == 0 {
+		return ecrToken{}, errors.New("no authorization data")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(data.AuthorizationData[0].AuthorizationToken)
+	if err != nil {
+		return ecrToken{}, fmt.Errorf("decoding token: %w", err)
+	}
+	username, password := "AWS", string(decoded)
+	if i := strings.Index(password, ":"); i >= 0 {
+		username, password = password[:i], password[i+1:]
+	}
+
+	expiresAt, err := parseExpiresAt(data.AuthorizationData[0].ExpiresAt)
+	if err != nil {
+		return ecrToken{}, fmt.Errorf("parsing token expiry: %w", err)
+	}
+
+	return ecrToken{
+		auth: types.AuthConfig{
+			Username:      username,
+			Password:      password,
+			ServerAddress: registry,
+		},
+		expiresAt: expiresAt,
+	}, nil
+}
+
+// parseExpiresAt parses a timestamp printed by the `aws` CLI, which is
+// either an ISO 8601 string or a number of seconds since the epoch.
+func parseExpiresAt(raw json.RawMessage) (time.Time, error) {
+	var iso string
+	if err := json.Unmarshal(raw, &iso); err == nil {
+		return time.Parse(time.RFC3339, iso)
+	}
+
+	var seconds float64
+	if err := json.Unmarshal(raw, &seconds); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(seconds*float64(time.Second))), nil
+}
+
+// EnsureECRRepository creates the ECR repository of the given image if it doesn't exist yet.
+// Images that are not hosted on ECR are ignored.
+func EnsureECRRepository(ctx context.Context, out io.Writer, image string) error {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return fmt.Errorf("parsing image name %q: %w", image, err)
+	}
+
+	account, region, ok := parseECRRegistry(reference.Domain(named))
+	if !ok {
+		return nil
+	}
+
+	repository := reference.Path(named)
+	flags := []string{"--registry-id", account, "--region", region}
+
+	_, err = util.RunCmdOut(exec.CommandContext(ctx, "aws", append([]string{"ecr", "describe-repositories", "--repository-names", repository}, flags...)...))
+	if err == nil {
+		return nil
+	}
+	if !strings.Contains(err.Error(), "RepositoryNotFoundException") {
+		return fmt.Errorf("checking ECR repository %q: %w", repository, err)
+	}
+
+	if _, err := util.RunCmdOut(exec.CommandContext(ctx, "aws", append([]string{"ecr", "create-repository", "--repository-name", repository}, flags...)...)); err != nil {
+		return fmt.Errorf("creating ECR repository %q: %w", repository, err)
+	}
+
+	color.Default.Fprintf(out, "Created ECR repository %s\n", repository)
+	return nil
+}
+
+func parseECRRegistry(registry string) (account string, region string, ok bool) {
+	matches := ecrRegistry.FindStringSubmatch(registry)
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1], matches[2], true
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestIsECRRegistry(t *testing.T) {
+	tests := []struct {
+		registry string
+		expected bool
+	}{
+		{registry: "123456789012.dkr.ecr.us-east-1.amazonaws.com", expected: true},
+		{registry: "123456789012.dkr.ecr-fips.us-gov-west-1.amazonaws.com", expected: true},
+		{registry: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn", expected: true},
+		{registry: "gcr.io"},
+		{registry: "dkr.ecr.us-east-1.amazonaws.com"},
+		{registry: "123456789012.dkr.ecr.us-east-1.amazonaws.com.evil.io"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.registry, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, IsECRRegistry(test.registry))
+		})
+	}
+}
+
+func TestECRAuthConfig(t *testing.T) {
+	const registry = "123456789012.dkr.ecr.eu-west-3.amazonaws.com"
+	const getToken = "aws ecr get-authorization-token --registry-ids 123456789012 --region eu-west-3 --output json"
+	// base64 of "AWS:TOKEN"
+	const tokenV2 = `{"authorizationData": [{"authorizationToken": "QVdTOlRPS0VO", "expiresAt": "2021-01-01T12:00:00+00:00"}]}`
+	const tokenV1 = `{"authorizationData": [{"authorizationToken": "QVdTOlRPS0VO", "expiresAt": 1609502400.0}]}`
+	expected := types.AuthConfig{
+		Username:      "AWS",
+		Password:      "TOKEN",
+		ServerAddress: registry,
+	}
+
+	tests := []struct {
+		description string
+		cached      map[string]ecrToken
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "iso 8601 expiry",
+			commands:    testutil.CmdRunOut(getToken, tokenV2),
+		},
+		{
+			description: "epoch expiry",
+			commands:    testutil.CmdRunOut(getToken, tokenV1),
+		},
+		{
+			description: "cached token",
+			cached:      map[string]ecrToken{registry: {auth: expected, expiresAt: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)}},
+		},
+		{
+			description: "renew expiring token",
+			cached:      map[string]ecrToken{registry: {auth: types.AuthConfig{Password: "OLD"}, expiresAt: time.Date(2021, 1, 1, 10, 1, 0, 0, time.UTC)}},
+			commands:    testutil.CmdRunOut(getToken, tokenV2),
+		},
+		{
+			description: "aws error",
+			commands:    testutil.CmdRunOutErr(getToken, "", errors.New("BUG")),
+			shouldErr:   true,
+		},
+		{
+			description: "invalid output",
+			commands:    testutil.CmdRunOut(getToken, `{"authorizationData": []}`),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cached := map[string]ecrToken{}
+			for k, v := range test.cached {
+				cached[k] = v
+			}
+			t.Override(&ecrTokens, cached)
+			t.Override(&timeNow, func() time.Time { return time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC) })
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			auth, err := ECRAuthConfig(registry)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(expected, auth)
+				t.CheckDeepEqual(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), ecrTokens[registry].expiresAt.UTC())
+			}
+		})
+	}
+}
+
+func TestEnsureECRRepository(t *testing.T) {
+	tests := []struct {
+		description    string
+		image          string
+		commands       util.Command
+		expectedOutput string
+		shouldErr      bool
+	}{
+		{
+			description: "not an ECR image",
+			image:       "gcr.io/project/app:tag",
+		},
+		{
+			description: "existing repository",
+			image:       "123456789012.dkr.ecr.us-east-1.amazonaws.com/team/app:tag",
+			commands:    testutil.CmdRunOut("aws ecr describe-repositories --repository-names team/app --registry-id 123456789012 --region us-east-1", "{}"),
+		},
+		{
+			description: "missing repository",
+			image:       "123456789012.dkr.ecr.us-east-1.amazonaws.com/app:tag",
+			commands: testutil.
+				CmdRunOutErr("aws ecr describe-repositories --repository-names app --registry-id 123456789012 --region us-east-1", "", errors.New("RepositoryNotFoundException")).
+				AndRunOut("aws ecr create-repository --repository-name app --registry-id 123456789012 --region us-east-1", "{}"),
+			expectedOutput: "Created ECR repository app\n",
+		},
+		{
+			description: "access denied",
+			image:       "123456789012.dkr.ecr.us-east-1.amazonaws.com/app:tag",
+			commands:    testutil.CmdRunOutErr("aws ecr describe-repositories --repository-names app --registry-id 123456789012 --region us-east-1", "", errors.New("AccessDeniedException")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			var out bytes.Buffer
+			err := EnsureECRRepository(context.Background(), &out, test.image)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Build builds a list of artifacts with Azure Container Registry Tasks.
+func (b *Builder) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]build.Artifact, error) {
+	builder := build.WithLogFile(b.buildArtifactWithACR, b.muted)
+	return build.InOrder(ctx, out, tags, artifacts, builder, b.AzureContainerRegistry.Concurrency)
+}
+
+// buildArtifactWithACR runs an `az acr build`, which uploads the workspace, streams the
+// build logs and pushes the image to the registry. The digest of the pushed image is then
+// retrieved from the registry so that the image can be deployed without a local Docker daemon.
+func (b *Builder) buildArtifactWithACR(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+	if artifact.DockerArtifact == nil {
+		return "", fmt.Errorf("artifact %q: only Docker artifacts can be built with ACR Tasks", artifact.ImageName)
+	}
+
+	registry, image, err := b.registryAndImage(tag)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := b.buildArgs(artifact.DockerArtifact, registry, image)
+	if err != nil {
+		return "", err
+	}
+	args = append(args, artifact.Workspace)
+
+	cmd := b.az(ctx, args...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return "", fmt.Errorf("running acr build: %w", err)
+	}
+
+	digest, err := b.digest(ctx, registry, image)
+	if err != nil {
+		return "", fmt.Errorf("getting image id from finished build: %w", err)
+	}
+
+	return build.TagWithDigest(tag, digest), nil
+}
+
+// registryAndImage returns the name of the registry that builds the given tag
+// and the name of the image relative to that registry.
+func (b *Builder) registryAndImage(tag string) (string, string, error) {
+	ref, err := docker.ParseReference(tag)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing image name %q: %w", tag, err)
+	}
+
+	registry := b.Registry
+	if registry == "" {
+		i := strings.Index(ref.Domain, ".azurecr.")
+		if i <= 0 {
+			return "", "", fmt.Errorf("extracting registry from image name %q: not an Azure Container Registry image", tag)
+		}
+		registry = ref.Domain[0:i]
+	}
+
+	if !strings.HasPrefix(ref.Domain, strings.ToLower(registry)+".azurecr.") {
+		return "", "", fmt.Errorf("image %q can't be pushed by registry %q. Set the default repo to its login server", tag, registry)
+	}
+
+	return registry, strings.TrimPrefix(tag, ref.Domain+"/"), nil
+}
+
+// buildArgs lists the arguments passed to `az` to build a given image.
+func (b *Builder) buildArgs(artifact *latest.DockerArtifact, registry, image string) ([]string, error) {
+	if artifact.Secret != nil {
+		return nil, errors.New("docker build secrets not currently supported in ACR Tasks builds")
+	}
+	if artifact.SSH != "" {
+		return nil, errors.New("docker build ssh not currently supported in ACR Tasks builds")
+	}
+	buildArgs, err := util.EvaluateEnvTemplateMap(artifact.BuildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+
+	ba, err := docker.ToCLIBuildArgs(artifact, buildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("getting docker build args: %w", err)
+	}
+
+	args := []string{"acr", "build", "--registry", registry, "--image", image, "--file", artifact.DockerfilePath}
+	args = append(args, ba...)
+	if b.Platform != "" {
+		args = append(args, "--platform", b.Platform)
+	}
+	if b.AgentPool != "" {
+		args = append(args, "--agent-pool", b.AgentPool)
+	}
+	if b.Timeout > 0 {
+		args = append(args, "--timeout", strconv.Itoa(b.Timeout))
+	}
+	if b.ResourceGroup != "" {
+		args = append(args, "--resource-group", b.ResourceGroup)
+	}
+
+	return args, nil
+}
+
+// digest retrieves the digest of an image pushed to the registry.
+func (b *Builder) digest(ctx context.Context, registry, image string) (string, error) {
+	out, err := util.RunCmdOut(b.az(ctx, "acr", "repository", "show", "--name", registry, "--image", image, "--query", "digest", "--output", "tsv"))
+	if err != nil {
+		return "", err
+	}
+
+	digest := strings.TrimSpace(string(out))
+	if digest == "" {
+		return "", fmt.Errorf("missing digest for image %q", image)
+	}
+	return digest, nil
+}
+
+func (b *Builder) az(ctx context.Context, args ...string) *exec.Cmd {
+	if b.Subscription != "" {
+		args = append(args, "--subscription", b.Subscription)
+	}
+	return exec.CommandContext(ctx, "az", args...)
+}
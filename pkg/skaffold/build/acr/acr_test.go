@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		description    string
+		acr            latest.AzureContainerRegistry
+		artifact       *latest.DockerArtifact
+		tag            string
+		commands       util.Command
+		expected       string
+		expectedOutput string
+		shouldErr      bool
+	}{
+		{
+			description: "registry guessed from the image name",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			tag:         "myregistry.azurecr.io/app:tag",
+			commands: testutil.
+				CmdRunWithOutput("az acr build --registry myregistry --image app:tag --file Dockerfile workspace", "Run ID: ca1 was successful\n").
+				AndRunOut("az acr repository show --name myregistry --image app:tag --query digest --output tsv", "sha256:abac\n"),
+			expected:       "myregistry.azurecr.io/app:tag@sha256:abac",
+			expectedOutput: "Run ID: ca1 was successful\n",
+		},
+		{
+			description: "build options",
+			acr: latest.AzureContainerRegistry{
+				Registry:      "MyRegistry",
+				ResourceGroup: "group",
+				Subscription:  "sub",
+				Platform:      "linux/arm64",
+				AgentPool:     "pool",
+				Timeout:       600,
+			},
+			artifact: &latest.DockerArtifact{
+				DockerfilePath: "docker/Dockerfile",
+				Target:         "prod",
+				BuildArgs:      map[string]*string{"B": util.StringPtr("2"), "A": nil},
+				CacheFrom:      []string{"myregistry.azurecr.io/org/app:cache"},
+				NetworkMode:    "Host",
+				NoCache:        true,
+			},
+			tag: "myregistry.azurecr.io/org/app:tag",
+			commands: testutil.
+				CmdRun("az acr build --registry MyRegistry --image org/app:tag --file docker/Dockerfile --build-arg A --build-arg B=2 --cache-from myregistry.azurecr.io/org/app:cache --target prod --network host --no-cache --platform linux/arm64 --agent-pool pool --timeout 600 --resource-group group workspace --subscription sub").
+				AndRunOut("az acr repository show --name MyRegistry --image org/app:tag --query digest --output tsv --subscription sub", "sha256:abac"),
+			expected: "myregistry.azurecr.io/org/app:tag@sha256:abac",
+		},
+		{
+			description: "not an azure registry",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			tag:         "gcr.io/project/app:tag",
+			shouldErr:   true,
+		},
+		{
+			description: "image pushed to another registry",
+			acr:         latest.AzureContainerRegistry{Registry: "other"},
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			tag:         "myregistry.azurecr.io/app:tag",
+			shouldErr:   true,
+		},
+		{
+			description: "secrets are not supported",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile", Secret: &latest.DockerSecret{ID: "secret"}},
+			tag:         "myregistry.azurecr.io/app:tag",
+			shouldErr:   true,
+		},
+		{
+			description: "build failure",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			tag:         "myregistry.azurecr.io/app:tag",
+			commands:    testutil.CmdRunErr("az acr build --registry myregistry --image app:tag --file Dockerfile workspace", errors.New("run failed")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			builder := NewBuilder(&acrConfig{acr: test.acr})
+			artifact := &latest.Artifact{
+				ImageName: "app",
+				Workspace: "workspace",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: test.artifact,
+				},
+			}
+
+			var out bytes.Buffer
+			tag, err := builder.buildArtifactWithACR(context.Background(), &out, artifact, test.tag)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
+
+type acrConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	acr                   latest.AzureContainerRegistry
+}
+
+func (c *acrConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Build.BuildType.AzureContainerRegistry = &c.acr
+	return pipeline
+}
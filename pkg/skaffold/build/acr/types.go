@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acr
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// Builder builds artifacts with Azure Container Registry Tasks.
+type Builder struct {
+	*latest.AzureContainerRegistry
+
+	cfg   Config
+	muted build.Muted
+}
+
+type Config interface {
+	docker.Config
+
+	Pipeline() latest.Pipeline
+	Muted() config.Muted
+}
+
+// NewBuilder creates a new Builder that builds artifacts with Azure Container Registry Tasks.
+func NewBuilder(cfg Config) *Builder {
+	return &Builder{
+		AzureContainerRegistry: cfg.Pipeline().Build.AzureContainerRegistry,
+		cfg:                    cfg,
+		muted:                  cfg.Muted(),
+	}
+}
+
+func (b *Builder) Prune(ctx context.Context, out io.Writer) error {
+	return nil // noop
+}
@@ -26,6 +26,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -41,14 +44,14 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Art
 	}
 
 	if b.pushImages {
-		return docker.Push(tarPath, tag, b.cfg)
+		return docker.Push(ctx, tarPath, tag, b.cfg)
 	}
 	return b.loadImage(ctx, out, tarPath, a, tag)
 }
 
 func (b *Builder) buildTar(ctx context.Context, out io.Writer, workspace string, a *latest.BazelArtifact) (string, error) {
-	if !strings.HasSuffix(a.BuildTarget, ".tar") {
-		return "", errors.New("the bazel build target should end with .tar, see https://github.com/bazelbuild/rules_docker#using-with-docker-locally")
+	if a.TarPath == "" && !strings.HasSuffix(a.BuildTarget, ".tar") {
+		return "", errors.New("the bazel build target should end with .tar or `tarPath` should be set, see https://github.com/bazelbuild/rules_docker#using-with-docker-locally")
 	}
 
 	args := []string{"build"}
@@ -69,11 +72,17 @@ func (b *Builder) buildTar(ctx context.Context, out io.Writer, workspace string,
 		return "", fmt.Errorf("getting path of bazel-bin: %w", err)
 	}
 
-	tarPath := filepath.Join(bazelBin, buildTarPath(a.BuildTarget))
-	return tarPath, nil
+	if a.TarPath != "" {
+		return filepath.Join(bazelBin, filepath.FromSlash(a.TarPath)), nil
+	}
+	return filepath.Join(bazelBin, buildTarPath(a.BuildTarget)), nil
 }
 
 func (b *Builder) loadImage(ctx context.Context, out io.Writer, tarPath string, a *latest.BazelArtifact, tag string) (string, error) {
+	if a.TarPath != "" {
+		return b.loadRetaggedImage(ctx, out, tarPath, tag)
+	}
+
 	imageTar, err := os.Open(tarPath)
 	if err != nil {
 		return "", fmt.Errorf("opening image tarball: %w", err)
@@ -93,6 +102,32 @@ func (b *Builder) loadImage(ctx context.Context, out io.Writer, tarPath string,
 	return imageID, nil
 }
 
+// loadRetaggedImage loads the single image of a tarball with the given tag,
+// since the tag of images built by custom targets can't be guessed.
+func (b *Builder) loadRetaggedImage(ctx context.Context, out io.Writer, tarPath string, tag string) (string, error) {
+	img, err := tarball.ImageFromPath(tarPath, nil)
+	if err != nil {
+		return "", fmt.Errorf("reading image tarball: %w", err)
+	}
+
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(tarball.Write(ref, img, w))
+	}()
+	defer r.Close()
+
+	imageID, err := b.localDocker.Load(ctx, out, r, tag)
+	if err != nil {
+		return "", fmt.Errorf("loading image into docker daemon: %w", err)
+	}
+	return imageID, nil
+}
+
 func bazelBin(ctx context.Context, workspace string, a *latest.BazelArtifact) (string, error) {
 	args := []string{"info", "bazel-bin"}
 	args = append(args, a.BuildArgs...)
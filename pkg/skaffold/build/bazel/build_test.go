@@ -17,10 +17,19 @@ limitations under the License.
 package bazel
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
+	"io"
 	"io/ioutil"
+	"os"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -49,6 +58,35 @@ func TestBuildBazel(t *testing.T) {
 	})
 }
 
+func TestBuildBazelWithTarPath(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Mkdir("bin/app").Chdir()
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("bazel build --config=remote //app:bundle").
+			AndRunOut("bazel info bazel-bin --config=remote", tmpDir.Path("bin")))
+		writeImageTar(t, "bazel/app:bundle", tmpDir.Path("bin/app/bundle.tar"))
+
+		artifact := &latest.Artifact{
+			Workspace: ".",
+			ArtifactType: latest.ArtifactType{
+				BazelArtifact: &latest.BazelArtifact{
+					BuildTarget: "//app:bundle",
+					BuildArgs:   []string{"--config=remote"},
+					TarPath:     "app/bundle.tar",
+				},
+			},
+		}
+
+		localDocker := &fakeLoader{}
+		builder := NewArtifactBuilder(localDocker, &mockConfig{}, false)
+		imageID, err := builder.Build(context.Background(), ioutil.Discard, artifact, "img:tag")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("sha256:loaded", imageID)
+		t.CheckDeepEqual([]string{"img:tag"}, localDocker.repoTags)
+	})
+}
+
 func TestBuildBazelFailInvalidTarget(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		artifact := &latest.Artifact{
@@ -62,7 +100,7 @@ func TestBuildBazelFailInvalidTarget(t *testing.T) {
 		builder := NewArtifactBuilder(nil, &mockConfig{}, false)
 		_, err := builder.Build(context.Background(), ioutil.Discard, artifact, "img:tag")
 
-		t.CheckErrorContains("the bazel build target should end with .tar", err)
+		t.CheckErrorContains("the bazel build target should end with .tar or `tarPath` should be set", err)
 	})
 }
 
@@ -102,8 +140,54 @@ func fakeLocalDaemon() docker.LocalDaemon {
 	return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, nil, false, nil)
 }
 
+// fakeLoader records the tags of the image it loads.
+type fakeLoader struct {
+	docker.LocalDaemon
+	repoTags []string
+}
+
+func (f *fakeLoader) Load(_ context.Context, _ io.Writer, input io.Reader, ref string) (string, error) {
+	tmp, err := ioutil.TempFile("", "image*.tar")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, input); err != nil {
+		return "", err
+	}
+	tmp.Close()
+
+	tag, err := name.NewTag(ref, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+	if _, err := tarball.ImageFromPath(tmp.Name(), &tag); err != nil {
+		return "", err
+	}
+	f.repoTags = append(f.repoTags, ref)
+	return "sha256:loaded", nil
+}
+
+func writeImageTar(t *testutil.T, ref, path string) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	t.CheckNoError(tw.WriteHeader(&tar.Header{Name: "file", Typeflag: tar.TypeReg, Size: 4}))
+	_, err := tw.Write([]byte("data"))
+	t.CheckNoError(err)
+	t.CheckNoError(tw.Close())
+
+	layer, err := tarball.LayerFromReader(&buf)
+	t.CheckNoError(err)
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	t.CheckNoError(err)
+	tag, err := name.NewTag(ref)
+	t.CheckNoError(err)
+	t.CheckNoError(tarball.WriteToFile(path, tag, img))
+}
+
 type mockConfig struct {
 	docker.Config
 }
 
 func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+func (c *mockConfig) UsePodman() bool                        { return false }
@@ -19,6 +19,7 @@ package build
 import (
 	"context"
 	"io"
+	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -30,6 +31,14 @@ type Artifact struct {
 	Tag       string `json:"tag"`
 }
 
+// Digest returns the digest of the built image when its tag references one, as in `image:tag@sha256:...`.
+func (a Artifact) Digest() string {
+	if i := strings.LastIndex(a.Tag, "@"); i >= 0 {
+		return a.Tag[i+1:]
+	}
+	return ""
+}
+
 // Builder is an interface to the Build API of Skaffold.
 // It must build and make the resulting image accessible to the cluster.
 // This could include pushing to a authorized repository or loading the nodes with the image.
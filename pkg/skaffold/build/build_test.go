@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestArtifactDigest(t *testing.T) {
+	tests := []struct {
+		description string
+		tag         string
+		expected    string
+	}{
+		{
+			description: "tag and digest",
+			tag:         "gcr.io/project/image:v1@sha256:abacabacabacabacabacabacabacabacabacabacabacabacabacabacabacabac",
+			expected:    "sha256:abacabacabacabacabacabacabacabacabacabacabacabacabacabacabacabac",
+		},
+		{
+			description: "digest only",
+			tag:         "localhost:5000/image@sha256:abac",
+			expected:    "sha256:abac",
+		},
+		{
+			description: "no digest",
+			tag:         "localhost:5000/image:v1",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, Artifact{ImageName: "image", Tag: test.tag}.Digest())
+		})
+	}
+}
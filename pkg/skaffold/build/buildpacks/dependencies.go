@@ -21,10 +21,12 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/list"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
 )
 
 // GetDependencies returns dependencies listed for a buildpack artifact
-func GetDependencies(ctx context.Context, workspace string, a *latest.BuildpackArtifact) ([]string, error) {
+// When filter is not nil, only the files it matches are listed.
+func GetDependencies(ctx context.Context, workspace string, a *latest.BuildpackArtifact, filter walk.Predicate) ([]string, error) {
 	// TODO(dgageot): Support project.toml include/exclude.
-	return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore)
+	return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore, filter)
 }
@@ -73,7 +73,7 @@ func TestGetDependencies(t *testing.T) {
 					Paths:  test.paths,
 					Ignore: test.ignore,
 				},
-			})
+			}, nil)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, deps)
 		})
@@ -61,6 +61,7 @@ type DependencyLister func(ctx context.Context, artifact *latest.Artifact) ([]st
 type Config interface {
 	docker.Config
 
+	Pipeline() latest.Pipeline
 	CacheArtifacts() bool
 	CacheFile() string
 	Mode() config.RunMode
@@ -97,7 +98,7 @@ func NewCache(cfg Config, imagesAreLocal bool, tryImportMissing bool, dependenci
 		imagesAreLocal:   imagesAreLocal,
 		tryImportMissing: tryImportMissing,
 		hashForArtifact: func(ctx context.Context, a *latest.Artifact) (string, error) {
-			return getHashForArtifact(ctx, dependencies, a, cfg.Mode())
+			return getHashForArtifactWithDependencies(ctx, dependencies, a, cfg.Pipeline().Build.Artifacts, cfg.Mode())
 		},
 	}, nil
 }
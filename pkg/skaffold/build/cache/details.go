@@ -21,6 +21,7 @@ import (
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 )
 
 type cacheDetails interface {
@@ -86,7 +87,10 @@ func (d needsRemoteTagging) Hash() string {
 
 func (d needsRemoteTagging) Tag(ctx context.Context, c *cache) error {
 	fqn := d.tag + "@" + d.digest // Tag is not important. We just need the registry and the digest to locate the image.
-	return docker.AddRemoteTag(fqn, d.tag, c.cfg)
+	_, endTrace := tracing.StartSpan(ctx, "Push", map[string]string{"image": d.tag})
+	err := docker.AddRemoteTag(fqn, d.tag, c.cfg)
+	endTrace(err)
+	return err
 }
 
 // Found locally. Needs pushing
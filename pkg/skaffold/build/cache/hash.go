@@ -26,6 +26,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -81,6 +82,11 @@ func getHashForArtifact(ctx context.Context, depLister DependencyLister, a *late
 		inputs = append(inputs, args...)
 	}
 
+	// add the target platforms for the artifact if specified
+	if len(a.Platforms) > 0 {
+		inputs = append(inputs, strings.Join(a.Platforms, ","))
+	}
+
 	// get a key for the hashes
 	hasher := sha256.New()
 	enc := json.NewEncoder(hasher)
@@ -91,6 +97,44 @@ func getHashForArtifact(ctx context.Context, depLister DependencyLister, a *late
 	return hex.EncodeToString(hasher.Sum(nil)), nil
 }
 
+// InputDigest computes the digest of the build inputs of an artifact, including its required artifacts,
+// the same way as the artifact cache does. The build args are always evaluated in build mode so that
+// the digest doesn't change between dev, build and run.
+func InputDigest(ctx context.Context, depLister DependencyLister, a *latest.Artifact, artifacts []*latest.Artifact) (string, error) {
+	return getHashForArtifactWithDependencies(ctx, depLister, a, artifacts, config.RunModes.Build)
+}
+
+// getHashForArtifactWithDependencies hashes the artifact together with the artifacts it requires,
+// so that a change in a required artifact also invalidates the artifacts that depend on it.
+func getHashForArtifactWithDependencies(ctx context.Context, depLister DependencyLister, a *latest.Artifact, artifacts []*latest.Artifact, mode config.RunMode) (string, error) {
+	hash, err := getHashForArtifact(ctx, depLister, a, mode)
+	if err != nil || len(a.Dependencies) == 0 {
+		return hash, err
+	}
+
+	inputs := []string{hash}
+	for _, d := range a.Dependencies {
+		for _, required := range artifacts {
+			if required.ImageName != d.ImageName {
+				continue
+			}
+			requiredHash, err := getHashForArtifactWithDependencies(ctx, depLister, required, artifacts, mode)
+			if err != nil {
+				return "", fmt.Errorf("getting hash for required artifact %q: %w", d.ImageName, err)
+			}
+			inputs = append(inputs, requiredHash)
+		}
+	}
+
+	hasher := sha256.New()
+	enc := json.NewEncoder(hasher)
+	if err := enc.Encode(inputs); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
 // TODO(dgageot): when the buildpacks builder image digest changes, we need to change the hash
 func artifactConfig(a *latest.Artifact) (string, error) {
 	buf, err := json.Marshal(a.ArtifactType)
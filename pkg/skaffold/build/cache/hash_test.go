@@ -121,6 +121,15 @@ func TestGetHashForArtifact(t *testing.T) {
 			mode:     config.RunModes.Dev,
 			expected: "f3f710a4ec1d1bfb2a9b8ef2b4b7cc5f254102d17095a71872821b396953a4ce",
 		},
+		{
+			description:  "platforms",
+			dependencies: []string{"a", "b"},
+			artifact: &latest.Artifact{
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			mode:     config.RunModes.Dev,
+			expected: "d9334fa65e6fbec2ce4c94ef15c0832451f5a7b095b548ab6ad3fd962c5d9121",
+		},
 		{
 			description:  "buildpack in dev mode",
 			dependencies: []string{"a", "b"},
@@ -164,6 +173,64 @@ func TestGetHashForArtifact(t *testing.T) {
 	}
 }
 
+func TestGetHashForArtifactWithDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&hashFunction, mockCacheHasher)
+		t.Override(&artifactConfigFunction, fakeArtifactConfig)
+
+		base := &latest.Artifact{ImageName: "base"}
+		app := &latest.Artifact{ImageName: "app", Dependencies: []*latest.ArtifactDependency{{ImageName: "base", Alias: "BASE"}}}
+		artifacts := []*latest.Artifact{base, app}
+
+		baseDependencies := []string{"a"}
+		depLister := func(_ context.Context, a *latest.Artifact) ([]string, error) {
+			if a.ImageName == "base" {
+				return baseDependencies, nil
+			}
+			return []string{"b"}, nil
+		}
+
+		baseHash, err := getHashForArtifactWithDependencies(context.Background(), depLister, base, artifacts, config.RunModes.Dev)
+		t.CheckNoError(err)
+		withoutDependencies, err := getHashForArtifact(context.Background(), depLister, base, config.RunModes.Dev)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(withoutDependencies, baseHash)
+
+		appHash, err := getHashForArtifactWithDependencies(context.Background(), depLister, app, artifacts, config.RunModes.Dev)
+		t.CheckNoError(err)
+
+		baseDependencies = []string{"a", "c"}
+		updatedAppHash, err := getHashForArtifactWithDependencies(context.Background(), depLister, app, artifacts, config.RunModes.Dev)
+		t.CheckNoError(err)
+		t.CheckFalse(appHash == updatedAppHash)
+	})
+}
+
+func TestInputDigest(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&hashFunction, mockCacheHasher)
+		t.Override(&artifactConfigFunction, fakeArtifactConfig)
+
+		tmpDir := t.NewTempDir().Write("Dockerfile", "FROM scratch\nARG SKAFFOLD_RUN_MODE\n")
+		artifact := &latest.Artifact{
+			ImageName:    "image",
+			Workspace:    tmpDir.Root(),
+			ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}},
+		}
+		artifacts := []*latest.Artifact{artifact}
+
+		digest, err := InputDigest(context.Background(), stubDependencyLister([]string{"a"}), artifact, artifacts)
+		t.CheckNoError(err)
+		inBuild, err := getHashForArtifactWithDependencies(context.Background(), stubDependencyLister([]string{"a"}), artifact, artifacts, config.RunModes.Build)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(inBuild, digest)
+
+		inDev, err := getHashForArtifactWithDependencies(context.Background(), stubDependencyLister([]string{"a"}), artifact, artifacts, config.RunModes.Dev)
+		t.CheckNoError(err)
+		t.CheckFalse(inDev == digest)
+	})
+}
+
 func TestArtifactConfig(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		config1, err := artifactConfig(&latest.Artifact{
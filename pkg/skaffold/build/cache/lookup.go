@@ -124,6 +124,21 @@ func (c *cache) tryImport(ctx context.Context, a *latest.Artifact, tag string, h
 
 	entry := ImageDetails{}
 
+	// Images that are pushed can be referenced directly in the registry, without being pulled.
+	if !c.imagesAreLocal {
+		digest, err := docker.RemoteDigest(tag, c.cfg)
+		if err != nil {
+			return entry, err
+		}
+		logrus.Debugf("Found artifact %s in the docker registry", tag)
+		entry.Digest = digest
+
+		c.cacheMutex.Lock()
+		c.artifactCache[hash] = entry
+		c.cacheMutex.Unlock()
+		return entry, nil
+	}
+
 	if !c.client.ImageExists(ctx, tag) {
 		logrus.Debugf("Importing artifact %s from docker registry", tag)
 		err := c.client.Pull(ctx, ioutil.Discard, tag)
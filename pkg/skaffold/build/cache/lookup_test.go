@@ -127,11 +127,13 @@ func TestLookupLocal(t *testing.T) {
 
 func TestLookupRemote(t *testing.T) {
 	tests := []struct {
-		description string
-		hasher      func(context.Context, *latest.Artifact) (string, error)
-		cache       map[string]ImageDetails
-		api         *testutil.FakeAPIClient
-		expected    cacheDetails
+		description      string
+		hasher           func(context.Context, *latest.Artifact) (string, error)
+		cache            map[string]ImageDetails
+		api              *testutil.FakeAPIClient
+		tryImportMissing bool
+		tag              string
+		expected         cacheDetails
 	}{
 		{
 			description: "miss",
@@ -140,6 +142,21 @@ func TestLookupRemote(t *testing.T) {
 			cache:       map[string]ImageDetails{},
 			expected:    needsBuilding{hash: "hash"},
 		},
+		{
+			description:      "import from the registry",
+			hasher:           mockHasher("hash"),
+			cache:            map[string]ImageDetails{},
+			tryImportMissing: true,
+			expected:         found{hash: "hash"},
+		},
+		{
+			description:      "missing from the registry",
+			hasher:           mockHasher("hash"),
+			cache:            map[string]ImageDetails{},
+			tryImportMissing: true,
+			tag:              "missing",
+			expected:         needsBuilding{hash: "hash"},
+		},
 		{
 			description: "hash failure",
 			hasher:      failingHasher("BUG"),
@@ -193,13 +210,19 @@ func TestLookupRemote(t *testing.T) {
 				}
 			})
 
+			tag := test.tag
+			if tag == "" {
+				tag = "tag"
+			}
+
 			cache := &cache{
-				imagesAreLocal:  false,
-				artifactCache:   test.cache,
-				client:          fakeLocalDaemon(test.api),
-				hashForArtifact: test.hasher,
+				imagesAreLocal:   false,
+				artifactCache:    test.cache,
+				client:           fakeLocalDaemon(test.api),
+				hashForArtifact:  test.hasher,
+				tryImportMissing: test.tryImportMissing,
 			}
-			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest.Artifact{{
+			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": tag}, []*latest.Artifact{{
 				ImageName: "artifact",
 			}})
 
@@ -113,7 +113,16 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 
 	logrus.Infoln("Cache check complete in", time.Since(start))
 
-	bRes, err := buildAndTest(ctx, out, tags, needToBuild)
+	// Artifacts that need building can refer to the required artifacts that are already built.
+	buildTags := make(tag.ImageTags, len(tags))
+	for imageName, t := range tags {
+		buildTags[imageName] = t
+	}
+	for _, b := range alreadyBuilt {
+		buildTags[b.ImageName] = b.Tag
+	}
+
+	bRes, err := buildAndTest(ctx, out, buildTags, needToBuild)
 	if err != nil {
 		return nil, err
 	}
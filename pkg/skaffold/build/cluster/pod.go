@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -234,12 +235,12 @@ func resourceRequirements(rr *latest.ResourceRequirements) v1.ResourceRequiremen
 }
 
 func kanikoArgs(artifact *latest.KanikoArtifact, tag string, insecureRegistries map[string]bool) ([]string, error) {
-	for reg := range insecureRegistries {
-		artifact.InsecureRegistry = append(artifact.InsecureRegistry, reg)
-	}
+	// Don't modify the artifact's configuration, which is reused between builds.
+	withRegistries := *artifact
+	withRegistries.InsecureRegistry = mergeInsecureRegistries(artifact.InsecureRegistry, insecureRegistries)
 
 	// Create pod spec
-	args, err := kaniko.Args(artifact, tag, fmt.Sprintf("dir://%s", kaniko.DefaultEmptyDirMountPath))
+	args, err := kaniko.Args(&withRegistries, tag, fmt.Sprintf("dir://%s", kaniko.DefaultEmptyDirMountPath))
 	if err != nil {
 		return nil, fmt.Errorf("unable build kaniko args: %w", err)
 	}
@@ -248,3 +249,23 @@ func kanikoArgs(artifact *latest.KanikoArtifact, tag string, insecureRegistries
 
 	return args, nil
 }
+
+// mergeInsecureRegistries adds the globally configured insecure registries to
+// the ones configured on the artifact, without duplicates.
+func mergeInsecureRegistries(artifactRegistries []string, insecureRegistries map[string]bool) []string {
+	var extra []string
+	for reg := range insecureRegistries {
+		extra = append(extra, reg)
+	}
+	sort.Strings(extra)
+
+	seen := map[string]bool{}
+	var merged []string
+	for _, reg := range append(artifactRegistries, extra...) {
+		if !seen[reg] {
+			seen[reg] = true
+			merged = append(merged, reg)
+		}
+	}
+	return merged
+}
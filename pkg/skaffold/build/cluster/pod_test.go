@@ -124,6 +124,19 @@ func TestKanikoArgs(t *testing.T) {
 			insecureRegistries: map[string]bool{"localhost:4000": true},
 			expectedArgs:       []string{kaniko.InsecureRegistryFlag, "localhost:4000"},
 		},
+		{
+			description: "insecure registries are merged with the artifact's",
+			artifact: &latest.KanikoArtifact{
+				DockerfilePath:   "Dockerfile",
+				InsecureRegistry: []string{"localhost:5000", "localhost:4000"},
+			},
+			insecureRegistries: map[string]bool{"localhost:4000": true, "my.registry": true},
+			expectedArgs: []string{
+				kaniko.InsecureRegistryFlag, "localhost:5000",
+				kaniko.InsecureRegistryFlag, "localhost:4000",
+				kaniko.InsecureRegistryFlag, "my.registry",
+			},
+		},
 		{
 			description: "skip tls",
 			artifact: &latest.KanikoArtifact{
@@ -434,3 +447,20 @@ func TestResourceRequirements(t *testing.T) {
 		})
 	}
 }
+
+func TestKanikoArgsDontModifyArtifact(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifact := &latest.KanikoArtifact{
+			DockerfilePath: "Dockerfile",
+		}
+		insecureRegistries := map[string]bool{"localhost:4000": true}
+
+		_, err := kanikoArgs(artifact, "gcr.io/tag", insecureRegistries)
+		t.CheckNoError(err)
+		args, err := kanikoArgs(artifact, "gcr.io/tag", insecureRegistries)
+		t.CheckNoError(err)
+
+		t.CheckEmpty(artifact.InsecureRegistry)
+		t.CheckDeepEqual([]string{"--destination", "gcr.io/tag", "--dockerfile", "Dockerfile", "--context", "dir:///kaniko/buildcontext", kaniko.InsecureRegistryFlag, "localhost:4000"}, args)
+	})
+}
@@ -94,6 +94,7 @@ type mockConfig struct {
 func (c *mockConfig) GetKubeContext() string                 { return c.kubeContext }
 func (c *mockConfig) GetKubeNamespace() string               { return c.namespace }
 func (c *mockConfig) GetInsecureRegistries() map[string]bool { return c.insecureRegistries }
+func (c *mockConfig) UsePodman() bool                        { return false }
 func (c *mockConfig) Pipeline() latest.Pipeline {
 	var pipeline latest.Pipeline
 	pipeline.Build.BuildType.Cluster = &c.cluster
@@ -0,0 +1,347 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codebuild
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	shell "github.com/kballard/go-shellquote"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+var (
+	// ecrRegistry matches the domain of an Amazon ECR registry and captures its region.
+	ecrRegistry = regexp.MustCompile(`^\d+\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com(\.cn)?$`)
+
+	// for testing
+	randomID     = util.RandomID
+	remoteDigest = docker.RemoteDigest
+)
+
+// Build builds a list of artifacts with AWS CodeBuild.
+func (b *Builder) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]build.Artifact, error) {
+	builder := build.WithLogFile(b.buildArtifactWithCodeBuild, b.muted)
+	return build.InOrder(ctx, out, tags, artifacts, builder, b.AWSCodeBuild.Concurrency)
+}
+
+func (b *Builder) buildArtifactWithCodeBuild(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+	if artifact.DockerArtifact == nil {
+		return "", fmt.Errorf("artifact %q: only Docker artifacts can be built with CodeBuild", artifact.ImageName)
+	}
+
+	spec, err := buildSpec(artifact.DockerArtifact, tag)
+	if err != nil {
+		return "", fmt.Errorf("could not create build description: %w", err)
+	}
+
+	dependencies, err := build.DependenciesForArtifact(ctx, artifact, b.cfg)
+	if err != nil {
+		return "", fmt.Errorf("getting dependencies for %q: %w", artifact.ImageName, err)
+	}
+
+	sourceObject := fmt.Sprintf("source/%s-%s.tar.gz", b.ProjectName, randomID())
+	color.Default.Fprintf(out, "Pushing code to s3://%s/%s\n", b.Bucket, sourceObject)
+
+	if err := b.uploadSources(ctx, artifact, sourceObject, dependencies); err != nil {
+		return "", fmt.Errorf("uploading source tarball: %w", err)
+	}
+
+	buildID, err := b.startBuild(ctx, sourceObject, spec)
+	if err != nil {
+		return "", fmt.Errorf("could not start build: %w", err)
+	}
+	color.Default.Fprintf(out, "Started CodeBuild build %s\n", buildID)
+
+	var logsToken string
+watch:
+	for {
+		status, err := b.buildStatus(ctx, buildID)
+		if err != nil {
+			return "", fmt.Errorf("getting build status: %w", err)
+		}
+
+		if status.Logs.GroupName != "" && status.Logs.StreamName != "" {
+			logsToken, err = b.streamLogs(ctx, out, status.Logs, logsToken)
+			if err != nil {
+				return "", fmt.Errorf("copying logs to stdout: %w", err)
+			}
+		}
+
+		switch status.BuildStatus {
+		case StatusInProgress:
+		case StatusSucceeded:
+			break watch
+		case StatusFailed, StatusFault, StatusTimedOut, StatusStopped:
+			return "", fmt.Errorf("codebuild build failed: %s", status.BuildStatus)
+		default:
+			return "", fmt.Errorf("unknown status: %s", status.BuildStatus)
+		}
+
+		time.Sleep(RetryDelay)
+	}
+
+	if _, err := util.RunCmdOut(b.aws(ctx, "s3", "rm", b.sourceURL(sourceObject))); err != nil {
+		return "", fmt.Errorf("cleaning up source tar after build: %w", err)
+	}
+	logrus.Infof("Deleted object %s", sourceObject)
+
+	digest, err := remoteDigest(tag, b.cfg)
+	if err != nil {
+		return "", fmt.Errorf("getting image id from finished build: %w", err)
+	}
+
+	return build.TagWithDigest(tag, digest), nil
+}
+
+// uploadSources streams a tarball of the artifact's sources to S3.
+func (b *Builder) uploadSources(ctx context.Context, a *latest.Artifact, object string, dependencies []string) error {
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	go func() {
+		if err := util.CreateTarGz(writer, a.Workspace, dependencies); err != nil {
+			writer.CloseWithError(err)
+		} else {
+			writer.Close()
+		}
+	}()
+
+	cmd := b.aws(ctx, "s3", "cp", "-", b.sourceURL(object))
+	cmd.Stdin = reader
+	_, err := util.RunCmdOut(cmd)
+	return err
+}
+
+// startBuild starts a build of the CodeBuild project with the uploaded sources
+// and the given buildspec. It returns the ID of the build.
+func (b *Builder) startBuild(ctx context.Context, object string, spec string) (string, error) {
+	args := []string{"codebuild", "start-build",
+		"--project-name", b.ProjectName,
+		"--source-type-override", "S3",
+		"--source-location-override", b.Bucket + "/" + object,
+		"--buildspec-override", spec,
+		"--image-override", b.Image,
+		"--privileged-mode-override",
+	}
+	if b.ComputeType != "" {
+		args = append(args, "--compute-type-override", b.ComputeType)
+	}
+	if b.TimeoutMinutes > 0 {
+		args = append(args, "--timeout-in-minutes-override", strconv.Itoa(b.TimeoutMinutes))
+	}
+	args = append(args, "--query", "build.id", "--output", "text")
+
+	out, err := util.RunCmdOut(b.aws(ctx, args...))
+	if err != nil {
+		return "", err
+	}
+
+	buildID := strings.TrimSpace(string(out))
+	if buildID == "" {
+		return "", errors.New("missing build ID")
+	}
+	return buildID, nil
+}
+
+type buildLogs struct {
+	GroupName  string `json:"groupName"`
+	StreamName string `json:"streamName"`
+}
+
+type buildInfo struct {
+	BuildStatus string    `json:"buildStatus"`
+	Logs        buildLogs `json:"logs"`
+}
+
+func (b *Builder) buildStatus(ctx context.Context, buildID string) (*buildInfo, error) {
+	var builds struct {
+		Builds []buildInfo `json:"builds"`
+	}
+	if err := b.awsJSON(ctx, &builds, "codebuild", "batch-get-builds", "--ids", buildID); err != nil {
+		return nil, err
+	}
+	if len(builds.Builds) != 1 {
+		return nil, fmt.Errorf("build %s not found", buildID)
+	}
+	return &builds.Builds[0], nil
+}
+
+// streamLogs copies the log events that were not seen yet to the output.
+// It returns the token to use to get the next events.
+func (b *Builder) streamLogs(ctx context.Context, out io.Writer, logs buildLogs, token string) (string, error) {
+	for {
+		args := []string{"logs", "get-log-events", "--log-group-name", logs.GroupName, "--log-stream-name", logs.StreamName, "--start-from-head"}
+		if token != "" {
+			args = append(args, "--next-token", token)
+		}
+
+		var events struct {
+			Events []struct {
+				Message string `json:"message"`
+			} `json:"events"`
+			NextForwardToken string `json:"nextForwardToken"`
+		}
+		if err := b.awsJSON(ctx, &events, args...); err != nil {
+			// The log stream is created a bit after the build starts.
+			logrus.Debugf("Logs for %s %s not available yet: %v", logs.GroupName, logs.StreamName, err)
+			return token, nil
+		}
+
+		for _, e := range events.Events {
+			message := e.Message
+			if !strings.HasSuffix(message, "\n") {
+				message += "\n"
+			}
+			if _, err := io.WriteString(out, message); err != nil {
+				return token, err
+			}
+		}
+
+		// The same token is returned when the end of the stream is reached.
+		if events.NextForwardToken == "" || events.NextForwardToken == token {
+			return token, nil
+		}
+		token = events.NextForwardToken
+	}
+}
+
+func (b *Builder) awsJSON(ctx context.Context, v interface{}, args ...string) error {
+	out, err := util.RunCmdOut(b.aws(ctx, append(args, "--output", "json")...))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(out, v)
+}
+
+func (b *Builder) aws(ctx context.Context, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "aws", append(args, b.globalFlags()...)...)
+}
+
+func (b *Builder) globalFlags() []string {
+	var flags []string
+	if b.Region != "" {
+		flags = append(flags, "--region", b.Region)
+	}
+	if b.Profile != "" {
+		flags = append(flags, "--profile", b.Profile)
+	}
+	return flags
+}
+
+func (b *Builder) sourceURL(object string) string {
+	return fmt.Sprintf("s3://%s/%s", b.Bucket, object)
+}
+
+type phase struct {
+	Commands []string `yaml:"commands"`
+}
+
+type phases struct {
+	PreBuild *phase `yaml:"pre_build,omitempty"`
+	Build    phase  `yaml:"build"`
+}
+
+type spec struct {
+	Version string `yaml:"version"`
+	Phases  phases `yaml:"phases"`
+}
+
+// buildSpec generates the buildspec that builds and pushes a Docker image.
+// See https://docs.aws.amazon.com/codebuild/latest/userguide/build-spec-ref.html
+func buildSpec(artifact *latest.DockerArtifact, tag string) (string, error) {
+	args, err := dockerBuildArgs(artifact, tag)
+	if err != nil {
+		return "", err
+	}
+
+	var s spec
+	s.Version = "0.2"
+
+	if login := ecrLogin(tag); login != "" {
+		s.Phases.PreBuild = &phase{Commands: []string{login}}
+	}
+
+	for _, cacheFrom := range artifact.CacheFrom {
+		s.Phases.Build.Commands = append(s.Phases.Build.Commands, fmt.Sprintf("docker pull %s || true", shell.Join(cacheFrom)))
+	}
+	s.Phases.Build.Commands = append(s.Phases.Build.Commands,
+		shell.Join(append([]string{"docker"}, args...)...),
+		shell.Join("docker", "push", tag),
+	)
+
+	buf, err := yaml.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// dockerBuildArgs lists the arguments passed to `docker` to build a given image.
+func dockerBuildArgs(artifact *latest.DockerArtifact, tag string) ([]string, error) {
+	if artifact.Secret != nil {
+		return nil, errors.New("docker build secrets not currently supported in CodeBuild builds")
+	}
+	if artifact.SSH != "" {
+		return nil, errors.New("docker build ssh not currently supported in CodeBuild builds")
+	}
+	buildArgs, err := util.EvaluateEnvTemplateMap(artifact.BuildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+
+	ba, err := docker.ToCLIBuildArgs(artifact, buildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("getting docker build args: %w", err)
+	}
+
+	args := []string{"build", "--tag", tag, "-f", artifact.DockerfilePath}
+	args = append(args, ba...)
+	args = append(args, ".")
+
+	return args, nil
+}
+
+// ecrLogin returns the command that logs Docker into the ECR registry of the image.
+// Images pushed to other registries don't need a login.
+func ecrLogin(tag string) string {
+	ref, err := docker.ParseReference(tag)
+	if err != nil {
+		return ""
+	}
+	matches := ecrRegistry.FindStringSubmatch(ref.Domain)
+	if matches == nil {
+		return ""
+	}
+	return fmt.Sprintf("aws ecr get-login-password --region %s | docker login --username AWS --password-stdin %s", matches[1], ref.Domain)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codebuild
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildSpec(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latest.DockerArtifact
+		tag         string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "push to ecr",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			tag:         "123456789012.dkr.ecr.us-east-1.amazonaws.com/app:tag",
+			expected: `version: "0.2"
+phases:
+  pre_build:
+    commands:
+    - aws ecr get-login-password --region us-east-1 | docker login --username AWS
+      --password-stdin 123456789012.dkr.ecr.us-east-1.amazonaws.com
+  build:
+    commands:
+    - docker build --tag 123456789012.dkr.ecr.us-east-1.amazonaws.com/app:tag -f Dockerfile
+      .
+    - docker push 123456789012.dkr.ecr.us-east-1.amazonaws.com/app:tag
+`,
+		},
+		{
+			description: "build args and cache from",
+			artifact: &latest.DockerArtifact{
+				DockerfilePath: "docker/Dockerfile",
+				BuildArgs:      map[string]*string{"MESSAGE": util.StringPtr("hello world")},
+				CacheFrom:      []string{"docker.io/org/app:latest"},
+			},
+			tag: "docker.io/org/app:tag",
+			expected: `version: "0.2"
+phases:
+  build:
+    commands:
+    - docker pull docker.io/org/app:latest || true
+    - docker build --tag docker.io/org/app:tag -f docker/Dockerfile --build-arg 'MESSAGE=hello
+      world' --cache-from docker.io/org/app:latest .
+    - docker push docker.io/org/app:tag
+`,
+		},
+		{
+			description: "secrets are not supported",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile", Secret: &latest.DockerSecret{ID: "secret"}},
+			tag:         "docker.io/org/app:tag",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			spec, err := buildSpec(test.artifact, test.tag)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, spec)
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	const (
+		tag  = "123456789012.dkr.ecr.eu-west-1.amazonaws.com/app:tag"
+		spec = `version: "0.2"
+phases:
+  pre_build:
+    commands:
+    - aws ecr get-login-password --region eu-west-1 | docker login --username AWS
+      --password-stdin 123456789012.dkr.ecr.eu-west-1.amazonaws.com
+  build:
+    commands:
+    - docker build --tag 123456789012.dkr.ecr.eu-west-1.amazonaws.com/app:tag -f Dockerfile
+      .
+    - docker push 123456789012.dkr.ecr.eu-west-1.amazonaws.com/app:tag
+`
+		startBuild = "aws codebuild start-build --project-name project --source-type-override S3 --source-location-override bucket/source/project-ID.tar.gz --buildspec-override " + spec +
+			" --image-override aws/codebuild/standard:4.0 --privileged-mode-override --query build.id --output text --region eu-west-1"
+		batchGetBuilds = "aws codebuild batch-get-builds --ids project:1 --output json --region eu-west-1"
+	)
+
+	tests := []struct {
+		description    string
+		commands       util.Command
+		expected       string
+		expectedOutput string
+		shouldErr      bool
+	}{
+		{
+			description: "build succeeds",
+			commands: testutil.
+				CmdRunOut("aws s3 cp - s3://bucket/source/project-ID.tar.gz --region eu-west-1", "").
+				AndRunOut(startBuild, "project:1\n").
+				AndRunOut(batchGetBuilds, `{"builds":[{"buildStatus":"SUCCEEDED","logs":{"groupName":"/aws/codebuild/project","streamName":"1"}}]}`).
+				AndRunOut("aws logs get-log-events --log-group-name /aws/codebuild/project --log-stream-name 1 --start-from-head --output json --region eu-west-1", `{"events":[{"message":"Step 1/1 : FROM scratch\n"},{"message":"Pushed"}],"nextForwardToken":"f/1"}`).
+				AndRunOut("aws logs get-log-events --log-group-name /aws/codebuild/project --log-stream-name 1 --start-from-head --next-token f/1 --output json --region eu-west-1", `{"events":[],"nextForwardToken":"f/1"}`).
+				AndRunOut("aws s3 rm s3://bucket/source/project-ID.tar.gz --region eu-west-1", ""),
+			expected:       tag + "@sha256:abac",
+			expectedOutput: "Step 1/1 : FROM scratch\nPushed\n",
+		},
+		{
+			description: "build fails",
+			commands: testutil.
+				CmdRunOut("aws s3 cp - s3://bucket/source/project-ID.tar.gz --region eu-west-1", "").
+				AndRunOut(startBuild, "project:1\n").
+				AndRunOut(batchGetBuilds, `{"builds":[{"buildStatus":"FAILED"}]}`),
+			shouldErr: true,
+		},
+		{
+			description: "upload fails",
+			commands:    testutil.CmdRunOutErr("aws s3 cp - s3://bucket/source/project-ID.tar.gz --region eu-west-1", "", errors.New("access denied")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("Dockerfile", "FROM scratch")
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&randomID, func() string { return "ID" })
+			t.Override(&remoteDigest, func(string, docker.Config) (string, error) { return "sha256:abac", nil })
+
+			builder := NewBuilder(&codeBuildConfig{codeBuild: latest.AWSCodeBuild{
+				ProjectName: "project",
+				Bucket:      "bucket",
+				Region:      "eu-west-1",
+				Image:       "aws/codebuild/standard:4.0",
+			}})
+			artifact := &latest.Artifact{
+				ImageName: "123456789012.dkr.ecr.eu-west-1.amazonaws.com/app",
+				Workspace: tmpDir.Root(),
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+				},
+			}
+
+			var out bytes.Buffer
+			builds, err := builder.buildArtifactWithCodeBuild(context.Background(), &out, artifact, tag)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, builds)
+			if !test.shouldErr {
+				t.CheckContains(test.expectedOutput, out.String())
+			}
+		})
+	}
+}
+
+func TestBuildRequiresDockerArtifacts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		builder := NewBuilder(&codeBuildConfig{codeBuild: latest.AWSCodeBuild{ProjectName: "project", Bucket: "bucket"}})
+
+		_, err := builder.buildArtifactWithCodeBuild(context.Background(), &bytes.Buffer{}, &latest.Artifact{
+			ImageName: "app",
+			ArtifactType: latest.ArtifactType{
+				BazelArtifact: &latest.BazelArtifact{BuildTarget: "//:app.tar"},
+			},
+		}, "app:tag")
+
+		t.CheckErrorContains("only Docker artifacts can be built with CodeBuild", err)
+	})
+}
+
+type codeBuildConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	codeBuild             latest.AWSCodeBuild
+}
+
+func (c *codeBuildConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Build.BuildType.AWSCodeBuild = &c.codeBuild
+	return pipeline
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codebuild
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const (
+	// StatusInProgress "IN_PROGRESS" - Build is being executed.
+	StatusInProgress = "IN_PROGRESS"
+
+	// StatusSucceeded "SUCCEEDED" - Build finished successfully.
+	StatusSucceeded = "SUCCEEDED"
+
+	// StatusFailed "FAILED" - Build failed to complete successfully.
+	StatusFailed = "FAILED"
+
+	// StatusFault "FAULT" - Build failed due to an internal cause.
+	StatusFault = "FAULT"
+
+	// StatusTimedOut "TIMED_OUT" - Build took longer than was allowed.
+	StatusTimedOut = "TIMED_OUT"
+
+	// StatusStopped "STOPPED" - Build was stopped by a user.
+	StatusStopped = "STOPPED"
+
+	// RetryDelay is the time to wait in between polling the status of the build.
+	RetryDelay = 1 * time.Second
+)
+
+// Builder builds artifacts with AWS CodeBuild.
+type Builder struct {
+	*latest.AWSCodeBuild
+
+	cfg   Config
+	muted build.Muted
+}
+
+type Config interface {
+	docker.Config
+
+	Pipeline() latest.Pipeline
+	Muted() config.Muted
+}
+
+// NewBuilder creates a new Builder that builds artifacts with AWS CodeBuild.
+func NewBuilder(cfg Config) *Builder {
+	return &Builder{
+		AWSCodeBuild: cfg.Pipeline().Build.AWSCodeBuild,
+		cfg:          cfg,
+		muted:        cfg.Muted(),
+	}
+}
+
+func (b *Builder) Prune(ctx context.Context, out io.Writer) error {
+	return nil // noop
+}
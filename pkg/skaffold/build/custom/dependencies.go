@@ -27,14 +27,17 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
 )
 
 // GetDependencies returns dependencies listed for a custom artifact
-func GetDependencies(ctx context.Context, workspace string, a *latest.CustomArtifact, cfg docker.Config) ([]string, error) {
+// When filter is not nil, only the files it matches are listed, except
+// for the dependencies given by a command which are not filtered.
+func GetDependencies(ctx context.Context, workspace string, a *latest.CustomArtifact, cfg docker.Config, filter walk.Predicate) ([]string, error) {
 	switch {
 	case a.Dependencies.Dockerfile != nil:
 		dockerfile := a.Dependencies.Dockerfile
-		return docker.GetDependencies(ctx, workspace, dockerfile.Path, dockerfile.BuildArgs, cfg)
+		return docker.GetDependencies(ctx, workspace, dockerfile.Path, dockerfile.BuildArgs, cfg, filter)
 
 	case a.Dependencies.Command != "":
 		split := strings.Split(a.Dependencies.Command, " ")
@@ -50,6 +53,6 @@ func GetDependencies(ctx context.Context, workspace string, a *latest.CustomArti
 		return deps, nil
 
 	default:
-		return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore)
+		return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore, filter)
 	}
 }
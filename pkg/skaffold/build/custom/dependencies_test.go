@@ -50,7 +50,7 @@ func TestGetDependenciesDockerfile(t *testing.T) {
 	}
 
 	expected := []string{"Dockerfile", filepath.FromSlash("baz/file"), "foo"}
-	deps, err := GetDependencies(context.Background(), tmpDir.Root(), customArtifact, nil)
+	deps, err := GetDependencies(context.Background(), tmpDir.Root(), customArtifact, nil, nil)
 
 	testutil.CheckErrorAndDeepEqual(t, false, err, expected, deps)
 }
@@ -69,7 +69,7 @@ func TestGetDependenciesCommand(t *testing.T) {
 		}
 
 		expected := []string{"file1", "file2", "file3"}
-		deps, err := GetDependencies(context.Background(), "", customArtifact, nil)
+		deps, err := GetDependencies(context.Background(), "", customArtifact, nil, nil)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(expected, deps)
@@ -124,7 +124,7 @@ func TestGetDependenciesPaths(t *testing.T) {
 					Paths:  test.paths,
 					Ignore: test.ignore,
 				},
-			}, nil)
+			}, nil, nil)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, deps)
 		})
@@ -24,14 +24,23 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
 )
 
 // DependenciesForArtifact returns the dependencies for a given artifact.
 func DependenciesForArtifact(ctx context.Context, a *latest.Artifact, cfg docker.Config) ([]string, error) {
+	return dependenciesForArtifact(ctx, a, cfg, nil)
+}
+
+// dependenciesForArtifact returns the dependencies for a given artifact.
+// When filter is not nil, only the files it matches are walked. The dependencies
+// listed by a build tool, like Bazel or Jib, are not filtered.
+func dependenciesForArtifact(ctx context.Context, a *latest.Artifact, cfg docker.Config, filter walk.Predicate) ([]string, error) {
 	var (
 		paths []string
 		err   error
@@ -39,10 +48,10 @@ func DependenciesForArtifact(ctx context.Context, a *latest.Artifact, cfg docker
 
 	switch {
 	case a.DockerArtifact != nil:
-		paths, err = docker.GetDependencies(ctx, a.Workspace, a.DockerArtifact.DockerfilePath, a.DockerArtifact.BuildArgs, cfg)
+		paths, err = docker.GetDependencies(ctx, a.Workspace, a.DockerArtifact.DockerfilePath, a.DockerArtifact.BuildArgs, cfg, filter)
 
 	case a.KanikoArtifact != nil:
-		paths, err = docker.GetDependencies(ctx, a.Workspace, a.KanikoArtifact.DockerfilePath, a.KanikoArtifact.BuildArgs, cfg)
+		paths, err = docker.GetDependencies(ctx, a.Workspace, a.KanikoArtifact.DockerfilePath, a.KanikoArtifact.BuildArgs, cfg, filter)
 
 	case a.BazelArtifact != nil:
 		paths, err = bazel.GetDependencies(ctx, a.Workspace, a.BazelArtifact)
@@ -51,10 +60,13 @@ func DependenciesForArtifact(ctx context.Context, a *latest.Artifact, cfg docker
 		paths, err = jib.GetDependencies(ctx, a.Workspace, a.JibArtifact)
 
 	case a.CustomArtifact != nil:
-		paths, err = custom.GetDependencies(ctx, a.Workspace, a.CustomArtifact, cfg)
+		paths, err = custom.GetDependencies(ctx, a.Workspace, a.CustomArtifact, cfg, filter)
 
 	case a.BuildpackArtifact != nil:
-		paths, err = buildpacks.GetDependencies(ctx, a.Workspace, a.BuildpackArtifact)
+		paths, err = buildpacks.GetDependencies(ctx, a.Workspace, a.BuildpackArtifact, filter)
+
+	case a.KoArtifact != nil:
+		paths, err = ko.GetDependencies(ctx, a.Workspace, a.KoArtifact, filter)
 
 	default:
 		return nil, fmt.Errorf("unexpected artifact type %q:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
@@ -67,6 +67,9 @@ func (b *Builder) dockerBuildArgs(artifact *latest.DockerArtifact, tag string) (
 	if artifact.Secret != nil {
 		return nil, errors.New("docker build secrets not currently supported in GCB builds")
 	}
+	if artifact.SSH != "" {
+		return nil, errors.New("docker build ssh not currently supported in GCB builds")
+	}
 	buildArgs, err := util.EvaluateEnvTemplateMap(artifact.BuildArgs)
 	if err != nil {
 		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
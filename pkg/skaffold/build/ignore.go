@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/builder/dockerignore"
+	"github.com/docker/docker/pkg/fileutils"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
+)
+
+// IgnoreFile lists, in an artifact's context, the files that the file watcher ignores.
+const IgnoreFile = ".skaffoldignore"
+
+// WatchedDependencies returns the dependencies of an artifact that the file watcher monitors.
+// Files matching the artifact's `ignore` patterns or its .skaffoldignore file are left out.
+// The ignored directories are not walked.
+func WatchedDependencies(ctx context.Context, a *latest.Artifact, cfg docker.Config) ([]string, error) {
+	notIgnored, err := notIgnoredPredicate(a)
+	if err != nil {
+		return nil, err
+	}
+
+	deps, err := dependenciesForArtifact(ctx, a, cfg, notIgnored)
+	if err != nil {
+		return nil, err
+	}
+
+	if notIgnored == nil || walksWorkspace(a) {
+		return deps, nil
+	}
+	// The dependencies listed by a build tool are not walked.
+	return FilterIgnored(a, deps)
+}
+
+// walksWorkspace checks if the dependencies of an artifact are found by walking its workspace,
+// rather than listed by a build tool or a command.
+func walksWorkspace(a *latest.Artifact) bool {
+	switch {
+	case a.DockerArtifact != nil, a.KanikoArtifact != nil, a.BuildpackArtifact != nil, a.KoArtifact != nil:
+		return true
+	case a.CustomArtifact != nil:
+		return a.CustomArtifact.Dependencies == nil || a.CustomArtifact.Dependencies.Command == ""
+	default:
+		return false
+	}
+}
+
+// notIgnoredPredicate creates a walk.Predicate that matches the files that the file watcher doesn't ignore
+// and skips the ignored directories. It returns nil when the artifact doesn't ignore any file.
+func notIgnoredPredicate(a *latest.Artifact) (walk.Predicate, error) {
+	patterns, err := ignorePatterns(a)
+	if err != nil {
+		return nil, err
+	}
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	workspace, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	ignored, err := docker.NewDockerIgnorePredicate(workspace, patterns)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ignore patterns of artifact %q: %w", a.ImageName, err)
+	}
+
+	return func(path string, info walk.Dirent) (bool, error) {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return false, err
+		}
+
+		rel, err := filepath.Rel(workspace, absPath)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			// Files outside of the workspace are always watched.
+			return true, nil
+		}
+
+		isIgnored, err := ignored(absPath, info)
+		if err != nil {
+			return false, err
+		}
+		return !isIgnored, nil
+	}, nil
+}
+
+// FilterIgnored removes from the dependencies of an artifact the files that the file watcher ignores.
+func FilterIgnored(a *latest.Artifact, deps []string) ([]string, error) {
+	patterns, err := ignorePatterns(a)
+	if err != nil {
+		return nil, err
+	}
+	if len(patterns) == 0 {
+		return deps, nil
+	}
+
+	matcher, err := fileutils.NewPatternMatcher(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ignore patterns of artifact %q: %w", a.ImageName, err)
+	}
+
+	workspace, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var watched []string
+	for _, dep := range deps {
+		// Dependencies are relative to the current directory when the workspace is.
+		absDep, err := filepath.Abs(dep)
+		if err != nil {
+			return nil, err
+		}
+
+		rel, err := filepath.Rel(workspace, absDep)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			// Files outside of the workspace are always watched.
+			watched = append(watched, dep)
+			continue
+		}
+
+		ignored, err := matcher.Matches(rel)
+		if err != nil {
+			return nil, err
+		}
+		if !ignored {
+			watched = append(watched, dep)
+		}
+	}
+	return watched, nil
+}
+
+// ignorePatterns lists the artifact's `ignore` patterns, followed by those of its .skaffoldignore file.
+func ignorePatterns(a *latest.Artifact) ([]string, error) {
+	patterns := append([]string{}, a.Ignore...)
+
+	f, err := os.Open(filepath.Join(a.Workspace, IgnoreFile))
+	if os.IsNotExist(err) {
+		return patterns, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", IgnoreFile, err)
+	}
+	defer f.Close()
+
+	fromFile, err := dockerignore.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", IgnoreFile, err)
+	}
+	return append(patterns, fromFile...), nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestWatchedDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest.ArtifactType
+		expected    []string
+	}{
+		{
+			description: "docker",
+			artifact:    latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}},
+			expected:    []string{".skaffoldignore", "Dockerfile", "main.go"},
+		},
+		{
+			description: "buildpacks",
+			artifact:    latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{Dependencies: &latest.BuildpackDependencies{Paths: []string{"."}}}},
+			expected:    []string{".skaffoldignore", "Dockerfile", "main.go"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().
+				Write("Dockerfile", "FROM scratch\nCOPY . .").
+				Write(".skaffoldignore", "node_modules").
+				Touch("main.go", "node_modules/lib/index.js", "logs/app.log")
+
+			deps, err := WatchedDependencies(context.Background(), &latest.Artifact{
+				ImageName:    "image",
+				Workspace:    tmpDir.Root(),
+				Ignore:       []string{"**/*.log"},
+				ArtifactType: test.artifact,
+			}, nil)
+
+			t.CheckNoError(err)
+			var expected []string
+			for _, dep := range test.expected {
+				expected = append(expected, tmpDir.Path(dep))
+			}
+			t.CheckDeepEqual(expected, deps)
+		})
+	}
+}
+
+func TestNotIgnoredPredicateSkipsIgnoredDirectories(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("main.go", "node_modules/lib/index.js")
+
+		notIgnored, err := notIgnoredPredicate(&latest.Artifact{Workspace: tmpDir.Root(), Ignore: []string{"node_modules"}})
+		t.CheckNoError(err)
+
+		var walked []string
+		err = walk.From(tmpDir.Root()).When(notIgnored).Do(func(path string, _ walk.Dirent) error {
+			walked = append(walked, path)
+			return nil
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{tmpDir.Root(), tmpDir.Path("main.go")}, walked)
+	})
+}
+
+func TestNotIgnoredPredicateWithoutPatterns(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		notIgnored, err := notIgnoredPredicate(&latest.Artifact{Workspace: t.NewTempDir().Root()})
+
+		t.CheckNoError(err)
+		t.CheckTrue(notIgnored == nil)
+	})
+}
+
+func TestFilterIgnored(t *testing.T) {
+	tests := []struct {
+		description string
+		ignore      []string
+		ignoreFile  string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "nothing ignored",
+			expected:    []string{"main.go", "node_modules/lib/index.js", "logs/app.log", "../shared/config.json"},
+		},
+		{
+			description: "artifact ignore patterns",
+			ignore:      []string{"node_modules", "**/*.log"},
+			expected:    []string{"main.go", "../shared/config.json"},
+		},
+		{
+			description: ".skaffoldignore file",
+			ignoreFile:  "# Dependencies\nnode_modules\n",
+			expected:    []string{"main.go", "logs/app.log", "../shared/config.json"},
+		},
+		{
+			description: "both",
+			ignore:      []string{"logs"},
+			ignoreFile:  "node_modules",
+			expected:    []string{"main.go", "../shared/config.json"},
+		},
+		{
+			description: "exception",
+			ignore:      []string{"node_modules", "!node_modules/lib/index.js"},
+			expected:    []string{"main.go", "node_modules/lib/index.js", "logs/app.log", "../shared/config.json"},
+		},
+		{
+			description: "invalid pattern",
+			ignore:      []string{"["},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			if test.ignoreFile != "" {
+				tmpDir.Write("app/.skaffoldignore", test.ignoreFile)
+			}
+			workspace := tmpDir.Path("app")
+			deps := []string{"main.go", "node_modules/lib/index.js", "logs/app.log", "../shared/config.json"}
+			var paths []string
+			for _, dep := range deps {
+				paths = append(paths, filepath.Join(workspace, dep))
+			}
+
+			watched, err := FilterIgnored(&latest.Artifact{ImageName: "image", Workspace: workspace, Ignore: test.ignore}, paths)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				var expected []string
+				for _, dep := range test.expected {
+					expected = append(expected, filepath.Join(workspace, dep))
+				}
+				t.CheckDeepEqual(expected, watched)
+			}
+		})
+	}
+}
+
+func TestFilterIgnoredRelativeWorkspace(t *testing.T) {
+	tests := []struct {
+		description string
+		workspace   string
+		deps        []string
+		expected    []string
+	}{
+		{
+			description: "default workspace",
+			workspace:   ".",
+			deps:        []string{"main.go", "node_modules/lib/index.js", "logs/app.log"},
+			expected:    []string{"main.go"},
+		},
+		{
+			description: "relative workspace",
+			workspace:   "app",
+			deps:        []string{"app/main.go", "app/node_modules/lib/index.js", "app/logs/app.log", "shared/config.json"},
+			expected:    []string{"app/main.go", "shared/config.json"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().
+				Write(filepath.Join(test.workspace, ".skaffoldignore"), "node_modules").
+				Chdir()
+
+			watched, err := FilterIgnored(&latest.Artifact{ImageName: "image", Workspace: test.workspace, Ignore: []string{"**/*.log"}}, test.deps)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, watched)
+		})
+	}
+}
@@ -485,3 +485,4 @@ type mockConfig struct {
 }
 
 func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+func (c *mockConfig) UsePodman() bool                        { return false }
@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// appDir is the directory of the image where the binary is added.
+const appDir = "/ko-app"
+
+// For testing
+var (
+	baseImage = docker.RetrieveRemoteImage
+)
+
+// Build builds a Go binary and appends it as a new layer to the base image.
+func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+	a := artifact.KoArtifact
+
+	base, err := baseImage(a.FromImage, b.cfg)
+	if err != nil {
+		return "", fmt.Errorf("getting base image %q: %w", a.FromImage, err)
+	}
+
+	platform, err := platformOf(base)
+	if err != nil {
+		return "", fmt.Errorf("reading config of base image %q: %w", a.FromImage, err)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-ko")
+	if err != nil {
+		return "", fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	binary := filepath.Join(tmpDir, appName(artifact.ImageName))
+	if err := buildBinary(ctx, out, artifact.Workspace, a, platform, binary); err != nil {
+		return "", err
+	}
+
+	img, err := appendBinary(base, binary)
+	if err != nil {
+		return "", fmt.Errorf("adding binary to base image: %w", err)
+	}
+
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+
+	tarPath := filepath.Join(tmpDir, "image.tar")
+	if err := tarball.WriteToFile(tarPath, ref, img); err != nil {
+		return "", fmt.Errorf("writing image tarball: %w", err)
+	}
+
+	if b.pushImages {
+		return docker.Push(ctx, tarPath, tag, b.cfg)
+	}
+	return b.loadImage(ctx, out, tarPath, tag)
+}
+
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, tarPath string, tag string) (string, error) {
+	imageTar, err := os.Open(tarPath)
+	if err != nil {
+		return "", fmt.Errorf("opening image tarball: %w", err)
+	}
+	defer imageTar.Close()
+
+	return b.localDocker.Load(ctx, out, imageTar, tag)
+}
+
+// buildBinary builds a statically linked binary for the platform of the base image.
+func buildBinary(ctx context.Context, out io.Writer, workspace string, a *latest.KoArtifact, platform v1.Platform, binary string) error {
+	env, err := misc.EvaluateEnv(a.Env)
+	if err != nil {
+		return fmt.Errorf("unable to evaluate env variables: %w", err)
+	}
+
+	args := append([]string{"build", "-o", binary}, a.Flags...)
+	if len(a.Ldflags) > 0 {
+		args = append(args, "-ldflags", strings.Join(a.Ldflags, " "))
+	}
+	args = append(args, a.Main)
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = workspace
+	cmd.Env = append(util.OSEnviron(), append([]string{"CGO_ENABLED=0", "GOOS=" + platform.OS, "GOARCH=" + platform.Architecture}, env...)...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("building binary: %w", err)
+	}
+	return nil
+}
+
+// appendBinary adds the binary to the image, in its own layer, and makes it the entrypoint.
+func appendBinary(base v1.Image, binary string) (v1.Image, error) {
+	content, err := ioutil.ReadFile(binary)
+	if err != nil {
+		return nil, err
+	}
+
+	target := path.Join(appDir, filepath.Base(binary))
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{Name: appDir, Typeflag: tar.TypeDir, Mode: 0555}); err != nil {
+		return nil, err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: target, Typeflag: tar.TypeReg, Mode: 0555, Size: int64(len(content))}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(content); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := mutate.AppendLayers(base, layer)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	cfg = cfg.DeepCopy()
+	cfg.Config.Entrypoint = []string{target}
+	cfg.Config.Cmd = nil
+
+	return mutate.ConfigFile(img, cfg)
+}
+
+// platformOf returns the platform of an image, defaulting to linux/amd64.
+func platformOf(img v1.Image) (v1.Platform, error) {
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return v1.Platform{}, err
+	}
+
+	platform := v1.Platform{OS: cfg.OS, Architecture: cfg.Architecture}
+	if platform.OS == "" {
+		platform.OS = "linux"
+	}
+	if platform.Architecture == "" {
+		platform.Architecture = "amd64"
+	}
+	return platform, nil
+}
+
+// appName returns the name of the binary, which is the last part of the image name.
+func appName(imageName string) string {
+	return path.Base(imageName)
+}
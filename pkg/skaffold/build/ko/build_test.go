@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildBinary(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latest.KoArtifact
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "default flags",
+			artifact:    &latest.KoArtifact{Main: "."},
+			commands:    testutil.CmdRunEnv("go build -o /tmp/app .", []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm64"}),
+		},
+		{
+			description: "flags, ldflags and env",
+			artifact: &latest.KoArtifact{
+				Main:    "./cmd/app",
+				Flags:   []string{"-trimpath"},
+				Ldflags: []string{"-s", "-w"},
+				Env:     []string{"GOPRIVATE=example.com"},
+			},
+			commands: testutil.CmdRunEnv("go build -o /tmp/app -trimpath -ldflags -s -w ./cmd/app", []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm64", "GOPRIVATE=example.com"}),
+		},
+		{
+			description: "build failure",
+			artifact:    &latest.KoArtifact{Main: "."},
+			commands:    testutil.CmdRunErr("go build -o /tmp/app .", errors.New("BUG")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&util.OSEnviron, func() []string { return nil })
+
+			err := buildBinary(context.Background(), ioutil.Discard, ".", test.artifact, v1.Platform{OS: "linux", Architecture: "arm64"}, "/tmp/app")
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		binary := t.NewTempDir().Write("leeroy-web", "binary").Path("leeroy-web")
+		base, err := mutate.Config(empty.Image, v1.Config{Cmd: []string{"sh"}})
+		t.CheckNoError(err)
+
+		img, err := appendBinary(base, binary)
+		t.CheckNoError(err)
+
+		layers, err := img.Layers()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(layers))
+
+		cfg, err := img.ConfigFile()
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"/ko-app/leeroy-web"}, cfg.Config.Entrypoint)
+		t.CheckEmpty(cfg.Config.Cmd)
+	})
+}
+
+func TestPlatformOf(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		platform, err := platformOf(empty.Image)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(v1.Platform{OS: "linux", Architecture: "amd64"}, platform)
+	})
+}
+
+func TestAppName(t *testing.T) {
+	testutil.CheckDeepEqual(t, "leeroy-web", appName("gcr.io/k8s-skaffold/leeroy-web"))
+}
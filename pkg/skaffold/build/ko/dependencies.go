@@ -0,0 +1,31 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/list"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
+)
+
+// GetDependencies returns the dependencies listed for a ko artifact.
+// When filter is not nil, only the files it matches are listed.
+func GetDependencies(ctx context.Context, workspace string, a *latest.KoArtifact, filter walk.Predicate) ([]string, error) {
+	return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore, filter)
+}
@@ -25,7 +25,9 @@ import (
 )
 
 // Files list files in a workspace, given a list of patterns and exclusions.
-func Files(workspace string, patterns, excludes []string) ([]string, error) {
+// When filter is not nil, only the entries it matches are listed. It can skip
+// whole directories by returning `filepath.SkipDir`.
+func Files(workspace string, patterns, excludes []string, filter walk.Predicate) ([]string, error) {
 	notExcluded := notExcluded(workspace, excludes)
 
 	var dependencies []string
@@ -41,7 +43,12 @@ func Files(workspace string, patterns, excludes []string) ([]string, error) {
 		}
 
 		for _, absFrom := range expanded {
-			if err := walk.From(absFrom).Unsorted().When(notExcluded).WhenIsFile().Do(func(path string, info walk.Dirent) error {
+			w := walk.From(absFrom).Unsorted().When(notExcluded)
+			if filter != nil {
+				w = w.When(filter)
+			}
+
+			if err := w.WhenIsFile().Do(func(path string, info walk.Dirent) error {
 				relPath, err := filepath.Rel(workspace, path)
 				if err != nil {
 					return err
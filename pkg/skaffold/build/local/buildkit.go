@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+)
+
+var (
+	// stepStarted matches the first line of a Dockerfile step in BuildKit's plain progress output.
+	// For example: `#6 [builder 2/3] RUN go build`.
+	stepStarted = regexp.MustCompile(`^#(\d+) (\[[^\]]*\d+/\d+\] .*)$`)
+
+	// stepDone matches the last line of a successful step.
+	// For example: `#6 DONE 3.2s` or `#6 CACHED`.
+	stepDone = regexp.MustCompile(`^#(\d+) (DONE \d+(\.\d+)?s|CACHED)$`)
+)
+
+// buildkitProgress copies BuildKit's plain progress output to the output
+// and notifies the completion of each Dockerfile step as a build event.
+type buildkitProgress struct {
+	out       io.Writer
+	imageName string
+	steps     map[string]string
+	line      []byte
+	notify    func(imageName, step string)
+}
+
+func newBuildkitProgress(out io.Writer, imageName string) *buildkitProgress {
+	return &buildkitProgress{
+		out:       out,
+		imageName: imageName,
+		steps:     map[string]string{},
+		notify:    event.BuildStepCompleted,
+	}
+}
+
+func (p *buildkitProgress) Write(b []byte) (int, error) {
+	n, err := p.out.Write(b)
+
+	p.line = append(p.line, b[:n]...)
+	for {
+		i := bytes.IndexByte(p.line, '\n')
+		if i < 0 {
+			break
+		}
+		p.parse(strings.TrimSuffix(string(p.line[:i]), "\r"))
+		p.line = p.line[i+1:]
+	}
+
+	return n, err
+}
+
+func (p *buildkitProgress) parse(line string) {
+	if m := stepStarted.FindStringSubmatch(line); m != nil {
+		p.steps[m[1]] = m[2]
+		return
+	}
+
+	if m := stepDone.FindStringSubmatch(line); m != nil {
+		if step, found := p.steps[m[1]]; found {
+			delete(p.steps, m[1])
+			p.notify(p.imageName, step)
+		}
+	}
+}
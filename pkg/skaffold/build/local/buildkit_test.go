@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package local
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildkitProgress(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var out bytes.Buffer
+		var completed []string
+		progress := newBuildkitProgress(&out, "app")
+		progress.notify = func(imageName, step string) {
+			completed = append(completed, imageName+": "+step)
+		}
+
+		output := `#1 [internal] load build definition from Dockerfile
+#1 DONE 0.1s
+
+#4 [builder 1/2] FROM docker.io/library/golang:1.15
+#4 CACHED
+
+#5 [builder 2/2] RUN go bu`
+		progress.Write([]byte(output))
+		progress.Write([]byte("ild\n#5 0.512 building\n#5 DONE 3.2s\r\n\n#6 [stage-1 1/1] COPY --from=builder /app .\n#6 ERROR: failed\n"))
+
+		t.CheckDeepEqual(output+"ild\n#5 0.512 building\n#5 DONE 3.2s\r\n\n#6 [stage-1 1/1] COPY --from=builder /app .\n#6 ERROR: failed\n", out.String())
+		t.CheckDeepEqual([]string{
+			"app: [builder 1/2] FROM docker.io/library/golang:1.15",
+			"app: [builder 2/2] RUN go build",
+		}, completed)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -44,10 +45,14 @@ func (b *Builder) buildDocker(ctx context.Context, out io.Writer, a *latest.Arti
 		return "", fmt.Errorf("pulling cache-from images: %w", err)
 	}
 
+	if len(a.Platforms) > 0 {
+		return b.buildxBuild(ctx, out, a, tag)
+	}
+
 	var imageID string
 
-	if b.local.UseDockerCLI || b.local.UseBuildkit {
-		imageID, err = b.dockerCLIBuild(ctx, out, a.Workspace, a.ArtifactType.DockerArtifact, tag)
+	if b.local.UseDockerCLI || b.local.UseBuildkit || b.local.UsePodman {
+		imageID, err = b.dockerCLIBuild(ctx, out, a.ImageName, a.Workspace, a.ArtifactType.DockerArtifact, tag)
 	} else {
 		imageID, err = b.localDocker.Build(ctx, out, a.Workspace, a.ArtifactType.DockerArtifact, tag, mode)
 	}
@@ -67,7 +72,7 @@ func (b *Builder) retrieveExtraEnv() []string {
 	return b.localDocker.ExtraEnv()
 }
 
-func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace string, a *latest.DockerArtifact, tag string) (string, error) {
+func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, imageName, workspace string, a *latest.DockerArtifact, tag string) (string, error) {
 	dockerfilePath, err := docker.NormalizeDockerfilePath(workspace, a.DockerfilePath)
 	if err != nil {
 		return "", fmt.Errorf("normalizing dockerfile path: %w", err)
@@ -88,13 +93,22 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 		args = append(args, "--force-rm")
 	}
 
-	cmd := exec.CommandContext(ctx, "docker", args...)
-	cmd.Env = append(util.OSEnviron(), b.retrieveExtraEnv()...)
-	if b.local.UseBuildkit {
-		cmd.Env = append(cmd.Env, "DOCKER_BUILDKIT=1")
+	cli := "docker"
+	if b.local.UsePodman {
+		cli = "podman"
 	}
+
+	cmd := exec.CommandContext(ctx, cli, args...)
+	cmd.Env = append(util.OSEnviron(), b.retrieveExtraEnv()...)
 	cmd.Stdout = out
 	cmd.Stderr = out
+	if b.local.UseBuildkit {
+		// The plain progress output is parsed to notify the completion of each step.
+		cmd.Env = append(cmd.Env, "DOCKER_BUILDKIT=1", "BUILDKIT_PROGRESS=plain")
+		progress := newBuildkitProgress(out, imageName)
+		cmd.Stdout = progress
+		cmd.Stderr = progress
+	}
 
 	if err := util.RunCmd(cmd); err != nil {
 		return "", fmt.Errorf("running build: %w", err)
@@ -103,6 +117,67 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 	return b.localDocker.ImageID(ctx, tag)
 }
 
+// buildxBuild builds the image for the artifact's target platforms with `docker buildx`.
+// Images for several platforms are combined into a manifest list that
+// can only be pushed to a registry, not loaded into the local daemon.
+// When images are not pushed, for example on local clusters, a single
+// platform is built and loaded instead.
+func (b *Builder) buildxBuild(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
+	if b.local.UsePodman {
+		return "", fmt.Errorf("building %q for target platforms is not supported with Podman", a.ImageName)
+	}
+
+	platforms := a.Platforms
+	if len(platforms) > 1 && !b.pushImages {
+		platforms = []string{loadablePlatform(platforms)}
+		warnings.Printf("Images for several platforms can't be loaded into the local daemon: only building %q for %s", a.ImageName, platforms[0])
+	}
+
+	dockerfilePath, err := docker.NormalizeDockerfilePath(a.Workspace, a.DockerArtifact.DockerfilePath)
+	if err != nil {
+		return "", fmt.Errorf("normalizing dockerfile path: %w", err)
+	}
+
+	args := []string{"buildx", "build", a.Workspace, "--file", dockerfilePath, "-t", tag, "--platform", strings.Join(platforms, ",")}
+	ba, err := docker.EvalBuildArgs(b.mode, a.Workspace, a.DockerArtifact)
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	cliArgs, err := docker.ToCLIBuildArgs(a.DockerArtifact, ba)
+	if err != nil {
+		return "", fmt.Errorf("getting docker build args: %w", err)
+	}
+	args = append(args, cliArgs...)
+
+	if b.pushImages {
+		args = append(args, "--push")
+	} else {
+		args = append(args, "--load")
+	}
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(util.OSEnviron(), b.retrieveExtraEnv()...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return "", fmt.Errorf("running buildx build: %w", err)
+	}
+
+	if b.pushImages {
+		return docker.RemoteDigest(tag, b.cfg)
+	}
+	return b.localDocker.ImageID(ctx, tag)
+}
+
+// loadablePlatform picks the platform to build when only one image can be loaded:
+// the local machine's platform if it's a target, or the first target platform.
+func loadablePlatform(platforms []string) string {
+	if util.StrSliceContains(platforms, docker.HostPlatform) {
+		return docker.HostPlatform
+	}
+	return platforms[0]
+}
+
 func (b *Builder) pullCacheFromImages(ctx context.Context, out io.Writer, a *latest.DockerArtifact) error {
 	if len(a.CacheFrom) == 0 {
 		return nil
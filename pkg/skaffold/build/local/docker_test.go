@@ -18,6 +18,7 @@ package local
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os/exec"
 	"path/filepath"
@@ -56,20 +57,25 @@ func TestDockerCLIBuild(t *testing.T) {
 		{
 			description: "buildkit",
 			localBuild:  latest.LocalBuild{UseBuildkit: true},
-			expectedEnv: []string{"KEY=VALUE", "DOCKER_BUILDKIT=1"},
+			expectedEnv: []string{"KEY=VALUE", "DOCKER_BUILDKIT=1", "BUILDKIT_PROGRESS=plain"},
 		},
 		{
 			description: "buildkit and extra env",
 			localBuild:  latest.LocalBuild{UseBuildkit: true},
 			extraEnv:    []string{"OTHER=VALUE"},
-			expectedEnv: []string{"KEY=VALUE", "OTHER=VALUE", "DOCKER_BUILDKIT=1"},
+			expectedEnv: []string{"KEY=VALUE", "OTHER=VALUE", "DOCKER_BUILDKIT=1", "BUILDKIT_PROGRESS=plain"},
 		},
 		{
 			description: "env var collisions",
 			localBuild:  latest.LocalBuild{UseBuildkit: true},
 			extraEnv:    []string{"KEY=OTHER_VALUE", "DOCKER_BUILDKIT=0"},
 			// env var collisions are handled by cmd.Run(). Last one wins.
-			expectedEnv: []string{"KEY=VALUE", "KEY=OTHER_VALUE", "DOCKER_BUILDKIT=0", "DOCKER_BUILDKIT=1"},
+			expectedEnv: []string{"KEY=VALUE", "KEY=OTHER_VALUE", "DOCKER_BUILDKIT=0", "DOCKER_BUILDKIT=1", "BUILDKIT_PROGRESS=plain"},
+		},
+		{
+			description: "podman",
+			localBuild:  latest.LocalBuild{UsePodman: true},
+			expectedEnv: []string{"KEY=VALUE"},
 		},
 	}
 
@@ -81,8 +87,12 @@ func TestDockerCLIBuild(t *testing.T) {
 			t.Override(&docker.EvalBuildArgs, func(mode config.RunMode, workspace string, a *latest.DockerArtifact) (map[string]*string, error) {
 				return a.BuildArgs, nil
 			})
+			cli := "docker"
+			if test.localBuild.UsePodman {
+				cli = "podman"
+			}
 			t.Override(&util.DefaultExecCommand, testutil.CmdRunEnv(
-				"docker build . --file "+dockerfilePath+" -t tag --force-rm",
+				cli+" build . --file "+dockerfilePath+" -t tag --force-rm",
 				test.expectedEnv,
 			))
 			t.Override(&cluster.GetClient, func() cluster.Client { return fakeMinikubeClient{} })
@@ -111,6 +121,87 @@ func TestDockerCLIBuild(t *testing.T) {
 	}
 }
 
+func TestBuildxBuild(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   []string
+		push        bool
+		localBuild  latest.LocalBuild
+		command     string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "single platform loaded locally",
+			platforms:   []string{"linux/arm64"},
+			command:     "docker buildx build . --file %s -t tag --platform linux/arm64 --load",
+			expected:    "sha256:imageID",
+		},
+		{
+			description: "several platforms pushed as a manifest list",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			push:        true,
+			command:     "docker buildx build . --file %s -t tag --platform linux/amd64,linux/arm64 --push",
+			expected:    "sha256:digest",
+		},
+		{
+			description: "several platforms without push loads the host platform",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			command:     "docker buildx build . --file %s -t tag --platform linux/arm64 --load",
+			expected:    "sha256:imageID",
+		},
+		{
+			description: "several platforms without push loads the first platform",
+			platforms:   []string{"linux/s390x", "linux/ppc64le"},
+			command:     "docker buildx build . --file %s -t tag --platform linux/s390x --load",
+			expected:    "sha256:imageID",
+		},
+		{
+			description: "podman",
+			platforms:   []string{"linux/arm64"},
+			localBuild:  latest.LocalBuild{UsePodman: true},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.HostPlatform, "linux/arm64")
+			t.Override(&docker.EvalBuildArgs, func(mode config.RunMode, workspace string, a *latest.DockerArtifact) (map[string]*string, error) {
+				return a.BuildArgs, nil
+			})
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun(fmt.Sprintf(test.command, dockerfilePath)))
+			t.Override(&docker.RemoteDigest, func(string, docker.Config) (string, error) { return "sha256:digest", nil })
+			t.Override(&cluster.GetClient, func() cluster.Client { return fakeMinikubeClient{} })
+			fakeClient := &testutil.FakeAPIClient{}
+			fakeClient.Add("tag", "sha256:imageID")
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return fakeLocalDaemon(fakeClient), nil
+			})
+
+			localBuild := test.localBuild
+			localBuild.Push = util.BoolPtr(test.push)
+			builder, err := NewBuilder(&mockConfig{local: localBuild})
+			t.CheckNoError(err)
+
+			artifact := &latest.Artifact{
+				ImageName: "image",
+				Workspace: ".",
+				Platforms: test.platforms,
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+					},
+				},
+			}
+
+			digestOrImageID, err := builder.buildDocker(context.Background(), ioutil.Discard, artifact, "tag", config.RunModes.Dev)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, digestOrImageID)
+		})
+	}
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
@@ -48,7 +49,11 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, tags tag.ImageTags,
 	}
 
 	builder := build.WithLogFile(b.buildArtifact, b.muted)
-	rt, err := build.InOrder(ctx, out, tags, artifacts, builder, *b.local.Concurrency)
+	schedule := build.InOrder
+	if b.local.InterleaveLogs {
+		schedule = build.Interleaved
+	}
+	rt, err := schedule(ctx, out, tags, artifacts, builder, *b.local.Concurrency)
 
 	if b.prune {
 		if b.mode == config.RunModes.Build {
@@ -62,6 +67,12 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, tags tag.ImageTags,
 }
 
 func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
+	if b.pushImages && b.createRepositories {
+		if err := ensureECRRepository(ctx, out, tag); err != nil {
+			return "", err
+		}
+	}
+
 	digestOrImageID, err := b.runBuildForArtifact(ctx, out, a, tag)
 	if err != nil {
 		return "", err
@@ -70,7 +81,8 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 	if b.pushImages {
 		// only track images for pruning when building with docker
 		// if we're pushing a bazel image, it was built directly to the registry
-		if a.DockerArtifact != nil {
+		// and so are the multi-platform images built with buildx
+		if a.DockerArtifact != nil && len(a.Platforms) == 0 {
 			imageID, err := b.getImageIDForTag(ctx, tag)
 			if err != nil {
 				logrus.Warnf("unable to inspect image: built images may not be cleaned up correctly by skaffold")
@@ -116,6 +128,9 @@ func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *lat
 	case a.BuildpackArtifact != nil:
 		return buildpacks.NewArtifactBuilder(b.localDocker, b.pushImages, b.mode).Build(ctx, out, a, tag)
 
+	case a.KoArtifact != nil:
+		return ko.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages).Build(ctx, out, a, tag)
+
 	default:
 		return "", fmt.Errorf("unexpected type %q for local artifact:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
 	}
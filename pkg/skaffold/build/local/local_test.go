@@ -19,6 +19,7 @@ package local
 import (
 	"context"
 	"errors"
+	"io"
 	"io/ioutil"
 	"testing"
 
@@ -264,6 +265,79 @@ func TestLocalRun(t *testing.T) {
 	}
 }
 
+func TestCreateECRRepositories(t *testing.T) {
+	tests := []struct {
+		description        string
+		pushImages         bool
+		createRepositories bool
+		ensureErr          error
+		expectedEnsured    []string
+		shouldErr          bool
+	}{
+		{
+			description:        "create repositories before push",
+			pushImages:         true,
+			createRepositories: true,
+			expectedEnsured:    []string{"gcr.io/test/image:tag"},
+		},
+		{
+			description:        "creation error",
+			pushImages:         true,
+			createRepositories: true,
+			ensureErr:          errors.New("BUG"),
+			expectedEnsured:    []string{"gcr.io/test/image:tag"},
+			shouldErr:          true,
+		},
+		{
+			description: "disabled",
+			pushImages:  true,
+		},
+		{
+			description:        "images not pushed",
+			createRepositories: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var ensured []string
+			t.Override(&ensureECRRepository, func(_ context.Context, _ io.Writer, image string) error {
+				ensured = append(ensured, image)
+				return test.ensureErr
+			})
+			t.Override(&docker.DefaultAuthHelper, testAuthHelper{})
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return fakeLocalDaemon(&testutil.FakeAPIClient{}), nil
+			})
+			t.Override(&docker.EvalBuildArgs, func(mode config.RunMode, workspace string, a *latest.DockerArtifact) (map[string]*string, error) {
+				return a.BuildArgs, nil
+			})
+			event.InitializeState(latest.Pipeline{
+				Build: latest.BuildConfig{
+					BuildType: latest.BuildType{
+						LocalBuild: &latest.LocalBuild{},
+					},
+				}}, "", true, true, true)
+
+			builder, err := NewBuilder(&mockConfig{
+				local: latest.LocalBuild{
+					Push:        util.BoolPtr(test.pushImages),
+					Concurrency: &constants.DefaultLocalConcurrency,
+				},
+			})
+			t.CheckNoError(err)
+			builder.createRepositories = test.createRepositories
+
+			_, err = builder.Build(context.Background(), ioutil.Discard, tag.ImageTags{"gcr.io/test/image": "gcr.io/test/image:tag"}, []*latest.Artifact{{
+				ImageName:    "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+			}})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedEnsured, ensured)
+		})
+	}
+}
+
 type dummyLocalDaemon struct {
 	docker.LocalDaemon
 }
@@ -276,6 +350,7 @@ func TestNewBuilder(t *testing.T) {
 		shouldErr      bool
 		expectedPush   bool
 		localBuild     latest.LocalBuild
+		deploy         latest.DeployType
 		localClusterFn func(string, string, bool) (bool, error)
 		localDockerFn  func(docker.Config) (docker.LocalDaemon, error)
 	}{
@@ -312,6 +387,67 @@ func TestNewBuilder(t *testing.T) {
 			shouldErr:    false,
 			expectedPush: false,
 		},
+		{
+			description: "pushImages is false when images are only run as local containers",
+			localDockerFn: func(docker.Config) (docker.LocalDaemon, error) {
+				return dummyDaemon, nil
+			},
+			localClusterFn: func(string, string, bool) (bool, error) {
+				return false, nil
+			},
+			deploy:       latest.DeployType{DockerDeploy: &latest.DockerDeploy{}},
+			expectedPush: false,
+		},
+		{
+			description: "pushImages becomes !localCluster when containers are also deployed to a cluster",
+			localDockerFn: func(docker.Config) (docker.LocalDaemon, error) {
+				return dummyDaemon, nil
+			},
+			localClusterFn: func(string, string, bool) (bool, error) {
+				return false, nil
+			},
+			deploy:       latest.DeployType{DockerDeploy: &latest.DockerDeploy{}, KubectlDeploy: &latest.KubectlDeploy{}},
+			expectedPush: true,
+		},
+		{
+			description: "pushImages is true when deploying to Cloud Run from a local cluster",
+			localDockerFn: func(docker.Config) (docker.LocalDaemon, error) {
+				return dummyDaemon, nil
+			},
+			localClusterFn: func(string, string, bool) (bool, error) {
+				return true, nil
+			},
+			deploy:       latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{}},
+			expectedPush: true,
+		},
+		{
+			description: "local:push overrides the Cloud Run default",
+			localDockerFn: func(docker.Config) (docker.LocalDaemon, error) {
+				return dummyDaemon, nil
+			},
+			localClusterFn: func(string, string, bool) (bool, error) {
+				return true, nil
+			},
+			localBuild: latest.LocalBuild{
+				Push: util.BoolPtr(false),
+			},
+			deploy:       latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{}},
+			expectedPush: false,
+		},
+		{
+			description: "local:push overrides the local containers default",
+			localDockerFn: func(docker.Config) (docker.LocalDaemon, error) {
+				return dummyDaemon, nil
+			},
+			localClusterFn: func(string, string, bool) (bool, error) {
+				return false, nil
+			},
+			localBuild: latest.LocalBuild{
+				Push: util.BoolPtr(true),
+			},
+			deploy:       latest.DeployType{DockerDeploy: &latest.DockerDeploy{}},
+			expectedPush: true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -323,7 +459,8 @@ func TestNewBuilder(t *testing.T) {
 			}
 
 			builder, err := NewBuilder(&mockConfig{
-				local: test.localBuild,
+				local:  test.localBuild,
+				deploy: test.deploy,
 			})
 
 			t.CheckError(test.shouldErr, err)
@@ -337,10 +474,12 @@ func TestNewBuilder(t *testing.T) {
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	local                 latest.LocalBuild
+	deploy                latest.DeployType
 }
 
 func (c *mockConfig) Pipeline() latest.Pipeline {
 	var pipeline latest.Pipeline
 	pipeline.Build.BuildType.LocalBuild = &c.local
+	pipeline.Deploy.DeployType = c.deploy
 	return pipeline
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/aws"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -47,12 +48,16 @@ type Builder struct {
 	insecureRegistries map[string]bool
 	muted              build.Muted
 	localPruner        *pruner
+	createRepositories bool
 }
 
 // external dependencies are wrapped
 // into private functions for testability
 
-var getLocalCluster = config.GetLocalCluster
+var (
+	getLocalCluster     = config.GetLocalCluster
+	ensureECRRepository = aws.EnsureECRRepository
+)
 
 type Config interface {
 	docker.Config
@@ -84,11 +89,17 @@ func NewBuilder(cfg Config) (*Builder, error) {
 	}
 
 	var pushImages bool
-	if cfg.Pipeline().Build.LocalBuild.Push == nil {
+	switch {
+	case cfg.Pipeline().Build.LocalBuild.Push != nil:
+		pushImages = *cfg.Pipeline().Build.LocalBuild.Push
+	case runsOnlyLocalContainers(cfg.Pipeline().Deploy.DeployType):
+		logrus.Debugln("push value not present, defaulting to false because images are only run as local Docker containers")
+	case cfg.Pipeline().Deploy.CloudRunDeploy != nil:
+		pushImages = true
+		logrus.Debugln("push value not present, defaulting to true because Cloud Run pulls images from a registry")
+	default:
 		pushImages = !localCluster
 		logrus.Debugf("push value not present, defaulting to %t because localCluster is %t", pushImages, localCluster)
-	} else {
-		pushImages = *cfg.Pipeline().Build.LocalBuild.Push
 	}
 
 	tryImportMissing := cfg.Pipeline().Build.LocalBuild.TryImportMissing
@@ -108,9 +119,16 @@ func NewBuilder(cfg Config) (*Builder, error) {
 		localPruner:        newPruner(localDocker, !cfg.NoPruneChildren()),
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		muted:              cfg.Muted(),
+		createRepositories: config.ShouldCreateECRRepositories(cfg.GlobalConfig()),
 	}, nil
 }
 
+// runsOnlyLocalContainers checks if the docker deployer is the only configured deployer,
+// in which case the images never leave the local Docker daemon.
+func runsOnlyLocalContainers(d latest.DeployType) bool {
+	return d.DockerDeploy != nil && d.CloudRunDeploy == nil && d.HelmDeploy == nil && d.KptDeploy == nil && d.KubectlDeploy == nil && d.KustomizeDeploy == nil
+}
+
 func (b *Builder) PushImages() bool {
 	return b.pushImages
 }
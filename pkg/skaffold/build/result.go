@@ -18,6 +18,7 @@ package build
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
@@ -38,27 +40,33 @@ var (
 // logAggregator provides an interface to create an output writer for each artifact build and later aggregate the logs in build order.
 // The order of output is not guaranteed between multiple builds running concurrently.
 type logAggregator interface {
-	// GetWriter returns an output writer tracked by the logAggregator
-	GetWriter() (io.WriteCloser, error)
+	// GetWriter returns an output writer tracked by the logAggregator for the given artifact.
+	GetWriter(imageName string) (io.WriteCloser, error)
 	// PrintInOrder prints the output from each allotted writer in build order.
 	// It blocks until the instantiated capacity of io writers have been all allotted and closed, or the context is cancelled.
 	PrintInOrder(ctx context.Context, out io.Writer)
 }
 
 type logAggregatorImpl struct {
-	messages   chan chan string
+	messages   chan *artifactMessages
 	size       int
 	capacity   int
 	countMutex sync.Mutex
 }
 
-func (l *logAggregatorImpl) GetWriter() (io.WriteCloser, error) {
+// artifactMessages are the lines of output of an artifact build.
+type artifactMessages struct {
+	imageName string
+	lines     chan string
+}
+
+func (l *logAggregatorImpl) GetWriter(imageName string) (io.WriteCloser, error) {
 	if err := l.checkCapacity(); err != nil {
 		return nil, err
 	}
 	r, w := io.Pipe()
 	ch := make(chan string, buffSize)
-	l.messages <- ch
+	l.messages <- &artifactMessages{imageName: imageName, lines: ch}
 	// write the build output to a buffered channel.
 	go l.writeToChannel(r, ch)
 	return w, nil
@@ -72,12 +80,12 @@ func (l *logAggregatorImpl) PrintInOrder(ctx context.Context, out io.Writer) {
 		l.messages <- nil
 	}()
 	for i := 0; i < l.capacity; i++ {
-		ch := <-l.messages
-		if ch == nil {
+		m := <-l.messages
+		if m == nil {
 			return
 		}
 		// read from each build's message channel and write to the given output.
-		printResult(out, ch)
+		printResult(output.WithArtifact(out, m.imageName), m.lines)
 	}
 }
 
@@ -106,7 +114,68 @@ func (l *logAggregatorImpl) writeToChannel(r io.Reader, lines chan string) {
 }
 
 func newLogAggregator(capacity int) logAggregator {
-	return &logAggregatorImpl{capacity: capacity, messages: make(chan chan string, capacity)}
+	return &logAggregatorImpl{capacity: capacity, messages: make(chan *artifactMessages, capacity)}
+}
+
+// interleavedLogAggregator writes the output of each artifact build as soon as it's produced,
+// one complete line at a time, prefixed with the name of the artifact.
+type interleavedLogAggregator struct {
+	out        io.Writer
+	outMutex   sync.Mutex
+	size       int
+	capacity   int
+	countMutex sync.Mutex
+}
+
+func newInterleavedLogAggregator(out io.Writer, capacity int) logAggregator {
+	return &interleavedLogAggregator{out: out, capacity: capacity}
+}
+
+func (l *interleavedLogAggregator) GetWriter(imageName string) (io.WriteCloser, error) {
+	l.countMutex.Lock()
+	defer l.countMutex.Unlock()
+	if l.size == l.capacity {
+		return nil, fmt.Errorf("failed to create writer: capacity exceeded")
+	}
+	l.size++
+	return &prefixedWriter{aggregator: l, out: output.WithArtifact(l.out, imageName), prefix: fmt.Sprintf("[%s] ", imageName)}, nil
+}
+
+// PrintInOrder does nothing since the output is printed as it's produced.
+func (l *interleavedLogAggregator) PrintInOrder(context.Context, io.Writer) {}
+
+func (l *interleavedLogAggregator) printLine(out io.Writer, prefix string, line []byte) {
+	l.outMutex.Lock()
+	defer l.outMutex.Unlock()
+	fmt.Fprintf(out, "%s%s\n", prefix, line)
+}
+
+// prefixedWriter buffers partial lines so that lines from concurrent builds don't get mixed.
+type prefixedWriter struct {
+	aggregator *interleavedLogAggregator
+	out        io.Writer
+	prefix     string
+	partial    []byte
+}
+
+func (w *prefixedWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		w.aggregator.printLine(w.out, w.prefix, w.partial[:i])
+		w.partial = w.partial[i+1:]
+	}
+}
+
+func (w *prefixedWriter) Close() error {
+	if len(w.partial) > 0 {
+		w.aggregator.printLine(w.out, w.prefix, w.partial)
+		w.partial = nil
+	}
+	return nil
 }
 
 // builtArtifacts stores the results of each artifact build.
@@ -21,12 +21,16 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 type ArtifactBuilder func(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error)
@@ -40,12 +44,12 @@ type scheduler struct {
 	concurrencySem  countingSemaphore
 }
 
-func newScheduler(artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int) *scheduler {
+func newScheduler(artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, logger logAggregator) *scheduler {
 	s := scheduler{
 		artifacts:       artifacts,
 		nodes:           createNodes(artifacts),
 		artifactBuilder: artifactBuilder,
-		logger:          newLogAggregator(len(artifacts)),
+		logger:          logger,
 		results:         newArtifactsStore(),
 		concurrencySem:  newCountingSemaphore(concurrency),
 	}
@@ -88,17 +92,17 @@ func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, i int) error
 
 	event.BuildInProgress(a.ImageName)
 
-	w, err := s.logger.GetWriter()
+	w, err := s.logger.GetWriter(a.ImageName)
 	if err != nil {
 		event.BuildFailed(a.ImageName, err)
 		return err
 	}
 	defer w.Close()
 
-	finalTag, err := performBuild(ctx, w, tags, a, s.artifactBuilder)
+	finalTag, err := performBuild(ctx, w, tags, s.withRequiredArtifacts(a, tags), s.artifactBuilder)
 	if err != nil {
 		event.BuildFailed(a.ImageName, err)
-		return err
+		return sErrors.WithArtifact(a.ImageName, err)
 	}
 
 	s.results.Record(a, finalTag)
@@ -107,8 +111,66 @@ func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, i int) error
 	return nil
 }
 
+// withRequiredArtifacts returns a copy of the artifact with the tags of the artifacts it requires
+// set as build args, using each dependency's alias as key. Required artifacts built by this scheduler
+// use their final tag. Other ones use the tag they were given, if any.
+func (s *scheduler) withRequiredArtifacts(a *latest.Artifact, tags tag.ImageTags) *latest.Artifact {
+	if len(a.Dependencies) == 0 {
+		return a
+	}
+
+	var buildArgs map[string]*string
+	switch {
+	case a.DockerArtifact != nil:
+		buildArgs = a.DockerArtifact.BuildArgs
+	case a.KanikoArtifact != nil:
+		buildArgs = a.KanikoArtifact.BuildArgs
+	default:
+		return a
+	}
+
+	withTags := make(map[string]*string, len(buildArgs)+len(a.Dependencies))
+	for k, v := range buildArgs {
+		withTags[k] = v
+	}
+	for _, d := range a.Dependencies {
+		requiredTag, err := s.results.GetTag(&latest.Artifact{ImageName: d.ImageName})
+		if err != nil {
+			var found bool
+			if requiredTag, found = tags[d.ImageName]; !found {
+				logrus.Warnf("Unable to find the tag of artifact %q required by %q", d.ImageName, a.ImageName)
+				continue
+			}
+		}
+		withTags[d.Alias] = util.StringPtr(requiredTag)
+	}
+
+	copied := *a
+	switch {
+	case a.DockerArtifact != nil:
+		docker := *a.DockerArtifact
+		docker.BuildArgs = withTags
+		copied.DockerArtifact = &docker
+	case a.KanikoArtifact != nil:
+		kaniko := *a.KanikoArtifact
+		kaniko.BuildArgs = withTags
+		copied.KanikoArtifact = &kaniko
+	}
+	return &copied
+}
+
 // InOrder builds a list of artifacts in dependency order.
 func InOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int) ([]Artifact, error) {
+	return schedule(ctx, out, tags, artifacts, artifactBuilder, concurrency, newLogAggregator(len(artifacts)))
+}
+
+// Interleaved builds a list of artifacts in dependency order, like InOrder, but prints the logs of
+// concurrent builds as they are produced, each line prefixed with the name of the artifact.
+func Interleaved(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int) ([]Artifact, error) {
+	return schedule(ctx, out, tags, artifacts, artifactBuilder, concurrency, newInterleavedLogAggregator(out, len(artifacts)))
+}
+
+func schedule(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, logger logAggregator) ([]Artifact, error) {
 	// `concurrency` specifies the max number of builds that can run at any one time. If concurrency is 0, then all builds can run in parallel.
 	if concurrency == 0 {
 		concurrency = len(artifacts)
@@ -116,7 +178,7 @@ func InOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts [
 	if concurrency > 1 {
 		color.Default.Fprintf(out, "Building %d artifacts in parallel\n", concurrency)
 	}
-	s := newScheduler(artifacts, artifactBuilder, concurrency)
+	s := newScheduler(artifacts, artifactBuilder, concurrency, logger)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	return s.run(ctx, out, tags)
@@ -128,5 +190,8 @@ func performBuild(ctx context.Context, cw io.Writer, tags tag.ImageTags, artifac
 	if !present {
 		return "", fmt.Errorf("unable to find tag for image %s", artifact.ImageName)
 	}
-	return build(ctx, cw, artifact, tag)
+	ctx, endTrace := tracing.StartSpan(ctx, "BuildArtifact", map[string]string{"artifact": artifact.ImageName})
+	finalTag, err := build(ctx, cw, artifact, tag)
+	endTrace(err)
+	return finalTag, err
 }
@@ -30,8 +30,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -188,6 +191,99 @@ And new lines
 	}
 }
 
+func TestInOrderJSONOutput(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var buf bytes.Buffer
+		artifacts := []*latest.Artifact{{ImageName: "skaffold/image1"}, {ImageName: "skaffold/image2"}}
+		tags := tag.ImageTags{"skaffold/image1": "skaffold/image1:v0.0.1", "skaffold/image2": "skaffold/image2:v0.0.2"}
+		initializeEvents()
+
+		InOrder(context.Background(), output.NewJSONWriter(&buf), tags, artifacts, func(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+			fmt.Fprintln(out, "building", artifact.ImageName)
+			return fmt.Sprintf("%s:tag", artifact.ImageName), nil
+		}, 0)
+
+		t.CheckContains(`"artifact":"skaffold/image1","payload":"building skaffold/image1"`, buf.String())
+		t.CheckContains(`"artifact":"skaffold/image2","payload":"building skaffold/image2"`, buf.String())
+	})
+}
+
+func TestInterleaved(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		out := new(bytes.Buffer)
+		artifacts := []*latest.Artifact{
+			{ImageName: "skaffold/image1"},
+			{ImageName: "skaffold/image2", Dependencies: []*latest.ArtifactDependency{{ImageName: "skaffold/image1"}}},
+		}
+		tags := tag.ImageTags{
+			"skaffold/image1": "skaffold/image1:v0.0.1",
+			"skaffold/image2": "skaffold/image2:v0.0.2",
+		}
+		buildFunc := func(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+			out.Write([]byte("first line\nsecond"))
+			out.Write([]byte(" line\nno new line"))
+			return fmt.Sprintf("%s:tag", artifact.ImageName), nil
+		}
+		initializeEvents()
+
+		_, err := Interleaved(context.Background(), out, tags, artifacts, buildFunc, 0)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`Building 2 artifacts in parallel
+[skaffold/image1] Building [skaffold/image1]...
+[skaffold/image1] first line
+[skaffold/image1] second line
+[skaffold/image1] no new line
+[skaffold/image2] Building [skaffold/image2]...
+[skaffold/image2] first line
+[skaffold/image2] second line
+[skaffold/image2] no new line
+`, out.String())
+	})
+}
+
+func TestInOrderWithRequiredArtifacts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifacts := []*latest.Artifact{
+			{ImageName: "skaffold/image1", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			{
+				ImageName: "skaffold/image2",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+					BuildArgs: map[string]*string{"KEY": util.StringPtr("VALUE")},
+				}},
+				Dependencies: []*latest.ArtifactDependency{
+					{ImageName: "skaffold/image1", Alias: "BASE"},
+					{ImageName: "skaffold/cached", Alias: "CACHED"},
+					{ImageName: "skaffold/unknown", Alias: "UNKNOWN"},
+				},
+			},
+		}
+		tags := tag.ImageTags{
+			"skaffold/image1": "skaffold/image1:v0.0.1",
+			"skaffold/image2": "skaffold/image2:v0.0.2",
+			"skaffold/cached": "skaffold/cached:v0.0.3@sha256:abac",
+		}
+		var buildArgs map[string]*string
+		buildFunc := func(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+			if artifact.ImageName == "skaffold/image2" {
+				buildArgs = artifact.DockerArtifact.BuildArgs
+			}
+			return tag + "@sha256:digest", nil
+		}
+		initializeEvents()
+
+		_, err := InOrder(context.Background(), ioutil.Discard, tags, artifacts, buildFunc, 1)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(map[string]*string{
+			"KEY":    util.StringPtr("VALUE"),
+			"BASE":   util.StringPtr("skaffold/image1:v0.0.1@sha256:digest"),
+			"CACHED": util.StringPtr("skaffold/cached:v0.0.3@sha256:abac"),
+		}, buildArgs)
+		t.CheckDeepEqual(map[string]*string{"KEY": util.StringPtr("VALUE")}, artifacts[1].DockerArtifact.BuildArgs)
+	})
+}
+
 func TestInOrderConcurrency(t *testing.T) {
 	tests := []struct {
 		artifacts      int
@@ -326,7 +422,7 @@ func TestInOrderForArgs(t *testing.T) {
 			},
 			artifactLen: 5,
 			expected:    nil,
-			err:         fmt.Errorf(`some error occurred while building "artifact2"`),
+			err:         sErrors.WithArtifact("artifact2", fmt.Errorf(`some error occurred while building "artifact2"`)),
 		},
 		{
 			description: "build fails for artifacts with dependencies",
@@ -344,7 +440,7 @@ func TestInOrderForArgs(t *testing.T) {
 			},
 			artifactLen: 5,
 			expected:    nil,
-			err:         fmt.Errorf(`some error occurred while building "artifact2"`),
+			err:         sErrors.WithArtifact("artifact2", fmt.Errorf(`some error occurred while building "artifact2"`)),
 		},
 	}
 	for _, test := range tests {
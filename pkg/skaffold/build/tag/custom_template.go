@@ -22,6 +22,8 @@ import (
 	"text/template"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 // customTemplateTagger implements Tagger
@@ -43,15 +45,17 @@ func NewCustomTemplateTagger(t string, components map[string]Tagger) (Tagger, er
 	}, nil
 }
 
-// GenerateTag generates a tag from a template referencing tagging strategies.
+// GenerateTag generates a tag from a template referencing tagging strategies,
+// the image name and environment variables.
 func (t *customTemplateTagger) GenerateTag(workingDir, imageName string) (string, error) {
 	customMap, err := t.EvaluateComponents(workingDir, imageName)
 	if err != nil {
 		return "", err
 	}
 
+	// Components take precedence over the environment variables.
 	// missingkey=error throws error when map is indexed with an undefined key
-	tag, err := ExecuteCustomTemplate(t.Template.Option("missingkey=error"), customMap)
+	tag, err := util.ExecuteEnvTemplate(t.Template.Option("missingkey=error"), customMap)
 	if err != nil {
 		return "", err
 	}
@@ -61,9 +65,9 @@ func (t *customTemplateTagger) GenerateTag(workingDir, imageName string) (string
 
 // EvaluateComponents creates a custom mapping of component names to their tagger string representation.
 func (t *customTemplateTagger) EvaluateComponents(workingDir, imageName string) (map[string]string, error) {
-	customMap := map[string]string{}
+	customMap := map[string]string{"IMAGE_NAME": imageName}
 
-	gitTagger, _ := NewGitCommit("", "")
+	gitTagger, _ := NewGitCommit("", "", "")
 	dateTimeTagger := NewDateTimeTagger("", "")
 
 	for k, v := range map[string]Tagger{"GIT": gitTagger, "DATE": dateTimeTagger, "SHA": &ChecksumTagger{}} {
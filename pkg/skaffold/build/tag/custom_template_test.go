@@ -80,9 +80,21 @@ func TestTagTemplate_GenerateTag(t *testing.T) {
 		},
 		{
 			description: "missing required components",
-			template:    "{{.FOO}}",
+			template:    "{{.MISSING}}",
 			shouldErr:   true,
 		},
+		{
+			description: "environment variables and image name",
+			template:    "{{.IMAGE_NAME}}-{{.FOO}}-{{.BAR}}",
+			customMap:   map[string]Tagger{"BAR": &ChecksumTagger{}},
+			expected:    "test-BAR-latest",
+		},
+		{
+			description: "components override environment variables",
+			template:    "{{.FOO}}",
+			customMap:   map[string]Tagger{"FOO": &ChecksumTagger{}},
+			expected:    "latest",
+		},
 		{
 			description: "default component name SHA",
 			template:    "{{.SHA}}",
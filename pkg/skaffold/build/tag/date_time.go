@@ -18,6 +18,7 @@ package tag
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"4d63.com/tz"
@@ -25,6 +26,9 @@ import (
 
 const tagTime = "2006-01-02_15-04-05.999_MST"
 
+// validTag matches the characters that a Docker image tag can contain.
+var validTag = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+
 // dateTimeTagger tags an image by the timestamp of the built image
 // dateTimeTagger implements Tagger
 type dateTimeTagger struct {
@@ -61,3 +65,29 @@ func (t *dateTimeTagger) GenerateTag(_, _ string) (string, error) {
 
 	return t.timeFn().In(loc).Format(format), nil
 }
+
+// ValidateDateTime checks that a date format and timezone produce valid image tags
+// that change over time.
+func ValidateDateTime(format, timezone string) error {
+	if format == "" {
+		format = tagTime
+	}
+
+	if timezone != "" {
+		if _, err := tz.LoadLocation(timezone); err != nil {
+			return fmt.Errorf("bad timezone provided: %q, error: %s", timezone, err)
+		}
+	}
+
+	// Two instants that differ in every element of a layout.
+	first := time.Date(2015, 3, 7, 11, 6, 39, 123456789, time.UTC).Format(format)
+	second := time.Date(2021, 11, 28, 22, 45, 18, 987654321, time.UTC).Format(format)
+
+	if first == second {
+		return fmt.Errorf("format %q doesn't contain any date or time element", format)
+	}
+	if !validTag.MatchString(first) {
+		return fmt.Errorf("format %q produces an invalid image tag: %q", format, first)
+	}
+	return nil
+}
@@ -74,3 +74,43 @@ func TestDateTime_GenerateTag(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDateTime(t *testing.T) {
+	tests := []struct {
+		description string
+		format      string
+		timezone    string
+		shouldErr   bool
+	}{
+		{
+			description: "default format",
+		},
+		{
+			description: "user provided format and timezone",
+			format:      "20060102-150405",
+			timezone:    "Europe/Paris",
+		},
+		{
+			description: "invalid timezone",
+			timezone:    "foo",
+			shouldErr:   true,
+		},
+		{
+			description: "no date or time element",
+			format:      "latest",
+			shouldErr:   true,
+		},
+		{
+			description: "invalid tag characters",
+			format:      "2006/01/02 15:04",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := ValidateDateTime(test.format, test.timezone)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
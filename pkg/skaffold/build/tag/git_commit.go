@@ -31,10 +31,14 @@ import (
 
 // GitCommit tags an image by the git commit it was built at.
 type GitCommit struct {
-	prefix   string
-	runGitFn func(string) (string, error)
+	prefix      string
+	dirtySuffix string
+	runGitFn    func(string) (string, error)
 }
 
+// defaultDirtySuffix is appended to the tags of images built from a dirty worktree.
+const defaultDirtySuffix = "-dirty"
+
 var variants = map[string]func(string) (string, error){
 	"":                gitTags,
 	"tags":            gitTags,
@@ -45,15 +49,21 @@ var variants = map[string]func(string) (string, error){
 }
 
 // NewGitCommit creates a new git commit tagger. It fails if the tagger variant is invalid.
-func NewGitCommit(prefix, variant string) (*GitCommit, error) {
+// An empty dirty suffix defaults to `-dirty`.
+func NewGitCommit(prefix, variant, dirtySuffix string) (*GitCommit, error) {
 	runGitFn, found := variants[strings.ToLower(variant)]
 	if !found {
 		return nil, fmt.Errorf("%q is not a valid git tagger variant", variant)
 	}
 
+	if dirtySuffix == "" {
+		dirtySuffix = defaultDirtySuffix
+	}
+
 	return &GitCommit{
-		prefix:   prefix,
-		runGitFn: runGitFn,
+		prefix:      prefix,
+		dirtySuffix: dirtySuffix,
+		runGitFn:    runGitFn,
 	}, nil
 }
 
@@ -70,7 +80,7 @@ func (t *GitCommit) GenerateTag(workingDir, _ string) (string, error) {
 	}
 
 	if len(changes) > 0 {
-		return fmt.Sprintf("%s%s-dirty", t.prefix, ref), nil
+		return t.prefix + sanitizeTag(ref+t.dirtySuffix), nil
 	}
 
 	return t.prefix + sanitizeTag(ref), nil
@@ -329,7 +329,7 @@ func TestGitCommit_GenerateTag(t *testing.T) {
 				"TreeSha":         test.variantTreeSha,
 				"AbbrevTreeSha":   test.variantAbbrevTreeSha,
 			} {
-				tagger, err := NewGitCommit("", variant)
+				tagger, err := NewGitCommit("", variant, "")
 				t.CheckNoError(err)
 
 				tag, err := tagger.GenerateTag(workspace, "test")
@@ -388,7 +388,7 @@ func TestGitCommit_GenerateFullyQualifiedImageName(t *testing.T) {
 				"TreeSha":         test.variantTreeSha,
 				"AbbrevTreeSha":   test.variantAbbrevTreeSha,
 			} {
-				tagger, err := NewGitCommit("", variant)
+				tagger, err := NewGitCommit("", variant, "")
 				t.CheckNoError(err)
 
 				tag, err := GenerateFullyQualifiedImageName(tagger, workspace, "test")
@@ -400,7 +400,7 @@ func TestGitCommit_GenerateFullyQualifiedImageName(t *testing.T) {
 }
 
 func TestGitCommit_CustomTemplate(t *testing.T) {
-	gitCommitExample, _ := NewGitCommit("", "CommitSha")
+	gitCommitExample, _ := NewGitCommit("", "CommitSha", "")
 	tests := []struct {
 		description   string
 		template      string
@@ -458,30 +458,30 @@ func TestGitCommitSubDirectory(t *testing.T) {
 		gitInit(t.T, tmpDir.Root()).mkdir("sub/sub").commit("initial")
 		workspace := tmpDir.Path("sub/sub")
 
-		tagger, err := NewGitCommit("", "Tags")
+		tagger, err := NewGitCommit("", "Tags", "")
 		t.CheckNoError(err)
 		tag, err := tagger.GenerateTag(workspace, "test")
 		t.CheckNoError(err)
 		t.CheckDeepEqual("a7b32a6", tag)
 
-		tagger, err = NewGitCommit("", "CommitSha")
+		tagger, err = NewGitCommit("", "CommitSha", "")
 		t.CheckNoError(err)
 		tag, err = tagger.GenerateTag(workspace, "test")
 		t.CheckNoError(err)
 		t.CheckDeepEqual("a7b32a69335a6daa51bd89cc1bf30bd31df228ba", tag)
 
-		tagger, err = NewGitCommit("", "AbbrevCommitSha")
+		tagger, err = NewGitCommit("", "AbbrevCommitSha", "")
 		t.CheckNoError(err)
 		tag, err = tagger.GenerateTag(workspace, "test")
 		t.CheckNoError(err)
 		t.CheckDeepEqual("a7b32a6", tag)
 
-		tagger, err = NewGitCommit("", "TreeSha")
+		tagger, err = NewGitCommit("", "TreeSha", "")
 		t.CheckNoError(err)
 		_, err = tagger.GenerateTag(workspace, "test")
 		t.CheckErrorAndDeepEqual(true, err, "a7b32a6", tag)
 
-		tagger, err = NewGitCommit("", "AbbrevTreeSha")
+		tagger, err = NewGitCommit("", "AbbrevTreeSha", "")
 		t.CheckNoError(err)
 		_, err = tagger.GenerateTag(workspace, "test")
 		t.CheckErrorAndDeepEqual(true, err, "a7b32a6", tag)
@@ -494,13 +494,13 @@ func TestPrefix(t *testing.T) {
 		gitInit(t.T, tmpDir.Root()).commit("initial")
 		workspace := tmpDir.Path(".")
 
-		tagger, err := NewGitCommit("tag-", "Tags")
+		tagger, err := NewGitCommit("tag-", "Tags", "")
 		t.CheckNoError(err)
 		tag, err := tagger.GenerateTag(workspace, "test")
 		t.CheckNoError(err)
 		t.CheckDeepEqual("tag-a7b32a6", tag)
 
-		tagger, err = NewGitCommit("commit-", "CommitSha")
+		tagger, err = NewGitCommit("commit-", "CommitSha", "")
 		t.CheckNoError(err)
 		tag, err = tagger.GenerateTag(workspace, "test")
 		t.CheckNoError(err)
@@ -508,9 +508,33 @@ func TestPrefix(t *testing.T) {
 	})
 }
 
+func TestDirtySuffix(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		gitInit(t.T, tmpDir.Root()).
+			write("source.go", "code").
+			add("source.go").
+			commit("initial").
+			write("source.go", "updated code")
+		workspace := tmpDir.Path(".")
+
+		tagger, err := NewGitCommit("", "AbbrevCommitSha", ".wip")
+		t.CheckNoError(err)
+		tag, err := tagger.GenerateTag(workspace, "test")
+		t.CheckNoError(err)
+		t.CheckDeepEqual("eefe1b9.wip", tag)
+
+		tagger, err = NewGitCommit("", "AbbrevCommitSha", "")
+		t.CheckNoError(err)
+		tag, err = tagger.GenerateTag(workspace, "test")
+		t.CheckNoError(err)
+		t.CheckDeepEqual("eefe1b9-dirty", tag)
+	})
+}
+
 func TestInvalidVariant(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
-		_, err := NewGitCommit("", "Invalid")
+		_, err := NewGitCommit("", "Invalid", "")
 
 		t.CheckErrorContains("\"Invalid\" is not a valid git tagger variant", err)
 	})
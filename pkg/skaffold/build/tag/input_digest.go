@@ -0,0 +1,36 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+// inputDigestTagger tags an image with a digest of its build inputs,
+// so that the same sources always produce the same tag.
+type inputDigestTagger struct {
+	digest func(imageName string) (string, error)
+}
+
+// NewInputDigestTagger creates a new inputDigestTagger that uses the given
+// function to compute the digest of an artifact's inputs.
+func NewInputDigestTagger(digest func(imageName string) (string, error)) Tagger {
+	return &inputDigestTagger{
+		digest: digest,
+	}
+}
+
+// GenerateTag returns the digest of the artifact's inputs.
+func (t *inputDigestTagger) GenerateTag(_, imageName string) (string, error) {
+	return t.digest(imageName)
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestInputDigestTagger_GenerateTag(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tagger := NewInputDigestTagger(func(imageName string) (string, error) {
+			if imageName != "image" {
+				return "", errors.New("unknown artifact")
+			}
+			return "d99ab295a682897269b4db0fe7c136ea", nil
+		})
+
+		tag, err := tagger.GenerateTag(".", "image")
+		t.CheckNoError(err)
+		t.CheckDeepEqual("d99ab295a682897269b4db0fe7c136ea", tag)
+
+		_, err = tagger.GenerateTag(".", "other")
+		t.CheckError(true, err)
+	})
+}
@@ -34,6 +34,18 @@ type ContextConfig struct {
 	DebugHelpersRegistry string        `yaml:"debug-helpers-registry,omitempty"`
 	UpdateCheck          *bool         `yaml:"update-check,omitempty"`
 	Survey               *SurveyConfig `yaml:"survey,omitempty"`
+	// WatchPollInterval is the interval (in ms) used when `--watch-poll-interval` isn't set.
+	WatchPollInterval *int `yaml:"watch-poll-interval,omitempty"`
+	// Cleanup tells if deployments are deleted when `skaffold dev` or `skaffold debug` exits and `--cleanup` isn't set.
+	Cleanup *bool `yaml:"cleanup,omitempty"`
+	// NoPrune tells if the images built by Skaffold are kept on exit when `--no-prune` isn't set.
+	NoPrune *bool `yaml:"no-prune,omitempty"`
+	// DefaultRepoStrategy is how image names are rewritten with the default repo: `escape`, `prefix` or `replace`.
+	DefaultRepoStrategy string `yaml:"default-repo-strategy,omitempty"`
+	// CreateECRRepositories tells if missing ECR repositories are created before pushing images.
+	CreateECRRepositories *bool `yaml:"create-ecr-repositories,omitempty"`
+	// UpdateChannel is the release channel of the update check when `--update-channel` isn't set: `stable` or `latest`.
+	UpdateChannel string `yaml:"update-channel,omitempty"`
 }
 
 // SurveyConfig is the survey config information
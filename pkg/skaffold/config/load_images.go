@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// For testing
+var podmanArchiveDir = func() (string, error) {
+	return ioutil.TempDir("", "skaffold-podman")
+}
+
+// loadKindImage loads an image into every node of a kind cluster.
+func loadKindImage(ctx context.Context, image ImageToLoad) ([]byte, error) {
+	kindCluster := KindClusterName(image.Cluster)
+	if image.UsePodman {
+		return loadPodmanImage(ctx, image.Tag, "kind", "load", "image-archive", "--name", kindCluster)
+	}
+	return util.RunCmdOut(exec.CommandContext(ctx, "kind", "load", "docker-image", "--name", kindCluster, image.Tag))
+}
+
+// loadK3dImage loads an image into every node of a k3s cluster.
+func loadK3dImage(ctx context.Context, image ImageToLoad) ([]byte, error) {
+	k3dCluster := K3dClusterName(image.Cluster)
+	if image.UsePodman {
+		return loadPodmanImage(ctx, image.Tag, "k3d", "image", "import", "--cluster", k3dCluster)
+	}
+	return util.RunCmdOut(exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", k3dCluster, image.Tag))
+}
+
+// loadMicrok8sImage imports an image into the containerd of a microk8s node.
+func loadMicrok8sImage(ctx context.Context, image ImageToLoad) ([]byte, error) {
+	cli := "docker"
+	if image.UsePodman {
+		cli = "podman"
+	}
+	return util.RunCmdOut(exec.CommandContext(ctx, "sh", "-c", `"$0" save "$1" | microk8s ctr image import -`, cli, image.Tag))
+}
+
+// loadPodmanImage saves an image built with Podman to a temporary archive
+// and runs the given command to load that archive into the cluster nodes.
+func loadPodmanImage(ctx context.Context, tag string, load ...string) ([]byte, error) {
+	dir, err := podmanArchiveDir()
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "image.tar")
+	if output, err := util.RunCmdOut(exec.CommandContext(ctx, "podman", "save", "-o", archive, tag)); err != nil {
+		return output, err
+	}
+
+	return util.RunCmdOut(exec.CommandContext(ctx, load[0], append(load[1:], archive)...))
+}
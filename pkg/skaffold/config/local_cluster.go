@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+)
+
+// LocalClusterDetector recognizes the Kubernetes contexts of a local cluster tool.
+// Images built for a local cluster are not pushed.
+type LocalClusterDetector interface {
+	// Matches tells if the given Kubernetes context points to a cluster of this tool.
+	Matches(kubeContext string) bool
+
+	// RequiresImageLoading tells if the built images have to be loaded into the cluster
+	// because its nodes don't share the local Docker daemon.
+	RequiresImageLoading() bool
+
+	// LoadImage loads a built image into the cluster nodes.
+	// It's only called when RequiresImageLoading is true.
+	LoadImage(ctx context.Context, image ImageToLoad) ([]byte, error)
+}
+
+// ImageToLoad describes an image to be loaded into the nodes of a local cluster.
+type ImageToLoad struct {
+	// Tag is the tag of the built image.
+	Tag string
+
+	// Cluster is the name of the cluster, as found in the kubeconfig.
+	Cluster string
+
+	// UsePodman is true when the image was built with Podman instead of Docker.
+	UsePodman bool
+}
+
+// localClusterDetectors are checked in order to decide if a context is local.
+var localClusterDetectors = []LocalClusterDetector{
+	contextNames{constants.DefaultMinikubeContext, constants.DefaultDockerForDesktopContext, constants.DefaultDockerDesktopContext},
+	loadingDetector{matches: IsKindCluster, load: loadKindImage},
+	loadingDetector{matches: IsK3dCluster, load: loadK3dImage},
+	loadingDetector{matches: IsMicrok8sCluster, load: loadMicrok8sImage},
+}
+
+// RegisterLocalClusterDetector adds a detector for a new local cluster tool.
+func RegisterLocalClusterDetector(d LocalClusterDetector) {
+	localClusterDetectors = append(localClusterDetectors, d)
+}
+
+// contextNames detects local clusters that share the local Docker daemon by their context names.
+type contextNames []string
+
+func (c contextNames) Matches(kubeContext string) bool {
+	for _, name := range c {
+		if kubeContext == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (contextNames) RequiresImageLoading() bool { return false }
+
+func (contextNames) LoadImage(context.Context, ImageToLoad) ([]byte, error) { return nil, nil }
+
+// loadingDetector detects local clusters whose nodes run their own container runtime.
+type loadingDetector struct {
+	matches func(kubeContext string) bool
+	load    func(ctx context.Context, image ImageToLoad) ([]byte, error)
+}
+
+func (l loadingDetector) Matches(kubeContext string) bool { return l.matches(kubeContext) }
+
+func (loadingDetector) RequiresImageLoading() bool { return true }
+
+func (l loadingDetector) LoadImage(ctx context.Context, image ImageToLoad) ([]byte, error) {
+	return l.load(ctx, image)
+}
+
+// DetectLocalCluster returns the detector of the local cluster tool
+// the given Kubernetes context points to, or nil for other clusters.
+func DetectLocalCluster(kubeContext string) LocalClusterDetector {
+	for _, d := range localClusterDetectors {
+		if d.Matches(kubeContext) {
+			return d
+		}
+	}
+	return nil
+}
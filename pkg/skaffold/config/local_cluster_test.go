@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeDetector struct {
+	prefix      string
+	loadsImages bool
+}
+
+func (f fakeDetector) Matches(kubeContext string) bool {
+	return strings.HasPrefix(kubeContext, f.prefix)
+}
+
+func (f fakeDetector) RequiresImageLoading() bool { return f.loadsImages }
+
+func (f fakeDetector) LoadImage(context.Context, ImageToLoad) ([]byte, error) { return nil, nil }
+
+func TestRegisterLocalClusterDetector(t *testing.T) {
+	tests := []struct {
+		description          string
+		context              string
+		expectedLocal        bool
+		expectedImageLoading bool
+	}{
+		{
+			description:          "registered tool sharing the docker daemon",
+			context:              "shared-dev",
+			expectedLocal:        true,
+			expectedImageLoading: false,
+		},
+		{
+			description:          "registered tool with its own runtime",
+			context:              "nodes-dev",
+			expectedLocal:        true,
+			expectedImageLoading: true,
+		},
+		{
+			description:          "built-in detectors are kept",
+			context:              "kind-kind",
+			expectedLocal:        true,
+			expectedImageLoading: true,
+		},
+		{
+			description: "remote cluster",
+			context:     "gke_project_zone_cluster",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&localClusterDetectors, append([]LocalClusterDetector{}, localClusterDetectors...))
+
+			RegisterLocalClusterDetector(fakeDetector{prefix: "shared-"})
+			RegisterLocalClusterDetector(fakeDetector{prefix: "nodes-", loadsImages: true})
+
+			t.CheckDeepEqual(test.expectedLocal, isDefaultLocal(test.context, false))
+			t.CheckDeepEqual(test.expectedImageLoading, IsImageLoadingRequired(test.context))
+		})
+	}
+}
+
+func TestLoadImage(t *testing.T) {
+	tests := []struct {
+		description    string
+		context        string
+		image          ImageToLoad
+		commands       util.Command
+		expectedOutput string
+		shouldErr      bool
+	}{
+		{
+			description:    "kind",
+			context:        "kind-other",
+			image:          ImageToLoad{Tag: "tag1", Cluster: "kind-other"},
+			commands:       testutil.CmdRunOut("kind load docker-image --name other tag1", "output: image loaded"),
+			expectedOutput: "output: image loaded",
+		},
+		{
+			description: "kind with podman",
+			context:     "kind-kind",
+			image:       ImageToLoad{Tag: "tag1", Cluster: "kind-kind", UsePodman: true},
+			commands: testutil.
+				CmdRunOut("podman save -o /archive/image.tar tag1", "").
+				AndRunOut("kind load image-archive --name kind /archive/image.tar", "output: image loaded"),
+			expectedOutput: "output: image loaded",
+		},
+		{
+			description:    "k3d",
+			context:        "k3d-k3s-default",
+			image:          ImageToLoad{Tag: "tag1", Cluster: "k3d-k3s-default"},
+			commands:       testutil.CmdRunOut("k3d image import --cluster k3s-default tag1", "output: image loaded"),
+			expectedOutput: "output: image loaded",
+		},
+		{
+			description: "k3d with podman",
+			context:     "k3d-k3s-default",
+			image:       ImageToLoad{Tag: "tag1", Cluster: "k3d-k3s-default", UsePodman: true},
+			commands: testutil.
+				CmdRunOut("podman save -o /archive/image.tar tag1", "").
+				AndRunOut("k3d image import --cluster k3s-default /archive/image.tar", "output: image loaded"),
+			expectedOutput: "output: image loaded",
+		},
+		{
+			description: "podman save error",
+			context:     "kind-kind",
+			image:       ImageToLoad{Tag: "tag1", Cluster: "kind-kind", UsePodman: true},
+			commands:    testutil.CmdRunOutErr("podman save -o /archive/image.tar tag1", "output: error!", errors.New("BUG")),
+			shouldErr:   true,
+		},
+		{
+			description:    "microk8s",
+			context:        "microk8s",
+			image:          ImageToLoad{Tag: "tag1", Cluster: "microk8s-cluster"},
+			commands:       testutil.CmdRunOut(`sh -c "$0" save "$1" | microk8s ctr image import - docker tag1`, "output: image loaded"),
+			expectedOutput: "output: image loaded",
+		},
+		{
+			description:    "microk8s with podman",
+			context:        "microk8s",
+			image:          ImageToLoad{Tag: "tag1", Cluster: "microk8s-cluster", UsePodman: true},
+			commands:       testutil.CmdRunOut(`sh -c "$0" save "$1" | microk8s ctr image import - podman tag1`, "output: image loaded"),
+			expectedOutput: "output: image loaded",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&podmanArchiveDir, func() (string, error) { return "/archive", nil })
+
+			output, err := DetectLocalCluster(test.context).LoadImage(context.Background(), test.image)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedOutput, string(output))
+			}
+		})
+	}
+}
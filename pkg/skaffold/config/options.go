@@ -17,17 +17,90 @@ limitations under the License.
 package config
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
-// PortForwardOptions are options set by the command line for port forwarding
-// with additional configuration information as well
+// Port forwarding modes that can be combined with `--port-forward`.
+const (
+	// PortForwardOff disables port forwarding. This is the default.
+	PortForwardOff = "off"
+	// PortForwardUser forwards the resources listed in the `portForward` section of skaffold.yaml.
+	PortForwardUser = "user"
+	// PortForwardServices forwards the ports of the services deployed by Skaffold.
+	PortForwardServices = "services"
+	// PortForwardDebug forwards the debugging ports that `skaffold debug` sets up on containers.
+	PortForwardDebug = "debug"
+	// PortForwardPods forwards all the container ports of the pods deployed by Skaffold.
+	PortForwardPods = "pods"
+)
+
+// PortForwardOptions are the port forwarding modes set by the command line.
+// `true`, the value of `--port-forward` without a mode, means `user` and `services`,
+// and also `debug` for `skaffold debug`. `false` is the same as `off`.
 type PortForwardOptions struct {
-	Enabled     bool
-	ForwardPods bool
+	Modes []string
+}
+
+func (p *PortForwardOptions) Type() string {
+	return "string"
+}
+
+func (p *PortForwardOptions) Set(value string) error {
+	for _, mode := range strings.Split(value, ",") {
+		switch mode {
+		case "true", "false", PortForwardOff, PortForwardUser, PortForwardServices, PortForwardDebug, PortForwardPods:
+			p.Modes = append(p.Modes, mode)
+		default:
+			return fmt.Errorf("invalid port forwarding mode %q. Valid values are %s, %s, %s, %s, %s, true or false", mode, PortForwardOff, PortForwardUser, PortForwardServices, PortForwardDebug, PortForwardPods)
+		}
+	}
+	return nil
+}
+
+func (p *PortForwardOptions) String() string {
+	if len(p.Modes) == 0 {
+		return PortForwardOff
+	}
+	return strings.Join(p.Modes, ",")
+}
+
+// Enabled tells if anything is port forwarded.
+func (p PortForwardOptions) Enabled() bool {
+	for _, mode := range p.Modes {
+		if mode != PortForwardOff && mode != "false" {
+			return true
+		}
+	}
+	return false
+}
+
+// ForwardUser tells if the resources listed in the `portForward` section of skaffold.yaml are forwarded.
+func (p PortForwardOptions) ForwardUser(RunMode) bool {
+	return p.has(PortForwardUser) || p.has("true")
+}
+
+// ForwardServices tells if the ports of the services deployed by Skaffold are forwarded.
+func (p PortForwardOptions) ForwardServices(RunMode) bool {
+	return p.has(PortForwardServices) || p.has("true")
+}
+
+// ForwardDebug tells if the debugging ports of the containers are forwarded.
+func (p PortForwardOptions) ForwardDebug(mode RunMode) bool {
+	return p.has(PortForwardDebug) || (mode == RunModes.Debug && p.has("true"))
+}
+
+// ForwardPods tells if all the container ports of the pods deployed by Skaffold are forwarded.
+func (p PortForwardOptions) ForwardPods(RunMode) bool {
+	return p.has(PortForwardPods)
+}
+
+func (p PortForwardOptions) has(mode string) bool {
+	return util.StrSliceContains(p.Modes, mode)
 }
 
 // WaitForDeletions configures the wait for pending deletions.
@@ -43,6 +116,7 @@ type SkaffoldOptions struct {
 	ConfigurationFile     string
 	GlobalConfig          string
 	Cleanup               bool
+	DeleteNamespaces      bool
 	Notification          bool
 	Tail                  bool
 	SkipTests             bool
@@ -60,6 +134,8 @@ type SkaffoldOptions struct {
 	ProfileAutoActivation bool
 	DryRun                bool
 	SkipRender            bool
+	Timings               bool
+	Interactive           bool
 
 	// Add Skaffold-specific labels including runID, deployer labels, etc.
 	// `CustomLabels` are still applied if this is false. Must only be used in
@@ -68,24 +144,27 @@ type SkaffoldOptions struct {
 	AddSkaffoldLabels bool
 	DetectMinikube    bool
 
-	PortForward        PortForwardOptions
-	CustomTag          string
-	Namespace          string
-	CacheFile          string
-	Trigger            string
-	KubeContext        string
-	KubeConfig         string
-	DigestSource       string
-	WatchPollInterval  int
-	DefaultRepo        StringOrUndefined
-	CustomLabels       []string
-	TargetImages       []string
-	Profiles           []string
-	InsecureRegistries []string
-	Muted              Muted
-	Command            string
-	RPCPort            int
-	RPCHTTPPort        int
+	PortForward         PortForwardOptions
+	CustomTag           string
+	Namespace           string
+	CacheFile           string
+	Trigger             string
+	KubeContext         string
+	KubeConfig          string
+	DigestSource        string
+	WatchPollInterval   int
+	DefaultRepo         StringOrUndefined
+	CustomLabels        []string
+	TargetImages        []string
+	LogInclude          []string
+	LogExclude          []string
+	Profiles            []string
+	ConfigurationFilter []string
+	InsecureRegistries  []string
+	Muted               Muted
+	Command             string
+	RPCPort             int
+	RPCHTTPPort         int
 
 	// TODO(https://github.com/GoogleContainerTools/skaffold/issues/3668):
 	// remove minikubeProfile from here and instead detect it by matching the
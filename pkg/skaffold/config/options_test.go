@@ -83,3 +83,95 @@ func TestPrune(t *testing.T) {
 	opts = SkaffoldOptions{NoPrune: true, CacheArtifacts: true}
 	testutil.CheckDeepEqual(t, false, opts.Prune())
 }
+
+func TestPortForwardOptions(t *testing.T) {
+	tests := []struct {
+		description      string
+		value            string
+		mode             RunMode
+		expectedEnabled  bool
+		expectedUser     bool
+		expectedServices bool
+		expectedDebug    bool
+		expectedPods     bool
+		shouldErr        bool
+	}{
+		{
+			description: "default",
+		},
+		{
+			description: "off",
+			value:       "off",
+		},
+		{
+			description: "false",
+			value:       "false",
+		},
+		{
+			description:      "true",
+			value:            "true",
+			mode:             RunModes.Dev,
+			expectedEnabled:  true,
+			expectedUser:     true,
+			expectedServices: true,
+		},
+		{
+			description:      "true in debug mode",
+			value:            "true",
+			mode:             RunModes.Debug,
+			expectedEnabled:  true,
+			expectedUser:     true,
+			expectedServices: true,
+			expectedDebug:    true,
+		},
+		{
+			description:     "user and debug",
+			value:           "user,debug",
+			mode:            RunModes.Dev,
+			expectedEnabled: true,
+			expectedUser:    true,
+			expectedDebug:   true,
+		},
+		{
+			description:     "pods",
+			value:           "pods",
+			mode:            RunModes.Debug,
+			expectedEnabled: true,
+			expectedPods:    true,
+		},
+		{
+			description: "invalid mode",
+			value:       "user,all",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var opts PortForwardOptions
+			if test.value != "" {
+				err := opts.Set(test.value)
+				t.CheckError(test.shouldErr, err)
+				if test.shouldErr {
+					return
+				}
+			}
+
+			t.CheckDeepEqual(test.expectedEnabled, opts.Enabled())
+			t.CheckDeepEqual(test.expectedUser, opts.ForwardUser(test.mode))
+			t.CheckDeepEqual(test.expectedServices, opts.ForwardServices(test.mode))
+			t.CheckDeepEqual(test.expectedDebug, opts.ForwardDebug(test.mode))
+			t.CheckDeepEqual(test.expectedPods, opts.ForwardPods(test.mode))
+		})
+	}
+}
+
+func TestPortForwardOptionsString(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var opts PortForwardOptions
+		t.CheckDeepEqual("off", opts.String())
+
+		opts.Set("user")
+		opts.Set("debug")
+		t.CheckDeepEqual("user,debug", opts.String())
+	})
+}
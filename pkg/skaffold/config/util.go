@@ -169,6 +169,19 @@ func GetDefaultRepo(configFile string, cliValue *string) (string, error) {
 	return cfg.DefaultRepo, nil
 }
 
+// GetDefaultRepoStrategy returns how image names are rewritten with the default repo.
+// An empty string means the default strategy.
+func GetDefaultRepoStrategy(configFile string) (string, error) {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return "", err
+	}
+	if cfg.DefaultRepoStrategy != "" {
+		logrus.Infof("Using default-repo-strategy=%s from config", cfg.DefaultRepoStrategy)
+	}
+	return cfg.DefaultRepoStrategy, nil
+}
+
 func GetLocalCluster(configFile string, minikubeProfile string, detectMinikubeCluster bool) (bool, error) {
 	if minikubeProfile != "" {
 		return true, nil
@@ -214,12 +227,44 @@ func GetDebugHelpersRegistry(configFile string) (string, error) {
 	return constants.DefaultDebugHelpersRegistry, nil
 }
 
+// GetWatchPollInterval returns the watch interval (in ms) from the global config, if set.
+func GetWatchPollInterval(configFile string) (*int, error) {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.WatchPollInterval != nil {
+		logrus.Infof("Using watch-poll-interval=%d from config", *cfg.WatchPollInterval)
+	}
+	return cfg.WatchPollInterval, nil
+}
+
+// GetCleanup returns whether deployments are deleted on exit according to the global config, if set.
+func GetCleanup(configFile string) (*bool, error) {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Cleanup != nil {
+		logrus.Infof("Using cleanup=%v from config", *cfg.Cleanup)
+	}
+	return cfg.Cleanup, nil
+}
+
+// GetNoPrune returns whether built images are kept on exit according to the global config, if set.
+func GetNoPrune(configFile string) (*bool, error) {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.NoPrune != nil {
+		logrus.Infof("Using no-prune=%v from config", *cfg.NoPrune)
+	}
+	return cfg.NoPrune, nil
+}
+
 func isDefaultLocal(kubeContext string, detectMinikubeCluster bool) bool {
-	if kubeContext == constants.DefaultMinikubeContext ||
-		kubeContext == constants.DefaultDockerForDesktopContext ||
-		kubeContext == constants.DefaultDockerDesktopContext ||
-		IsKindCluster(kubeContext) ||
-		IsK3dCluster(kubeContext) {
+	if DetectLocalCluster(kubeContext) != nil {
 		return true
 	}
 	if detectMinikubeCluster {
@@ -230,7 +275,8 @@ func isDefaultLocal(kubeContext string, detectMinikubeCluster bool) bool {
 
 // IsImageLoadingRequired checks if the cluster requires loading images into it
 func IsImageLoadingRequired(kubeContext string) bool {
-	return IsKindCluster(kubeContext) || IsK3dCluster(kubeContext)
+	d := DetectLocalCluster(kubeContext)
+	return d != nil && d.RequiresImageLoading()
 }
 
 // IsKindCluster checks that the given `kubeContext` is talking to `kind`.
@@ -289,6 +335,20 @@ func K3dClusterName(clusterName string) string {
 	return clusterName
 }
 
+// IsMicrok8sCluster checks that the given `kubeContext` is talking to `microk8s`.
+func IsMicrok8sCluster(kubeContext string) bool {
+	return kubeContext == constants.DefaultMicrok8sContext
+}
+
+// ShouldCreateECRRepositories tells if missing ECR repositories are created before pushing images.
+func ShouldCreateECRRepositories(configFile string) bool {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return false
+	}
+	return cfg.CreateECRRepositories != nil && *cfg.CreateECRRepositories
+}
+
 func IsUpdateCheckEnabled(configfile string) bool {
 	cfg, err := GetConfigForCurrentKubectx(configfile)
 	if err != nil {
@@ -297,6 +357,15 @@ func IsUpdateCheckEnabled(configfile string) bool {
 	return cfg == nil || cfg.UpdateCheck == nil || *cfg.UpdateCheck
 }
 
+// GetUpdateChannel returns the release channel of the update check set in the global config, if any.
+func GetUpdateChannel(configfile string) string {
+	cfg, err := GetConfigForCurrentKubectx(configfile)
+	if err != nil || cfg == nil {
+		return ""
+	}
+	return cfg.UpdateChannel
+}
+
 func ShouldDisplayPrompt(configfile string) bool {
 	cfg, disabled := isSurveyPromptDisabled(configfile)
 	return !disabled && !recentlyPromptedOrTaken(cfg)
@@ -265,6 +265,40 @@ func TestIsUpdateCheckEnabled(t *testing.T) {
 	}
 }
 
+func TestGetUpdateChannel(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         *ContextConfig
+		readErr     error
+		expected    string
+	}{
+		{
+			description: "config update-channel is set",
+			cfg:         &ContextConfig{UpdateChannel: "latest"},
+			expected:    "latest",
+		},
+		{
+			description: "config update-channel is not set",
+			cfg:         &ContextConfig{},
+		},
+		{
+			description: "config is nil",
+			cfg:         nil,
+		},
+		{
+			description: "config has err",
+			readErr:     fmt.Errorf("error while reading"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&GetConfigForCurrentKubectx, func(string) (*ContextConfig, error) { return test.cfg, test.readErr })
+			actual := GetUpdateChannel("dummyconfig")
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
 func TestIsDefaultLocal(t *testing.T) {
 	tests := []struct {
 		context       string
@@ -276,6 +310,7 @@ func TestIsDefaultLocal(t *testing.T) {
 		{context: "docker-for-desktop", expectedLocal: true},
 		{context: "minikube", expectedLocal: true},
 		{context: "docker-desktop", expectedLocal: true},
+		{context: "microk8s", expectedLocal: true},
 		{context: "anything-else", expectedLocal: false},
 		{context: "kind@blah", expectedLocal: false},
 		{context: "other-kind", expectedLocal: false},
@@ -306,6 +341,7 @@ func TestIsImageLoadingRequired(t *testing.T) {
 		{context: "kind-other", expectedImageLoadingRequired: true},
 		{context: "kind@kind", expectedImageLoadingRequired: true},
 		{context: "k3d-k3s-default", expectedImageLoadingRequired: true},
+		{context: "microk8s", expectedImageLoadingRequired: true},
 		{context: "docker-for-desktop", expectedImageLoadingRequired: false},
 		{context: "minikube", expectedImageLoadingRequired: false},
 		{context: "docker-desktop", expectedImageLoadingRequired: false},
@@ -384,6 +420,24 @@ func TestIsK3dCluster(t *testing.T) {
 	}
 }
 
+func TestIsMicrok8sCluster(t *testing.T) {
+	tests := []struct {
+		context            string
+		expectedIsMicrok8s bool
+	}{
+		{context: "microk8s", expectedIsMicrok8s: true},
+		{context: "microk8s-cluster", expectedIsMicrok8s: false},
+		{context: "minikube", expectedIsMicrok8s: false},
+	}
+	for _, test := range tests {
+		testutil.Run(t, "", func(t *testutil.T) {
+			isMicrok8s := IsMicrok8sCluster(test.context)
+
+			t.CheckDeepEqual(test.expectedIsMicrok8s, isMicrok8s)
+		})
+	}
+}
+
 func TestK3dClusterName(t *testing.T) {
 	tests := []struct {
 		kubeCluster  string
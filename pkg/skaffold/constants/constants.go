@@ -33,6 +33,7 @@ const (
 	DefaultMinikubeContext         = "minikube"
 	DefaultDockerForDesktopContext = "docker-for-desktop"
 	DefaultDockerDesktopContext    = "docker-desktop"
+	DefaultMicrok8sContext         = "microk8s"
 	GCSBucketSuffix                = "_cloudbuild"
 
 	HelmOverridesFilename = "skaffold-overrides.yaml"
@@ -41,6 +42,9 @@ const (
 
 	DefaultBusyboxImage = "busybox"
 
+	// DefaultKoBaseImage is the default base image of the artifacts built with ko.
+	DefaultKoBaseImage = "gcr.io/distroless/static:nonroot"
+
 	// DefaultDebugHelpersRegistry is the default location used for the helper images for `debug`.
 	DefaultDebugHelpersRegistry = "gcr.io/gcp-dev-tools/duct-tape"
 
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Deployer deploys services to fully managed Cloud Run with the gcloud CLI.
+type Deployer struct {
+	*latest.CloudRunDeploy
+}
+
+func NewDeployer(cfg types.Config) *Deployer {
+	return &Deployer{
+		CloudRunDeploy: cfg.Pipeline().Deploy.CloudRunDeploy,
+	}
+}
+
+// Deploy deploys a new revision of each service with the built image,
+// splits the traffic if configured and prints the URL of the service.
+// Cloud Run services don't live in a Kubernetes namespace so no namespace is returned.
+func (d *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
+	for _, s := range d.Services {
+		image := imageFor(s.Image, builds)
+
+		if err := d.gcloud(ctx, out, append([]string{"run", "deploy", s.Name, "--image", image}, s.Flags...)...); err != nil {
+			return nil, fmt.Errorf("deploying Cloud Run service %q: %w", s.Name, err)
+		}
+
+		if len(s.Traffic) > 0 {
+			if err := d.gcloud(ctx, out, "run", "services", "update-traffic", s.Name, "--to-revisions", trafficSplit(s.Traffic)); err != nil {
+				return nil, fmt.Errorf("updating traffic of Cloud Run service %q: %w", s.Name, err)
+			}
+		}
+
+		url, err := d.serviceURL(ctx, s.Name)
+		if err != nil {
+			event.DeployInfoEvent(fmt.Errorf("could not get the URL of Cloud Run service %q: %w", s.Name, err))
+			continue
+		}
+
+		color.Default.Fprintf(out, "Cloud Run service %s is available at %s\n", s.Name, url)
+		event.CloudRunServiceAvailable(s.Name, url)
+	}
+
+	return nil, nil
+}
+
+// Dependencies returns nothing since Cloud Run services are
+// entirely described in skaffold.yaml.
+func (d *Deployer) Dependencies() ([]string, error) {
+	return nil, nil
+}
+
+// Cleanup deletes the Cloud Run services.
+func (d *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
+	for _, s := range d.Services {
+		if err := d.gcloud(ctx, out, "run", "services", "delete", s.Name); err != nil {
+			return fmt.Errorf("deleting Cloud Run service %q: %w", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// Render does nothing since Cloud Run services are not deployed from manifests.
+func (d *Deployer) Render(context.Context, io.Writer, []build.Artifact, bool, string) error {
+	logrus.Debugln("Cloud Run services are not rendered")
+	return nil
+}
+
+func (d *Deployer) gcloud(ctx context.Context, out io.Writer, args ...string) error {
+	cmd := exec.CommandContext(ctx, "gcloud", append(args, d.globalFlags()...)...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	return util.RunCmd(cmd)
+}
+
+func (d *Deployer) serviceURL(ctx context.Context, name string) (string, error) {
+	args := append([]string{"run", "services", "describe", name, "--format", "value(status.url)"}, d.globalFlags()...)
+	out, err := util.RunCmdOut(exec.CommandContext(ctx, "gcloud", args...))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func (d *Deployer) globalFlags() []string {
+	flags := []string{"--platform", "managed", "--region", d.Region, "--quiet"}
+	if d.ProjectID != "" {
+		flags = append(flags, "--project", d.ProjectID)
+	}
+	return flags
+}
+
+// imageFor returns the built image for the given image name.
+// Images that were not built are deployed as is.
+func imageFor(image string, builds []build.Artifact) string {
+	for _, b := range builds {
+		if b.ImageName == image {
+			return b.Tag
+		}
+	}
+	return image
+}
+
+func trafficSplit(targets []latest.CloudRunTrafficTarget) string {
+	var split []string
+	for _, t := range targets {
+		split = append(split, fmt.Sprintf("%s=%d", t.RevisionName, t.Percent))
+	}
+	return strings.Join(split, ",")
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCloudRunDeploy(t *testing.T) {
+	tests := []struct {
+		description    string
+		cloudrun       latest.CloudRunDeploy
+		builds         []build.Artifact
+		commands       util.Command
+		expectedOutput string
+		shouldErr      bool
+	}{
+		{
+			description: "deploy built image",
+			cloudrun: latest.CloudRunDeploy{
+				Region:   "us-central1",
+				Services: []latest.CloudRunService{{Name: "svc", Image: "app", Flags: []string{"--allow-unauthenticated"}}},
+			},
+			builds: []build.Artifact{{ImageName: "app", Tag: "gcr.io/project/app:tag"}},
+			commands: testutil.
+				CmdRun("gcloud run deploy svc --image gcr.io/project/app:tag --allow-unauthenticated --platform managed --region us-central1 --quiet").
+				AndRunOut("gcloud run services describe svc --format value(status.url) --platform managed --region us-central1 --quiet", "https://svc.a.run.app\n"),
+			expectedOutput: "Cloud Run service svc is available at https://svc.a.run.app\n",
+		},
+		{
+			description: "deploy image that was not built with project and traffic split",
+			cloudrun: latest.CloudRunDeploy{
+				ProjectID: "project",
+				Region:    "europe-west1",
+				Services: []latest.CloudRunService{{
+					Name:  "svc",
+					Image: "gcr.io/project/other",
+					Traffic: []latest.CloudRunTrafficTarget{
+						{RevisionName: "LATEST", Percent: 10},
+						{RevisionName: "svc-00001", Percent: 90},
+					},
+				}},
+			},
+			commands: testutil.
+				CmdRun("gcloud run deploy svc --image gcr.io/project/other --platform managed --region europe-west1 --quiet --project project").
+				AndRun("gcloud run services update-traffic svc --to-revisions LATEST=10,svc-00001=90 --platform managed --region europe-west1 --quiet --project project").
+				AndRunOut("gcloud run services describe svc --format value(status.url) --platform managed --region europe-west1 --quiet --project project", "https://svc.a.run.app"),
+			expectedOutput: "Cloud Run service svc is available at https://svc.a.run.app\n",
+		},
+		{
+			description: "deploy failure",
+			cloudrun: latest.CloudRunDeploy{
+				Region:   "us-central1",
+				Services: []latest.CloudRunService{{Name: "svc", Image: "app"}},
+			},
+			commands:  testutil.CmdRunErr("gcloud run deploy svc --image app --platform managed --region us-central1 --quiet", errors.New("BUG")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			var out bytes.Buffer
+			namespaces, err := NewDeployer(&cloudRunConfig{cloudrun: test.cloudrun}).Deploy(context.Background(), &out, test.builds)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckEmpty(namespaces)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestCloudRunCleanup(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("gcloud run services delete svc1 --platform managed --region us-central1 --quiet").
+			AndRun("gcloud run services delete svc2 --platform managed --region us-central1 --quiet"))
+
+		err := NewDeployer(&cloudRunConfig{cloudrun: latest.CloudRunDeploy{
+			Region:   "us-central1",
+			Services: []latest.CloudRunService{{Name: "svc1"}, {Name: "svc2"}},
+		}}).Cleanup(context.Background(), ioutil.Discard)
+
+		t.CheckNoError(err)
+	})
+}
+
+type cloudRunConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	cloudrun              latest.CloudRunDeploy
+}
+
+func (c *cloudRunConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Deploy.DeployType.CloudRunDeploy = &c.cloudrun
+	return pipeline
+}
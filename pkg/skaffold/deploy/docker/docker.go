@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	deploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// Deployer runs the built images as local Docker containers.
+type Deployer struct {
+	*latest.DockerDeploy
+
+	cfg    deploy.Config
+	labels map[string]string
+}
+
+func NewDeployer(cfg deploy.Config, labels map[string]string) *Deployer {
+	return &Deployer{
+		DockerDeploy: cfg.Pipeline().Deploy.DockerDeploy,
+		cfg:          cfg,
+		labels:       labels,
+	}
+}
+
+// Deploy replaces the containers with new ones running the built images.
+// Docker containers don't live in a Kubernetes namespace so no namespace is returned.
+func (d *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
+	localDocker, err := docker.NewAPIClient(d.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("getting docker client: %w", err)
+	}
+	apiClient := localDocker.RawClient()
+
+	for _, c := range d.Containers {
+		if err := removeContainer(ctx, apiClient, c.Name); err != nil {
+			return nil, err
+		}
+
+		image := imageFor(c.Image, builds)
+		if !localDocker.ImageExists(ctx, image) {
+			if err := localDocker.Pull(ctx, out, image); err != nil {
+				return nil, fmt.Errorf("pulling image for container %q: %w", c.Name, err)
+			}
+		}
+
+		exposedPorts, portBindings, err := nat.ParsePortSpecs(c.Ports)
+		if err != nil {
+			return nil, fmt.Errorf("parsing ports of container %q: %w", c.Name, err)
+		}
+
+		created, err := apiClient.ContainerCreate(ctx, &container.Config{
+			Image:        image,
+			Env:          c.Env,
+			ExposedPorts: exposedPorts,
+			Labels:       d.labels,
+		}, &container.HostConfig{
+			PortBindings: portBindings,
+		}, nil, c.Name)
+		if err != nil {
+			return nil, fmt.Errorf("creating container %q: %w", c.Name, err)
+		}
+
+		if err := apiClient.ContainerStart(ctx, created.ID, types.ContainerStartOptions{}); err != nil {
+			return nil, fmt.Errorf("starting container %q: %w", c.Name, err)
+		}
+
+		color.Default.Fprintf(out, "Started container %s\n", c.Name)
+	}
+
+	return nil, nil
+}
+
+// Dependencies returns nothing since the containers are
+// entirely described in skaffold.yaml.
+func (d *Deployer) Dependencies() ([]string, error) {
+	return nil, nil
+}
+
+// Cleanup removes the containers.
+func (d *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
+	localDocker, err := docker.NewAPIClient(d.cfg)
+	if err != nil {
+		return fmt.Errorf("getting docker client: %w", err)
+	}
+
+	for _, c := range d.Containers {
+		if err := removeContainer(ctx, localDocker.RawClient(), c.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render does nothing since Docker containers are not deployed from manifests.
+func (d *Deployer) Render(context.Context, io.Writer, []build.Artifact, bool, string) error {
+	logrus.Debugln("Docker containers are not rendered")
+	return nil
+}
+
+func removeContainer(ctx context.Context, apiClient client.CommonAPIClient, name string) error {
+	err := apiClient.ContainerRemove(ctx, name, types.ContainerRemoveOptions{Force: true})
+	if err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("removing container %q: %w", name, err)
+	}
+	return nil
+}
+
+// imageFor returns the built image for the given image name.
+// Images that were not built are run as is.
+func imageFor(image string, builds []build.Artifact) string {
+	for _, b := range builds {
+		if b.ImageName == image {
+			return b.Tag
+		}
+	}
+	return image
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeContainerClient struct {
+	testutil.FakeAPIClient
+
+	errCreate bool
+	removed   []string
+	created   []*container.Config
+	bindings  []nat.PortMap
+	started   []string
+}
+
+func (f *fakeContainerClient) ContainerRemove(_ context.Context, name string, _ types.ContainerRemoveOptions) error {
+	f.removed = append(f.removed, name)
+	return nil
+}
+
+func (f *fakeContainerClient) ContainerCreate(_ context.Context, config *container.Config, hostConfig *container.HostConfig, _ *network.NetworkingConfig, name string) (container.ContainerCreateCreatedBody, error) {
+	if f.errCreate {
+		return container.ContainerCreateCreatedBody{}, errors.New("unable to create")
+	}
+	f.created = append(f.created, config)
+	f.bindings = append(f.bindings, hostConfig.PortBindings)
+	return container.ContainerCreateCreatedBody{ID: "id-" + name}, nil
+}
+
+func (f *fakeContainerClient) ContainerStart(_ context.Context, id string, _ types.ContainerStartOptions) error {
+	f.started = append(f.started, id)
+	return nil
+}
+
+func TestDockerDeploy(t *testing.T) {
+	tests := []struct {
+		description string
+		containers  []latest.DockerContainer
+		errCreate   bool
+		expected    []*container.Config
+		bindings    []nat.PortMap
+		shouldErr   bool
+	}{
+		{
+			description: "run built image",
+			containers:  []latest.DockerContainer{{Name: "web", Image: "web", Ports: []string{"8080:80"}, Env: []string{"KEY=VALUE"}}},
+			expected: []*container.Config{{
+				Image:        "web:tag",
+				Env:          []string{"KEY=VALUE"},
+				ExposedPorts: nat.PortSet{"80/tcp": struct{}{}},
+				Labels:       map[string]string{"run-id": "123"},
+			}},
+			bindings: []nat.PortMap{{"80/tcp": []nat.PortBinding{{HostPort: "8080"}}}},
+		},
+		{
+			description: "invalid port",
+			containers:  []latest.DockerContainer{{Name: "web", Image: "web", Ports: []string{"invalid:port"}}},
+			shouldErr:   true,
+		},
+		{
+			description: "create error",
+			containers:  []latest.DockerContainer{{Name: "web", Image: "web"}},
+			errCreate:   true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fakeClient := &fakeContainerClient{errCreate: test.errCreate}
+			fakeClient.Add("web:tag", "imageID")
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return docker.NewLocalDaemon(fakeClient, nil, false, nil), nil
+			})
+
+			deployer := NewDeployer(&dockerConfig{docker: latest.DockerDeploy{Containers: test.containers}}, map[string]string{"run-id": "123"})
+			var out bytes.Buffer
+			namespaces, err := deployer.Deploy(context.Background(), &out, []build.Artifact{{ImageName: "web", Tag: "web:tag"}})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckEmpty(namespaces)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual([]string{"web"}, fakeClient.removed)
+			t.CheckDeepEqual(test.expected, fakeClient.created)
+			t.CheckDeepEqual(test.bindings, fakeClient.bindings)
+			t.CheckDeepEqual([]string{"id-web"}, fakeClient.started)
+			t.CheckDeepEqual("Started container web\n", out.String())
+		})
+	}
+}
+
+func TestDockerCleanup(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		fakeClient := &fakeContainerClient{}
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return docker.NewLocalDaemon(fakeClient, nil, false, nil), nil
+		})
+
+		deployer := NewDeployer(&dockerConfig{docker: latest.DockerDeploy{Containers: []latest.DockerContainer{{Name: "web"}, {Name: "db"}}}}, nil)
+		err := deployer.Cleanup(context.Background(), ioutil.Discard)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"web", "db"}, fakeClient.removed)
+	})
+}
+
+type dockerConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	docker                latest.DockerDeploy
+}
+
+func (c *dockerConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Deploy.DeployType.DockerDeploy = &c.docker
+	return pipeline
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+// LogAggregator aggregates the logs of the containers started by the docker deployer.
+type LogAggregator struct {
+	output   io.Writer
+	cfg      docker.Config
+	selector string
+
+	muted      int32
+	sinceTime  time.Time
+	tracked    map[string]bool
+	lock       sync.Mutex
+	outputLock sync.Mutex
+	cancel     context.CancelFunc
+}
+
+// NewLogAggregator creates a new LogAggregator for the containers matching
+// the given label selector.
+func NewLogAggregator(out io.Writer, cfg docker.Config, selector string) *LogAggregator {
+	return &LogAggregator{
+		output:   out,
+		cfg:      cfg,
+		selector: selector,
+		tracked:  map[string]bool{},
+	}
+}
+
+func (a *LogAggregator) SetSince(t time.Time) {
+	a.sinceTime = t
+}
+
+// Start tails the logs of the running containers and of the
+// containers started later by new deployments.
+func (a *LogAggregator) Start(ctx context.Context) error {
+	localDocker, err := docker.NewAPIClient(a.cfg)
+	if err != nil {
+		return fmt.Errorf("getting docker client: %w", err)
+	}
+	apiClient := localDocker.RawClient()
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	a.cancel = cancel
+
+	// Watch for new containers before listing the running ones so that none is missed.
+	messages, errs := apiClient.Events(cancelCtx, types.EventsOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("type", "container"),
+			filters.Arg("event", "start"),
+			filters.Arg("label", a.selector),
+		),
+	})
+
+	containers, err := apiClient.ContainerList(cancelCtx, types.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", a.selector)),
+	})
+	if err != nil {
+		cancel()
+		return fmt.Errorf("listing containers: %w", err)
+	}
+	for _, c := range containers {
+		go a.streamContainerLogs(cancelCtx, apiClient, c.ID, containerName(c.Names))
+	}
+
+	go func() {
+		for {
+			select {
+			case <-cancelCtx.Done():
+				return
+			case err := <-errs:
+				if err != nil && cancelCtx.Err() == nil {
+					logrus.Warnln("watching docker events:", err)
+				}
+				return
+			case msg := <-messages:
+				go a.streamContainerLogs(cancelCtx, apiClient, msg.ID, msg.Actor.Attributes["name"])
+			}
+		}
+	}()
+
+	return nil
+}
+
+// WatchNewNamespaces does nothing since Docker containers don't live in namespaces.
+func (a *LogAggregator) WatchNewNamespaces() error {
+	return nil
+}
+
+// Stop stops the logger.
+func (a *LogAggregator) Stop() {
+	if a.cancel != nil {
+		a.cancel()
+	}
+}
+
+func (a *LogAggregator) streamContainerLogs(ctx context.Context, apiClient client.CommonAPIClient, id, name string) {
+	a.lock.Lock()
+	alreadyTracked := a.tracked[id]
+	a.tracked[id] = true
+	a.lock.Unlock()
+	if alreadyTracked {
+		return
+	}
+
+	options := types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	}
+	if !a.sinceTime.IsZero() {
+		options.Since = strconv.FormatInt(a.sinceTime.Unix(), 10)
+	}
+
+	logs, err := apiClient.ContainerLogs(ctx, id, options)
+	if err != nil {
+		logrus.Warnf("unable to stream logs of container %s: %v", name, err)
+		return
+	}
+	defer logs.Close()
+
+	// The containers don't have a TTY so stdout and stderr are multiplexed.
+	r, w := io.Pipe()
+	go func() {
+		_, err := stdcopy.StdCopy(w, w, logs)
+		w.CloseWithError(err)
+	}()
+
+	prefix := fmt.Sprintf("[%s]", name)
+	if err := a.streamRequest(ctx, prefix, r); err != nil {
+		logrus.Debugf("streaming logs of container %s: %v", name, err)
+	}
+}
+
+func (a *LogAggregator) streamRequest(ctx context.Context, prefix string, rc io.Reader) error {
+	r := bufio.NewReader(rc)
+	for {
+		select {
+		case <-ctx.Done():
+			logrus.Infof("%s interrupted", prefix)
+			return nil
+		default:
+			// Read up to newline
+			line, err := r.ReadString('\n')
+			if err == io.EOF {
+				if line != "" {
+					a.printLogLine(prefix, line+"\n")
+				}
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("reading bytes from log stream: %w", err)
+			}
+
+			a.printLogLine(prefix, line)
+		}
+	}
+}
+
+func (a *LogAggregator) printLogLine(prefix, text string) {
+	if !a.IsMuted() {
+		a.outputLock.Lock()
+
+		color.Default.Fprintf(a.output, "%s ", prefix)
+		fmt.Fprint(a.output, text)
+
+		a.outputLock.Unlock()
+	}
+}
+
+// Mute mutes the logs.
+func (a *LogAggregator) Mute() {
+	atomic.StoreInt32(&a.muted, 1)
+}
+
+// Unmute unmutes the logs.
+func (a *LogAggregator) Unmute() {
+	atomic.StoreInt32(&a.muted, 0)
+}
+
+// IsMuted says if the logs are to be muted.
+func (a *LogAggregator) IsMuted() bool {
+	return atomic.LoadInt32(&a.muted) == 1
+}
+
+// containerName returns the name of a container listed by the Docker API,
+// without the leading slash.
+func containerName(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(names[0], "/")
+}
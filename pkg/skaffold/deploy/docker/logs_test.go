@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/pkg/stdcopy"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeLogsClient struct {
+	testutil.FakeAPIClient
+
+	running  []types.Container
+	logs     map[string]string
+	messages chan events.Message
+	filters  []string
+}
+
+func (f *fakeLogsClient) Events(_ context.Context, options types.EventsOptions) (<-chan events.Message, <-chan error) {
+	f.filters = append(f.filters, options.Filters.Get("label")...)
+	return f.messages, make(chan error)
+}
+
+func (f *fakeLogsClient) ContainerList(_ context.Context, options types.ContainerListOptions) ([]types.Container, error) {
+	f.filters = append(f.filters, options.Filters.Get("label")...)
+	return f.running, nil
+}
+
+func (f *fakeLogsClient) ContainerLogs(_ context.Context, id string, _ types.ContainerLogsOptions) (io.ReadCloser, error) {
+	var buf bytes.Buffer
+	stdcopy.NewStdWriter(&buf, stdcopy.Stdout).Write([]byte(f.logs[id]))
+	return ioutil.NopCloser(&buf), nil
+}
+
+type lockedBuffer struct {
+	sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.Lock()
+	defer b.Unlock()
+	return b.buf.String()
+}
+
+func TestLogAggregator(t *testing.T) {
+	tests := []struct {
+		description string
+		muted       bool
+		expected    []string
+	}{
+		{
+			description: "tail running and new containers",
+			expected:    []string{"[web] serving\n", "[db] ready\n"},
+		},
+		{
+			description: "muted",
+			muted:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fakeClient := &fakeLogsClient{
+				running:  []types.Container{{ID: "id-web", Names: []string{"/web"}}},
+				logs:     map[string]string{"id-web": "serving\n", "id-db": "ready"},
+				messages: make(chan events.Message, 1),
+			}
+			fakeClient.messages <- events.Message{ID: "id-db", Actor: events.Actor{Attributes: map[string]string{"name": "db"}}}
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return docker.NewLocalDaemon(fakeClient, nil, false, nil), nil
+			})
+
+			var out lockedBuffer
+			logger := NewLogAggregator(&out, &runcontext.RunContext{}, "run-id=123")
+			if test.muted {
+				logger.Mute()
+			}
+			err := logger.Start(context.Background())
+			defer logger.Stop()
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual([]string{"run-id=123", "run-id=123"}, fakeClient.filters)
+			for _, line := range test.expected {
+				waitForOutput(t, &out, line)
+			}
+			if test.muted {
+				time.Sleep(100 * time.Millisecond)
+				t.CheckEmpty(out.String())
+			}
+		})
+	}
+}
+
+func waitForOutput(t *testutil.T, out *lockedBuffer, expected string) {
+	timeout := time.After(5 * time.Second)
+	for !bytes.Contains([]byte(out.String()), []byte(expected)) {
+		select {
+		case <-timeout:
+			t.Fatalf("expected output to contain %q, got %q", expected, out.String())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
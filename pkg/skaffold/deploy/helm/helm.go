@@ -48,8 +48,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
@@ -74,6 +76,16 @@ var (
 	osExecutable = os.Executable
 )
 
+// createdNamespaceLabel marks the namespaces created by Helm for a release,
+// that didn't exist before. They can be deleted on cleanup.
+const createdNamespaceLabel = "skaffold.dev/created-namespace"
+
+type Config interface {
+	kubectl.Config
+
+	DeleteNamespaces() bool
+}
+
 // Deployer deploys workflows using the helm CLI
 type Deployer struct {
 	*latest.HelmDeploy
@@ -87,23 +99,28 @@ type Deployer struct {
 
 	labels map[string]string
 
-	forceDeploy bool
-	enableDebug bool
+	forceDeploy      bool
+	enableDebug      bool
+	deleteNamespaces bool
+
+	kubectl *pkgkubectl.CLI
 
 	// bV is the helm binary version
 	bV semver.Version
 }
 
 // NewDeployer returns a configured Deployer
-func NewDeployer(cfg kubectl.Config, labels map[string]string) *Deployer {
+func NewDeployer(cfg Config, labels map[string]string) *Deployer {
 	return &Deployer{
-		HelmDeploy:  cfg.Pipeline().Deploy.HelmDeploy,
-		kubeContext: cfg.GetKubeContext(),
-		kubeConfig:  cfg.GetKubeConfig(),
-		namespace:   cfg.GetKubeNamespace(),
-		forceDeploy: cfg.ForceDeploy(),
-		labels:      labels,
-		enableDebug: cfg.Mode() == config.RunModes.Debug,
+		HelmDeploy:       cfg.Pipeline().Deploy.HelmDeploy,
+		kubeContext:      cfg.GetKubeContext(),
+		kubeConfig:       cfg.GetKubeConfig(),
+		namespace:        cfg.GetKubeNamespace(),
+		forceDeploy:      cfg.ForceDeploy(),
+		labels:           labels,
+		enableDebug:      cfg.Mode() == config.RunModes.Debug,
+		deleteNamespaces: cfg.DeleteNamespaces(),
+		kubectl:          pkgkubectl.NewCLI(cfg, ""),
 	}
 }
 
@@ -122,9 +139,11 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 
 	// Deploy every release
 	for _, r := range h.Releases {
-		results, err := h.deployRelease(ctx, out, r, builds, valuesSet, hv)
+		releaseName, _ := util.ExpandEnvTemplate(r.Name, nil)
+		releaseCtx, endTrace := tracing.StartSpan(ctx, "Apply", map[string]string{"release": releaseName})
+		results, err := h.deployRelease(releaseCtx, out, r, builds, valuesSet, hv)
+		endTrace(err)
 		if err != nil {
-			releaseName, _ := util.ExpandEnvTemplate(r.Name, nil)
 			return nil, fmt.Errorf("deploying %q: %w", releaseName, err)
 		}
 
@@ -173,9 +192,26 @@ func (h *Deployer) Dependencies() ([]string, error) {
 
 	for _, release := range h.Releases {
 		r := release
-		deps = append(deps, r.ValuesFiles...)
+		for _, v := range r.ValuesFiles {
+			// The images are not built yet so only the environment variables are expanded.
+			// Values files named after the images, like `{{.IMAGE_TAG}}.yaml`, are not watched.
+			unresolved, err := util.UnresolvedEnvVars(v, nil)
+			if err != nil {
+				return nil, fmt.Errorf("unable to expand the template of values file %q: %w", v, err)
+			}
+			if len(unresolved) > 0 {
+				logrus.Debugf("Not watching values file %q that depends on %v", v, unresolved)
+				continue
+			}
 
-		if r.Remote {
+			exp, err := expandValuesFile(v, nil)
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, exp)
+		}
+
+		if isRemote(r) {
 			// chart path is only a dependency if it exists on the local filesystem
 			continue
 		}
@@ -259,6 +295,41 @@ func (h *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 		if err := h.exec(ctx, out, false, nil, args...); err != nil {
 			return fmt.Errorf("deleting %q: %w", releaseName, err)
 		}
+
+		if h.deleteNamespaces && createsNamespace(r) && namespace != "" {
+			if err := h.deleteCreatedNamespace(ctx, out, namespace); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func createsNamespace(r latest.HelmRelease) bool {
+	return r.CreateNamespace != nil && *r.CreateNamespace
+}
+
+// namespaceExists tells if a namespace exists.
+func (h *Deployer) namespaceExists(ctx context.Context, namespace string) (bool, error) {
+	out, err := h.kubectl.RunOut(ctx, "get", "namespace", namespace, "--ignore-not-found", "-o", "name")
+	if err != nil {
+		return false, fmt.Errorf("checking namespace %q: %w", namespace, err)
+	}
+	return strings.TrimSpace(string(out)) != "", nil
+}
+
+// labelCreatedNamespace marks a namespace that Helm created for a release.
+func (h *Deployer) labelCreatedNamespace(ctx context.Context, namespace string) error {
+	if err := h.kubectl.Run(ctx, nil, ioutil.Discard, "label", "namespace", namespace, createdNamespaceLabel+"=true"); err != nil {
+		return fmt.Errorf("labeling namespace %q: %w", namespace, err)
+	}
+	return nil
+}
+
+// deleteCreatedNamespace deletes a namespace only if it was created by Helm for a release.
+func (h *Deployer) deleteCreatedNamespace(ctx context.Context, out io.Writer, namespace string) error {
+	if err := h.kubectl.Run(ctx, nil, out, "delete", "namespace", "--selector", createdNamespaceLabel+"=true", "--field-selector", "metadata.name="+namespace, "--ignore-not-found"); err != nil {
+		return fmt.Errorf("deleting namespace %q: %w", namespace, err)
 	}
 	return nil
 }
@@ -282,8 +353,12 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 			args = append(args, "--name", r.Name)
 		}
 
-		for _, vf := range r.ValuesFiles {
-			args = append(args, "--values", vf)
+		if r.Version != "" {
+			args = append(args, "--version", r.Version)
+		}
+
+		if r.Repo != "" {
+			args = append(args, "--repo", r.Repo)
 		}
 
 		params, err := pairParamsToArtifacts(builds, r.ArtifactOverrides)
@@ -364,8 +439,8 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 	opts := installOpts{
 		releaseName: releaseName,
 		upgrade:     true,
-		flags:       h.Flags.Upgrade,
-		force:       h.forceDeploy,
+		flags:       append(append([]string{}, h.Flags.Upgrade...), r.Flags.Upgrade...),
+		force:       h.forceDeploy || r.Force,
 		chartPath:   r.ChartPath,
 		helmVersion: helmVersion,
 	}
@@ -414,19 +489,20 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 		color.Yellow.Fprintf(out, "Helm release %s not installed. Installing...\n", releaseName)
 
 		opts.upgrade = false
-		opts.flags = h.Flags.Install
+		opts.flags = append(append([]string{}, h.Flags.Install...), r.Flags.Install...)
 	} else {
 		if r.UpgradeOnChange != nil && !*r.UpgradeOnChange {
 			logrus.Infof("Release %s already installed...", releaseName)
 			return []types.Artifact{}, nil
-		} else if r.UpgradeOnChange == nil && r.Remote {
+		} else if r.UpgradeOnChange == nil && isRemote(r) {
 			logrus.Infof("Release %s not upgraded as it is remote...", releaseName)
 			return []types.Artifact{}, nil
 		}
 	}
 
 	// Only build local dependencies, but allow a user to skip them.
-	if !r.SkipBuildDependencies && !r.Remote {
+	// The dependencies of a packaged chart are already in the archive.
+	if !r.SkipBuildDependencies && !isRemote(r) && !isPackagedChart(r.ChartPath) {
 		logrus.Infof("Building helm dependencies...")
 
 		if err := h.exec(ctx, out, false, nil, "dep", "build", r.ChartPath); err != nil {
@@ -464,11 +540,27 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 		return nil, fmt.Errorf("release args: %w", err)
 	}
 
+	// Remember if Helm creates the namespace of the release, so that it can be deleted on cleanup.
+	newNamespace := false
+	if !opts.upgrade && createsNamespace(r) && opts.namespace != "" {
+		exists, err := h.namespaceExists(ctx, opts.namespace)
+		if err != nil {
+			return nil, err
+		}
+		newNamespace = !exists
+	}
+
 	err = h.exec(ctx, out, r.UseHelmSecrets, installEnv, args...)
 	if err != nil {
 		return nil, fmt.Errorf("install: %w", err)
 	}
 
+	if newNamespace {
+		if err := h.labelCreatedNamespace(ctx, opts.namespace); err != nil {
+			return nil, err
+		}
+	}
+
 	b, err := h.getRelease(ctx, helmVersion, releaseName, opts.namespace)
 	if err != nil {
 		return nil, fmt.Errorf("get release: %w", err)
@@ -577,13 +669,17 @@ func installArgs(r latest.HelmRelease, builds []build.Artifact, valuesSet map[st
 		args = append(args, "--version", r.Version)
 	}
 
+	if r.Repo != "" {
+		args = append(args, "--repo", r.Repo)
+	}
+
 	args = append(args, o.chartPath)
 
 	if o.namespace != "" {
 		args = append(args, "--namespace", o.namespace)
 	}
 
-	if r.CreateNamespace != nil && *r.CreateNamespace && !o.upgrade {
+	if createsNamespace(r) && !o.upgrade {
 		if o.helmVersion.LT(helm32Version) {
 			return nil, errors.New("the createNamespace option is not available in the current Helm version. Update Helm to version 3.2 or higher")
 		}
@@ -667,12 +763,7 @@ func constructOverrideArgs(r *latest.HelmRelease, builds []build.Artifact, args
 	}
 
 	for _, v := range r.ValuesFiles {
-		exp, err := homedir.Expand(v)
-		if err != nil {
-			return nil, fmt.Errorf("unable to expand %q: %w", v, err)
-		}
-
-		exp, err = util.ExpandEnvTemplate(exp, envMap)
+		exp, err := expandValuesFile(v, envMap)
 		if err != nil {
 			return nil, err
 		}
@@ -682,6 +773,30 @@ func constructOverrideArgs(r *latest.HelmRelease, builds []build.Artifact, args
 	return args, nil
 }
 
+// expandValuesFile expands the home directory and the templated parts of a values file path.
+func expandValuesFile(v string, envMap map[string]string) (string, error) {
+	exp, err := homedir.Expand(v)
+	if err != nil {
+		return "", fmt.Errorf("unable to expand %q: %w", v, err)
+	}
+
+	exp, err = util.ExpandEnvTemplate(exp, envMap)
+	if err != nil {
+		return "", fmt.Errorf("unable to expand the template of values file %q: %w", v, err)
+	}
+	return exp, nil
+}
+
+// isRemote tells if the chart of a release comes from a chart repository.
+func isRemote(r latest.HelmRelease) bool {
+	return r.Remote || r.Repo != ""
+}
+
+// isPackagedChart tells if a chart path points to a chart archive created with `helm package`.
+func isPackagedChart(chartPath string) bool {
+	return strings.HasSuffix(chartPath, ".tgz")
+}
+
 // sortKeys returns the map keys in sorted order
 func sortKeys(m map[string]string) []string {
 	s := make([]string, 0, len(m))
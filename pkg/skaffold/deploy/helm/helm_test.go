@@ -263,6 +263,47 @@ var testDeployRemoteChart = latest.HelmDeploy{
 	}},
 }
 
+var testDeployRepoChart = latest.HelmDeploy{
+	Releases: []latest.HelmRelease{{
+		Name:      "skaffold-helm",
+		ChartPath: "chartmuseum",
+		Repo:      "https://chartmuseum.github.io/charts",
+		Version:   "2.14.0",
+		ArtifactOverrides: map[string]string{
+			"image": "skaffold-helm",
+		},
+	}},
+}
+
+var testDeployPackagedChart = latest.HelmDeploy{
+	Releases: []latest.HelmRelease{{
+		Name:      "skaffold-helm",
+		ChartPath: "charts/test-0.1.0.tgz",
+		ArtifactOverrides: map[string]string{
+			"image": "skaffold-helm",
+		},
+	}},
+}
+
+var testDeployReleaseFlags = latest.HelmDeploy{
+	Flags: latest.HelmDeployFlags{
+		Install: []string{"--timeout=5m"},
+		Upgrade: []string{"--timeout=5m"},
+	},
+	Releases: []latest.HelmRelease{{
+		Name:      "skaffold-helm",
+		ChartPath: "examples/test",
+		ArtifactOverrides: map[string]string{
+			"image": "skaffold-helm",
+		},
+		Force: true,
+		Flags: latest.HelmReleaseFlags{
+			Install: []string{"--atomic"},
+			Upgrade: []string{"--cleanup-on-fail"},
+		},
+	}},
+}
+
 var upgradeOnChangeFalse = false
 var testDeployUpgradeOnChange = latest.HelmDeploy{
 	Releases: []latest.HelmRelease{{
@@ -667,6 +708,33 @@ func TestHelmDeploy(t *testing.T) {
 			builds:    testBuilds,
 			shouldErr: true,
 		},
+		{
+			description: "install chart from a repository",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version31).
+				AndRunErr("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig", fmt.Errorf("not found")).
+				AndRun("helm --kube-context kubecontext install skaffold-helm --version 2.14.0 --repo https://chartmuseum.github.io/charts chartmuseum --set-string image=docker.io:5000/skaffold-helm:3605e7bc17cf46e53f4d81c4cbc24e5b4c495184 --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig"),
+			helm:   testDeployRepoChart,
+			builds: testBuilds,
+		},
+		{
+			description: "chart from a repository is not upgraded",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version31).
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig"),
+			helm: testDeployRepoChart,
+		},
+		{
+			description: "packaged chart dependencies are not built",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version31).
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext upgrade skaffold-helm charts/test-0.1.0.tgz --set-string image=docker.io:5000/skaffold-helm:3605e7bc17cf46e53f4d81c4cbc24e5b4c495184 --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig"),
+			helm:   testDeployPackagedChart,
+			builds: testBuilds,
+		},
 		{
 			description: "get failure should install not upgrade",
 			commands: testutil.
@@ -723,6 +791,28 @@ func TestHelmDeploy(t *testing.T) {
 			force:  true,
 			builds: testBuilds,
 		},
+		{
+			description: "upgrade with the flags and force of the release",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version31).
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext dep build examples/test --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext upgrade skaffold-helm --timeout=5m --cleanup-on-fail --force examples/test --set-string image=docker.io:5000/skaffold-helm:3605e7bc17cf46e53f4d81c4cbc24e5b4c495184 --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig"),
+			helm:   testDeployReleaseFlags,
+			builds: testBuilds,
+		},
+		{
+			description: "install with the flags of the release",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version31).
+				AndRunErr("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig", fmt.Errorf("not found")).
+				AndRun("helm --kube-context kubecontext dep build examples/test --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext install skaffold-helm --timeout=5m --atomic examples/test --set-string image=docker.io:5000/skaffold-helm:3605e7bc17cf46e53f4d81c4cbc24e5b4c495184 --kubeconfig kubeconfig").
+				AndRun("helm --kube-context kubecontext get all skaffold-helm --kubeconfig kubeconfig"),
+			helm:   testDeployReleaseFlags,
+			builds: testBuilds,
+		},
 		{
 			description: "get success should upgrade without force, not install",
 			commands: testutil.
@@ -919,6 +1009,20 @@ func TestHelmDeploy(t *testing.T) {
 				CmdRunWithOutput("helm version --client", version32).
 				AndRunErr("helm --kube-context kubecontext get all --namespace testReleaseNamespace skaffold-helm --kubeconfig kubeconfig", fmt.Errorf("not found")).
 				AndRun("helm --kube-context kubecontext dep build examples/test --kubeconfig kubeconfig").
+				AndRunOut("kubectl --context kubecontext --kubeconfig kubeconfig get namespace testReleaseNamespace --ignore-not-found -o name", "").
+				AndRun("helm --kube-context kubecontext install skaffold-helm examples/test --namespace testReleaseNamespace --create-namespace -f skaffold-overrides.yaml --set-string image=docker.io:5000/skaffold-helm:3605e7bc17cf46e53f4d81c4cbc24e5b4c495184 --set some.key=somevalue --kubeconfig kubeconfig").
+				AndRun("kubectl --context kubecontext --kubeconfig kubeconfig label namespace testReleaseNamespace skaffold.dev/created-namespace=true").
+				AndRun("helm --kube-context kubecontext get all --namespace testReleaseNamespace skaffold-helm --kubeconfig kubeconfig"),
+			helm:   testDeployCreateNamespaceConfig,
+			builds: testBuilds,
+		},
+		{
+			description: "helm3.2 createNamespace with an existing namespace",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version32).
+				AndRunErr("helm --kube-context kubecontext get all --namespace testReleaseNamespace skaffold-helm --kubeconfig kubeconfig", fmt.Errorf("not found")).
+				AndRun("helm --kube-context kubecontext dep build examples/test --kubeconfig kubeconfig").
+				AndRunOut("kubectl --context kubecontext --kubeconfig kubeconfig get namespace testReleaseNamespace --ignore-not-found -o name", "namespace/testReleaseNamespace\n").
 				AndRun("helm --kube-context kubecontext install skaffold-helm examples/test --namespace testReleaseNamespace --create-namespace -f skaffold-overrides.yaml --set-string image=docker.io:5000/skaffold-helm:3605e7bc17cf46e53f4d81c4cbc24e5b4c495184 --set some.key=somevalue --kubeconfig kubeconfig").
 				AndRun("helm --kube-context kubecontext get all --namespace testReleaseNamespace skaffold-helm --kubeconfig kubeconfig"),
 			helm:   testDeployCreateNamespaceConfig,
@@ -969,6 +1073,7 @@ func TestHelmCleanup(t *testing.T) {
 		namespace        string
 		builds           []build.Artifact
 		shouldErr        bool
+		deleteNamespaces bool
 		expectedWarnings []string
 		envs             map[string]string
 	}{
@@ -1022,6 +1127,24 @@ func TestHelmCleanup(t *testing.T) {
 			namespace: kubectl.TestNamespace,
 			builds:    testBuilds,
 		},
+		{
+			description: "helm3.2 cleanup keeps created namespace by default",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version32).
+				AndRun("helm --kube-context kubecontext delete skaffold-helm --namespace testReleaseNamespace --kubeconfig kubeconfig"),
+			helm:   testDeployCreateNamespaceConfig,
+			builds: testBuilds,
+		},
+		{
+			description: "helm3.2 cleanup deletes created namespace",
+			commands: testutil.
+				CmdRunWithOutput("helm version --client", version32).
+				AndRun("helm --kube-context kubecontext delete skaffold-helm --namespace testReleaseNamespace --kubeconfig kubeconfig").
+				AndRun("kubectl --context kubecontext --kubeconfig kubeconfig delete namespace --selector skaffold.dev/created-namespace=true --field-selector metadata.name=testReleaseNamespace --ignore-not-found"),
+			helm:             testDeployCreateNamespaceConfig,
+			deleteNamespaces: true,
+			builds:           testBuilds,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -1031,8 +1154,9 @@ func TestHelmCleanup(t *testing.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
 
 			deployer := NewDeployer(&helmConfig{
-				helm:      test.helm,
-				namespace: test.namespace,
+				helm:             test.helm,
+				namespace:        test.namespace,
+				deleteNamespaces: test.deleteNamespaces,
 			}, nil)
 			err := deployer.Cleanup(context.Background(), ioutil.Discard)
 
@@ -1078,6 +1202,7 @@ func TestHelmDependencies(t *testing.T) {
 		valuesFiles           []string
 		skipBuildDependencies bool
 		remote                bool
+		repo                  string
 		expected              func(folder *testutil.TempDir) []string
 	}{
 		{
@@ -1117,6 +1242,33 @@ func TestHelmDependencies(t *testing.T) {
 				return []string{"/folder/values.yaml", folder.Path("Chart.yaml")}
 			},
 		},
+		{
+			description:           "templated values file is included",
+			skipBuildDependencies: false,
+			files:                 []string{"Chart.yaml"},
+			valuesFiles:           []string{"/folder/values-{{.FOO}}.yaml"},
+			expected: func(folder *testutil.TempDir) []string {
+				return []string{"/folder/values-FOOBAR.yaml", folder.Path("Chart.yaml")}
+			},
+		},
+		{
+			description:           "values file named after an image is skipped",
+			skipBuildDependencies: false,
+			files:                 []string{"Chart.yaml"},
+			valuesFiles:           []string{"/folder/values.yaml", "/folder/values-{{.IMAGE_TAG}}.yaml"},
+			expected: func(folder *testutil.TempDir) []string {
+				return []string{"/folder/values.yaml", folder.Path("Chart.yaml")}
+			},
+		},
+		{
+			description:           "no deps for chart from a repository",
+			skipBuildDependencies: false,
+			files:                 []string{"Chart.yaml"},
+			repo:                  "https://chartmuseum.github.io/charts",
+			expected: func(folder *testutil.TempDir) []string {
+				return nil
+			},
+		},
 		{
 			description:           "no deps for remote chart path",
 			skipBuildDependencies: false,
@@ -1131,6 +1283,7 @@ func TestHelmDependencies(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			tmpDir := t.NewTempDir().
 				Touch(test.files...)
+			t.Override(&util.OSEnviron, func() []string { return []string{"FOO=FOOBAR"} })
 
 			deployer := NewDeployer(&helmConfig{
 				helm: latest.HelmDeploy{
@@ -1143,6 +1296,7 @@ func TestHelmDependencies(t *testing.T) {
 						SetValues:             map[string]string{"some.key": "somevalue"},
 						SkipBuildDependencies: test.skipBuildDependencies,
 						Remote:                test.remote,
+						Repo:                  test.repo,
 					}},
 				}}, nil)
 
@@ -1321,6 +1475,30 @@ func TestHelmRender(t *testing.T) {
 					Tag:       "skaffold-helm:tag1",
 				}},
 		},
+		{
+			description: "render chart from a repository",
+			shouldErr:   false,
+			commands: testutil.CmdRunWithOutput("helm version --client", version31).
+				AndRun("helm --kube-context kubecontext template skaffold-helm chartmuseum --version 2.14.0 --repo https://chartmuseum.github.io/charts --set-string image=skaffold-helm:tag1 --kubeconfig kubeconfig"),
+			helm: testDeployRepoChart,
+			builds: []build.Artifact{
+				{
+					ImageName: "skaffold-helm",
+					Tag:       "skaffold-helm:tag1",
+				}},
+		},
+		{
+			description: "render with templated values file",
+			shouldErr:   false,
+			commands: testutil.CmdRunWithOutput("helm version --client", version31).
+				AndRun("helm --kube-context kubecontext template skaffold-helm examples/test --set-string image=skaffold-helm:tag1 -f /some/file-FOOBAR.yaml --kubeconfig kubeconfig"),
+			helm: testDeployConfigValuesFilesTemplated,
+			builds: []build.Artifact{
+				{
+					ImageName: "skaffold-helm",
+					Tag:       "skaffold-helm:tag1",
+				}},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -1415,10 +1593,12 @@ type helmConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	namespace             string
 	force                 bool
+	deleteNamespaces      bool
 	helm                  latest.HelmDeploy
 }
 
 func (c *helmConfig) ForceDeploy() bool        { return c.force }
+func (c *helmConfig) DeleteNamespaces() bool   { return c.deleteNamespaces }
 func (c *helmConfig) GetKubeConfig() string    { return kubectl.TestKubeConfig }
 func (c *helmConfig) GetKubeContext() string   { return kubectl.TestKubeContext }
 func (c *helmConfig) GetKubeNamespace() string { return c.namespace }
@@ -39,6 +39,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -77,7 +78,9 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return []string{}, err
 	}
 
-	manifests, err := k.renderManifests(ctx, out, builds, flags)
+	renderCtx, endTrace := tracing.StartSpan(ctx, "Render", nil)
+	manifests, err := k.renderManifests(renderCtx, out, builds, flags)
+	endTrace(err)
 	if err != nil {
 		return nil, err
 	}
@@ -105,10 +108,13 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		}
 	}
 
-	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, []string{"live", "apply"}, k.getKptLiveApplyArgs(), nil)...)
+	applyCtx, endTrace := tracing.StartSpan(ctx, "Apply", nil)
+	cmd := exec.CommandContext(applyCtx, "kpt", kptCommandArgs(applyDir, []string{"live", "apply"}, k.getKptLiveApplyArgs(), nil)...)
 	cmd.Stdout = out
 	cmd.Stderr = out
-	if err := util.RunCmd(cmd); err != nil {
+	err = util.RunCmd(cmd)
+	endTrace(err)
+	if err != nil {
 		return nil, err
 	}
 
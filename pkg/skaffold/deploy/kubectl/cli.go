@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	kubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 )
 
 // CLI holds parameters to run kubectl.
@@ -40,6 +42,8 @@ type CLI struct {
 
 	forceDeploy      bool
 	waitForDeletions config.WaitForDeletions
+	strategy         *latest.DeployStrategy
+	rolloutTimeout   time.Duration
 	previousApply    manifest.ManifestList
 }
 
@@ -52,11 +56,18 @@ type Config interface {
 }
 
 func NewCLI(cfg Config, flags latest.KubectlFlags, defaultNameSpace string) CLI {
+	rolloutTimeout := defaultRolloutTimeout
+	if deadline := cfg.Pipeline().Deploy.StatusCheckDeadlineSeconds; deadline > 0 {
+		rolloutTimeout = time.Duration(deadline) * time.Second
+	}
+
 	return CLI{
 		CLI:              kubectl.NewCLI(cfg, defaultNameSpace),
 		Flags:            flags,
 		forceDeploy:      cfg.ForceDeploy(),
 		waitForDeletions: cfg.WaitForDeletions(),
+		strategy:         cfg.Pipeline().Deploy.Strategy,
+		rolloutTimeout:   rolloutTimeout,
 	}
 }
 
@@ -81,6 +92,11 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 		return nil
 	}
 
+	return c.apply(ctx, out, updated)
+}
+
+// apply runs `kubectl apply` without skipping the manifests that were already applied.
+func (c *CLI) apply(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error {
 	args := []string{"-f", "-"}
 	if c.forceDeploy {
 		args = append(args, "--force", "--grace-period=0")
@@ -90,7 +106,10 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 		args = append(args, "--validate=false")
 	}
 
-	if err := c.Run(ctx, updated.Reader(), out, "apply", c.args(c.Flags.Apply, args...)...); err != nil {
+	ctx, endTrace := tracing.StartSpan(ctx, "Apply", map[string]string{"manifests": strconv.Itoa(len(manifests))})
+	err := c.Run(ctx, manifests.Reader(), out, "apply", c.args(c.Flags.Apply, args...)...)
+	endTrace(err)
+	if err != nil {
 		return fmt.Errorf("kubectl apply: %w", err)
 	}
 
@@ -37,6 +37,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -86,11 +87,13 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		manifests manifest.ManifestList
 		err       error
 	)
+	renderCtx, endTrace := tracing.StartSpan(ctx, "Render", nil)
 	if k.skipRender {
-		manifests, err = k.readManifests(ctx, false)
+		manifests, err = k.readManifests(renderCtx, false)
 	} else {
-		manifests, err = k.renderManifests(ctx, out, builds, false)
+		manifests, err = k.renderManifests(renderCtx, out, builds, false)
 	}
+	endTrace(err)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +112,7 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
-	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+	if err := k.kubectl.ApplyWithStrategy(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		return nil, err
 	}
 
@@ -327,7 +330,7 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 		}
 	}
 
-	if err := k.kubectl.Delete(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+	if err := k.kubectl.DeleteWithStrategy(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		return fmt.Errorf("delete: %w", err)
 	}
 
@@ -0,0 +1,458 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+const (
+	colorLabel  = "skaffold.dev/color"
+	trackLabel  = "skaffold.dev/track"
+	blue        = "blue"
+	green       = "green"
+	canaryTrack = "canary"
+
+	// defaultRolloutTimeout matches the default progressDeadlineSeconds of a Deployment.
+	defaultRolloutTimeout = 10 * time.Minute
+)
+
+// manifestsByKind separates the Deployments and the Services from the other manifests.
+type manifestsByKind struct {
+	deployments []map[string]interface{}
+	services    []map[string]interface{}
+	others      manifest.ManifestList
+}
+
+// ApplyWithStrategy runs `kubectl apply` on a list of manifests, rolling out
+// the Deployments with the configured strategy.
+func (c *CLI) ApplyWithStrategy(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error {
+	if c.strategy == nil || (c.strategy.Canary == nil && c.strategy.BlueGreen == nil) {
+		return c.Apply(ctx, out, manifests)
+	}
+
+	// Only roll out again if a manifest was modified
+	if len(c.previousApply.Diff(manifests)) == 0 {
+		return nil
+	}
+
+	byKind, err := splitByKind(manifests)
+	if err != nil {
+		return err
+	}
+
+	if c.strategy.Canary != nil {
+		err = c.canary(ctx, out, byKind)
+	} else {
+		err = c.blueGreen(ctx, out, byKind)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Failed rollouts are retried, even if the manifests don't change.
+	c.previousApply = manifests
+	return nil
+}
+
+// DeleteWithStrategy runs `kubectl delete` on a list of manifests and
+// on the Deployments created by the configured strategy.
+func (c *CLI) DeleteWithStrategy(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error {
+	if c.strategy == nil || (c.strategy.Canary == nil && c.strategy.BlueGreen == nil) {
+		return c.Delete(ctx, out, manifests)
+	}
+
+	byKind, err := splitByKind(manifests)
+	if err != nil {
+		return err
+	}
+
+	list := append(manifest.ManifestList{}, byKind.others...)
+	if err := appendAll(&list, byKind.services); err != nil {
+		return err
+	}
+	for _, d := range byKind.deployments {
+		var variants []map[string]interface{}
+		if c.strategy.Canary != nil {
+			variants = []map[string]interface{}{d, canaryOf(d)}
+		} else {
+			variants = []map[string]interface{}{d, withSuffix(d, blue, colorLabel), withSuffix(d, green, colorLabel)}
+		}
+		if err := appendAll(&list, variants); err != nil {
+			return err
+		}
+	}
+
+	return c.Delete(ctx, out, list)
+}
+
+// canary deploys a few replicas of the new version of each Deployment, waits for them
+// to be healthy, then updates the Deployments and deletes the canaries.
+func (c *CLI) canary(ctx context.Context, out io.Writer, byKind manifestsByKind) error {
+	replicas := 1
+	if c.strategy.Canary.Replicas > 0 {
+		replicas = c.strategy.Canary.Replicas
+	}
+
+	var canaries []map[string]interface{}
+	for _, d := range byKind.deployments {
+		canary := canaryOf(d)
+		nestedMap(canary, "spec")["replicas"] = replicas
+		canaries = append(canaries, canary)
+	}
+
+	list := append(manifest.ManifestList{}, byKind.others...)
+	if err := appendAll(&list, byKind.services); err != nil {
+		return err
+	}
+	if err := appendAll(&list, canaries); err != nil {
+		return err
+	}
+	if err := c.apply(ctx, out, list); err != nil {
+		return err
+	}
+	if err := c.waitForRollouts(ctx, out, canaries); err != nil {
+		return fmt.Errorf("waiting for canaries: %w", err)
+	}
+
+	var deployments manifest.ManifestList
+	if err := appendAll(&deployments, byKind.deployments); err != nil {
+		return err
+	}
+	if err := c.apply(ctx, out, deployments); err != nil {
+		return err
+	}
+	if err := c.waitForRollouts(ctx, out, byKind.deployments); err != nil {
+		return err
+	}
+
+	return c.deleteDeployments(ctx, out, canaries)
+}
+
+// blueGreen updates the Deployments in place, giving their pods a new color, while copies of the
+// running Deployments keep on serving the live color. Once the Deployments are healthy, the selectors
+// of the Services are switched over to the new color and the copies are deleted.
+// The Deployments keep their names and selectors so that the HorizontalPodAutoscalers,
+// PodDisruptionBudgets and Services which reference them are not broken.
+func (c *CLI) blueGreen(ctx context.Context, out io.Writer, byKind manifestsByKind) error {
+	live, err := c.liveColor(ctx, byKind.services)
+	if err != nil {
+		return err
+	}
+	next := blue
+	if live == blue {
+		next = green
+	}
+
+	services := byKind.services
+	if live != "" {
+		// Copies of the new color are left over by a previous rollout that kept them.
+		if err := c.deleteDeployments(ctx, out, withSuffixes(byKind.deployments, next, colorLabel)); err != nil {
+			return err
+		}
+
+		previous, err := c.liveCopies(ctx, byKind.deployments, live)
+		if err != nil {
+			return err
+		}
+		var list manifest.ManifestList
+		if err := appendAll(&list, previous); err != nil {
+			return err
+		}
+		if len(list) > 0 {
+			if err := c.apply(ctx, out, list); err != nil {
+				return err
+			}
+			if err := c.waitForRollouts(ctx, out, previous); err != nil {
+				return err
+			}
+		}
+
+		// Keep on serving the live version until the new one is healthy.
+		services = withSelector(byKind.services, live)
+	}
+
+	deployments := withColor(byKind.deployments, next)
+	list := append(manifest.ManifestList{}, byKind.others...)
+	if err := appendAll(&list, services); err != nil {
+		return err
+	}
+	if err := appendAll(&list, deployments); err != nil {
+		return err
+	}
+	if err := c.apply(ctx, out, list); err != nil {
+		return err
+	}
+	if err := c.waitForRollouts(ctx, out, deployments); err != nil {
+		return err
+	}
+
+	var switched manifest.ManifestList
+	if err := appendAll(&switched, withSelector(byKind.services, next)); err != nil {
+		return err
+	}
+	if err := c.apply(ctx, out, switched); err != nil {
+		return fmt.Errorf("switching services to %s: %w", next, err)
+	}
+	if live == "" {
+		return nil
+	}
+	color.Default.Fprintf(out, "Switched services from %s to %s\n", live, next)
+
+	if c.strategy.BlueGreen.KeepPrevious {
+		return nil
+	}
+	return c.deleteDeployments(ctx, out, withSuffixes(byKind.deployments, live, colorLabel))
+}
+
+// liveCopies returns copies of the running Deployments, named after the live color.
+// A Deployment is skipped if it doesn't run yet or if its pods don't have the live color,
+// which means that its previous rollout failed and that the copy made then still serves the live color.
+func (c *CLI) liveCopies(ctx context.Context, deployments []map[string]interface{}, live string) ([]map[string]interface{}, error) {
+	var copies []map[string]interface{}
+	for _, d := range deployments {
+		cmd := c.CommandWithNamespaceArg(ctx, "get", namespaceOf(d), "deployment", nameOf(d), "--ignore-not-found", "-o", "json")
+		buf, err := util.RunCmdOut(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("getting deployment %q: %w", nameOf(d), err)
+		}
+		if len(bytes.TrimSpace(buf)) == 0 {
+			continue
+		}
+
+		running := make(map[string]interface{})
+		if err := json.Unmarshal(buf, &running); err != nil {
+			return nil, fmt.Errorf("reading deployment %q: %w", nameOf(d), err)
+		}
+		spec := nestedMap(running, "spec")
+		template := nestedMap(spec, "template")
+		if nestedMap(template, "metadata", "labels")[colorLabel] != live {
+			continue
+		}
+
+		metadata := map[string]interface{}{
+			"name":   nameOf(d),
+			"labels": nestedMap(running, "metadata", "labels"),
+		}
+		if namespace := namespaceOf(d); namespace != "" {
+			metadata["namespace"] = namespace
+		}
+		copied := map[string]interface{}{
+			"apiVersion": running["apiVersion"],
+			"kind":       "Deployment",
+			"metadata":   metadata,
+			"spec": map[string]interface{}{
+				"replicas": spec["replicas"],
+				"selector": nestedMap(spec, "selector"),
+				"template": template,
+			},
+		}
+		copies = append(copies, withSuffix(copied, live, colorLabel))
+	}
+	return copies, nil
+}
+
+// liveColor returns the color selected by the first Service that was already switched by a blue/green rollout.
+func (c *CLI) liveColor(ctx context.Context, services []map[string]interface{}) (string, error) {
+	for _, s := range services {
+		cmd := c.CommandWithNamespaceArg(ctx, "get", namespaceOf(s), "service", nameOf(s), "--ignore-not-found", "-o", `jsonpath={.spec.selector.skaffold\.dev/color}`)
+		live, err := util.RunCmdOut(cmd)
+		if err != nil {
+			return "", fmt.Errorf("getting the color of service %q: %w", nameOf(s), err)
+		}
+		if selected := strings.TrimSpace(string(live)); selected != "" {
+			return selected, nil
+		}
+	}
+	return "", nil
+}
+
+func (c *CLI) waitForRollouts(ctx context.Context, out io.Writer, deployments []map[string]interface{}) error {
+	for _, d := range deployments {
+		if err := c.RunInNamespace(ctx, nil, out, "rollout", namespaceOf(d), "status", "deployment", nameOf(d), "--timeout", c.rolloutTimeout.String()); err != nil {
+			return fmt.Errorf("waiting for the rollout of deployment %q: %w", nameOf(d), err)
+		}
+	}
+	return nil
+}
+
+// deleteDeployments deletes Deployments and waits for their deletion to complete.
+func (c *CLI) deleteDeployments(ctx context.Context, out io.Writer, deployments []map[string]interface{}) error {
+	var list manifest.ManifestList
+	if err := appendAll(&list, deployments); err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return nil
+	}
+
+	if err := c.Delete(ctx, out, list); err != nil {
+		return err
+	}
+	return c.WaitForDeletions(ctx, out, list)
+}
+
+func splitByKind(manifests manifest.ManifestList) (manifestsByKind, error) {
+	var byKind manifestsByKind
+	for _, m := range manifests {
+		obj := make(map[string]interface{})
+		if err := yaml.Unmarshal(m, &obj); err != nil {
+			return manifestsByKind{}, fmt.Errorf("reading Kubernetes YAML: %w", err)
+		}
+
+		apiVersion, _ := obj["apiVersion"].(string)
+		kind, _ := obj["kind"].(string)
+		switch {
+		case kind == "Deployment" && (strings.HasPrefix(apiVersion, "apps/") || strings.HasPrefix(apiVersion, "extensions/")):
+			byKind.deployments = append(byKind.deployments, obj)
+		case kind == "Service" && apiVersion == "v1":
+			byKind.services = append(byKind.services, obj)
+		default:
+			byKind.others = append(byKind.others, m)
+		}
+	}
+	return byKind, nil
+}
+
+// withSuffix returns a copy of a Deployment with a suffixed name and
+// with the suffix added as a label on the Deployment, its selector and its pods.
+func withSuffix(d map[string]interface{}, suffix string, label string) map[string]interface{} {
+	copied := deepCopy(d).(map[string]interface{})
+
+	metadata := nestedMap(copied, "metadata")
+	metadata["name"] = fmt.Sprintf("%s-%s", metadata["name"], suffix)
+	nestedMap(metadata, "labels")[label] = suffix
+	nestedMap(copied, "spec", "selector", "matchLabels")[label] = suffix
+	nestedMap(copied, "spec", "template", "metadata", "labels")[label] = suffix
+
+	return copied
+}
+
+// canaryOf returns the canary copy of a Deployment. Its selector only matches a track label of its own,
+// so that it doesn't overlap the selector of the Deployment. Its pods keep the labels of the application
+// so that the Services send them a share of the traffic.
+func canaryOf(d map[string]interface{}) map[string]interface{} {
+	copied := deepCopy(d).(map[string]interface{})
+	track := fmt.Sprintf("%s-%s", nameOf(d), canaryTrack)
+
+	metadata := nestedMap(copied, "metadata")
+	metadata["name"] = track
+	nestedMap(metadata, "labels")[trackLabel] = track
+	nestedMap(copied, "spec")["selector"] = map[string]interface{}{
+		"matchLabels": map[string]interface{}{trackLabel: track},
+	}
+	nestedMap(copied, "spec", "template", "metadata", "labels")[trackLabel] = track
+
+	return copied
+}
+
+// withSuffixes returns suffixed copies of the Deployments.
+func withSuffixes(deployments []map[string]interface{}, suffix string, label string) []map[string]interface{} {
+	var copies []map[string]interface{}
+	for _, d := range deployments {
+		copies = append(copies, withSuffix(d, suffix, label))
+	}
+	return copies
+}
+
+// withColor returns copies of the Deployments with the color added as a label on the Deployments and their pods.
+// Their selectors are left untouched since they can't be changed once the Deployments exist.
+func withColor(deployments []map[string]interface{}, color string) []map[string]interface{} {
+	var colored []map[string]interface{}
+	for _, d := range deployments {
+		copied := deepCopy(d).(map[string]interface{})
+		nestedMap(copied, "metadata", "labels")[colorLabel] = color
+		nestedMap(copied, "spec", "template", "metadata", "labels")[colorLabel] = color
+		colored = append(colored, copied)
+	}
+	return colored
+}
+
+// withSelector returns copies of the Services that select the pods of the given color.
+func withSelector(services []map[string]interface{}, selected string) []map[string]interface{} {
+	var updated []map[string]interface{}
+	for _, s := range services {
+		copied := deepCopy(s).(map[string]interface{})
+		nestedMap(copied, "spec", "selector")[colorLabel] = selected
+		updated = append(updated, copied)
+	}
+	return updated
+}
+
+func appendAll(list *manifest.ManifestList, objs []map[string]interface{}) error {
+	for _, obj := range objs {
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("marshalling yaml: %w", err)
+		}
+		*list = append(*list, b)
+	}
+	return nil
+}
+
+// nestedMap returns the map at the given path, creating the missing ones.
+func nestedMap(obj map[string]interface{}, fields ...string) map[string]interface{} {
+	for _, f := range fields {
+		child, ok := obj[f].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			obj[f] = child
+		}
+		obj = child
+	}
+	return obj
+}
+
+func nameOf(obj map[string]interface{}) string {
+	name, _ := nestedMap(obj, "metadata")["name"].(string)
+	return name
+}
+
+func namespaceOf(obj map[string]interface{}) string {
+	namespace, _ := nestedMap(obj, "metadata")["namespace"].(string)
+	return namespace
+}
+
+func deepCopy(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			copied[k] = deepCopy(value)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, value := range v {
+			copied[i] = deepCopy(value)
+		}
+		return copied
+	default:
+		return v
+	}
+}
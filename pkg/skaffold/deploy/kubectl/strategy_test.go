@@ -0,0 +1,371 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	strategyDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web`
+
+	strategyService = `apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app: web`
+
+	colorGet   = "kubectl --context kubecontext get service web --ignore-not-found -o jsonpath={.spec.selector.skaffold\\.dev/color}"
+	runningGet = "kubectl --context kubecontext get deployment web --ignore-not-found -o json"
+	apply      = "kubectl --context kubecontext apply -f -"
+	remove     = "kubectl --context kubecontext delete --ignore-not-found=true -f -"
+)
+
+// coloredDeployment is the Deployment updated with pods of the given color.
+func coloredDeployment(color string) string {
+	return `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/color: ` + color + `
+  name: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/color: ` + color
+}
+
+// suffixedDeployment is the copy of the Deployment serving the given color.
+func suffixedDeployment(color string) string {
+	return `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/color: ` + color + `
+  name: web-` + color + `
+spec:
+  selector:
+    matchLabels:
+      app: web
+      skaffold.dev/color: ` + color + `
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/color: ` + color
+}
+
+// runningDeployment is the Deployment, as returned by the cluster, with pods of the given color.
+func runningDeployment(color string) string {
+	return `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","uid":"1234","labels":{"skaffold.dev/color":"` + color + `"}},` +
+		`"spec":{"replicas":3,"selector":{"matchLabels":{"app":"web"}},"template":{"metadata":{"labels":{"app":"web","skaffold.dev/color":"` + color + `"}}}},"status":{}}`
+}
+
+// liveCopy is the copy of the running Deployment that serves the live color during a rollout.
+func liveCopy(color string) string {
+	return `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/color: ` + color + `
+  name: web-` + color + `
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: web
+      skaffold.dev/color: ` + color + `
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/color: ` + color
+}
+
+func coloredService(color string) string {
+	return strategyService + `
+    skaffold.dev/color: ` + color
+}
+
+func TestApplyWithStrategy(t *testing.T) {
+	tests := []struct {
+		description      string
+		strategy         latest.DeployStrategy
+		waitForDeletions config.WaitForDeletions
+		commands         util.Command
+		expectedOutput   string
+		shouldErr        bool
+	}{
+		{
+			description: "first blue/green rollout",
+			strategy:    latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			commands: testutil.
+				CmdRunOut(colorGet, "").
+				AndRunInput(apply, strategyService+"\n---\n"+coloredDeployment("blue")).
+				AndRun("kubectl --context kubecontext rollout status deployment web --timeout 10m0s").
+				AndRunInput(apply, coloredService("blue")),
+		},
+		{
+			description: "switch from blue to green",
+			strategy:    latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			commands: testutil.
+				CmdRunOut(colorGet, "blue").
+				AndRunInput(remove, suffixedDeployment("green")).
+				AndRunOut(runningGet, runningDeployment("blue")).
+				AndRunInput(apply, liveCopy("blue")).
+				AndRun("kubectl --context kubecontext rollout status deployment web-blue --timeout 10m0s").
+				AndRunInput(apply, coloredService("blue")+"\n---\n"+coloredDeployment("green")).
+				AndRun("kubectl --context kubecontext rollout status deployment web --timeout 10m0s").
+				AndRunInput(apply, coloredService("green")).
+				AndRunInput(remove, suffixedDeployment("blue")),
+			expectedOutput: "Switched services from blue to green\n",
+		},
+		{
+			description: "switch from green to blue and keep previous",
+			strategy:    latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{KeepPrevious: true}},
+			commands: testutil.
+				CmdRunOut(colorGet, "green").
+				AndRunInput(remove, suffixedDeployment("blue")).
+				AndRunOut(runningGet, runningDeployment("green")).
+				AndRunInput(apply, liveCopy("green")).
+				AndRun("kubectl --context kubecontext rollout status deployment web-green --timeout 10m0s").
+				AndRunInput(apply, coloredService("green")+"\n---\n"+coloredDeployment("blue")).
+				AndRun("kubectl --context kubecontext rollout status deployment web --timeout 10m0s").
+				AndRunInput(apply, coloredService("blue")),
+			expectedOutput: "Switched services from green to blue\n",
+		},
+		{
+			description: "retry after a failed rollout",
+			strategy:    latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			commands: testutil.
+				CmdRunOut(colorGet, "blue").
+				AndRunInput(remove, suffixedDeployment("green")).
+				AndRunOut(runningGet, runningDeployment("green")).
+				AndRunInput(apply, coloredService("blue")+"\n---\n"+coloredDeployment("green")).
+				AndRun("kubectl --context kubecontext rollout status deployment web --timeout 10m0s").
+				AndRunInput(apply, coloredService("green")).
+				AndRunInput(remove, suffixedDeployment("blue")),
+			expectedOutput: "Switched services from blue to green\n",
+		},
+		{
+			description: "wait for the deletion of the previous deployments",
+			strategy:    latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			waitForDeletions: config.WaitForDeletions{
+				Enabled: true,
+				Max:     10 * time.Second,
+				Delay:   0,
+			},
+			commands: testutil.
+				CmdRunOut(colorGet, "blue").
+				AndRunInput(remove, suffixedDeployment("green")).
+				AndRunInputOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", suffixedDeployment("green"), "").
+				AndRunOut(runningGet, "").
+				AndRunInput(apply, coloredService("blue")+"\n---\n"+coloredDeployment("green")).
+				AndRun("kubectl --context kubecontext rollout status deployment web --timeout 10m0s").
+				AndRunInput(apply, coloredService("green")).
+				AndRunInput(remove, suffixedDeployment("blue")).
+				AndRunInputOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", suffixedDeployment("blue"), ""),
+			expectedOutput: "Switched services from blue to green\n",
+		},
+		{
+			description: "blue/green rollout failure",
+			strategy:    latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			commands: testutil.
+				CmdRunOut(colorGet, "blue").
+				AndRunInput(remove, suffixedDeployment("green")).
+				AndRunOut(runningGet, runningDeployment("blue")).
+				AndRunInput(apply, liveCopy("blue")).
+				AndRun("kubectl --context kubecontext rollout status deployment web-blue --timeout 10m0s").
+				AndRunInput(apply, coloredService("blue")+"\n---\n"+coloredDeployment("green")).
+				AndRunErr("kubectl --context kubecontext rollout status deployment web --timeout 10m0s", errors.New("BUG")),
+			shouldErr: true,
+		},
+		{
+			description: "canary",
+			strategy:    latest.DeployStrategy{Canary: &latest.CanaryStrategy{Replicas: 2}},
+			commands: testutil.
+				CmdRunInput("kubectl --context kubecontext apply -f -", strategyService+"\n---\n"+`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/track: web-canary
+  name: web-canary
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      skaffold.dev/track: web-canary
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/track: web-canary`).
+				AndRun("kubectl --context kubecontext rollout status deployment web-canary --timeout 10m0s").
+				AndRunInput("kubectl --context kubecontext apply -f -", strategyDeployment).
+				AndRun("kubectl --context kubecontext rollout status deployment web --timeout 10m0s").
+				AndRunInput(remove, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/track: web-canary
+  name: web-canary
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      skaffold.dev/track: web-canary
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/track: web-canary`),
+		},
+		{
+			description: "canary failure",
+			strategy:    latest.DeployStrategy{Canary: &latest.CanaryStrategy{}},
+			commands: testutil.
+				CmdRun("kubectl --context kubecontext apply -f -").
+				AndRunErr("kubectl --context kubecontext rollout status deployment web-canary --timeout 10m0s", errors.New("BUG")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			cli := NewCLI(&strategyConfig{strategy: test.strategy, waitForDeletions: test.waitForDeletions}, latest.KubectlFlags{}, "")
+			var out bytes.Buffer
+			err := cli.ApplyWithStrategy(context.Background(), &out, manifest.ManifestList{[]byte(strategyDeployment), []byte(strategyService)})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestApplyWithStrategyRetriesFailedRollouts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun(apply).
+			AndRunErr("kubectl --context kubecontext rollout status deployment web-canary --timeout 10m0s", errors.New("BUG")).
+			AndRun(apply).
+			AndRun("kubectl --context kubecontext rollout status deployment web-canary --timeout 10m0s").
+			AndRun(apply).
+			AndRun("kubectl --context kubecontext rollout status deployment web --timeout 10m0s").
+			AndRun(remove))
+
+		cli := NewCLI(&strategyConfig{strategy: latest.DeployStrategy{Canary: &latest.CanaryStrategy{}}}, latest.KubectlFlags{}, "")
+		manifests := manifest.ManifestList{[]byte(strategyDeployment), []byte(strategyService)}
+
+		err := cli.ApplyWithStrategy(context.Background(), ioutil.Discard, manifests)
+		t.CheckError(true, err)
+
+		err = cli.ApplyWithStrategy(context.Background(), ioutil.Discard, manifests)
+		t.CheckNoError(err)
+
+		// Nothing is rolled out once the manifests are deployed.
+		err = cli.ApplyWithStrategy(context.Background(), ioutil.Discard, manifests)
+		t.CheckNoError(err)
+	})
+}
+
+func TestDeleteWithStrategy(t *testing.T) {
+	tests := []struct {
+		description string
+		strategy    latest.DeployStrategy
+		expected    string
+	}{
+		{
+			description: "blue/green",
+			strategy:    latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			expected:    strategyService + "\n---\n" + strategyDeployment + "\n---\n" + suffixedDeployment("blue") + "\n---\n" + suffixedDeployment("green"),
+		},
+		{
+			description: "canary",
+			strategy:    latest.DeployStrategy{Canary: &latest.CanaryStrategy{}},
+			expected: strategyService + "\n---\n" + strategyDeployment + "\n---\n" + `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/track: web-canary
+  name: web-canary
+spec:
+  selector:
+    matchLabels:
+      skaffold.dev/track: web-canary
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/track: web-canary`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunInput("kubectl --context kubecontext delete --ignore-not-found=true -f -", test.expected))
+
+			cli := NewCLI(&strategyConfig{strategy: test.strategy}, latest.KubectlFlags{}, "")
+			err := cli.DeleteWithStrategy(context.Background(), ioutil.Discard, manifest.ManifestList{[]byte(strategyDeployment), []byte(strategyService)})
+
+			t.CheckNoError(err)
+		})
+	}
+}
+
+type strategyConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	strategy              latest.DeployStrategy
+	waitForDeletions      config.WaitForDeletions
+}
+
+func (c *strategyConfig) GetKubeContext() string                    { return "kubecontext" }
+func (c *strategyConfig) WaitForDeletions() config.WaitForDeletions { return c.waitForDeletions }
+func (c *strategyConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Deploy.Strategy = &c.strategy
+	return pipeline
+}
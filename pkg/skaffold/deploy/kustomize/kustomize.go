@@ -35,6 +35,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
@@ -143,7 +144,9 @@ func kubectlVersionCheck(kubectl kubectl.CLI) bool {
 
 // Deploy runs `kubectl apply` on the manifest generated by kustomize.
 func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
-	manifests, err := k.renderManifests(ctx, out, builds)
+	renderCtx, endTrace := tracing.StartSpan(ctx, "Render", nil)
+	manifests, err := k.renderManifests(renderCtx, out, builds)
+	endTrace(err)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +165,7 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
-	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+	if err := k.kubectl.ApplyWithStrategy(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		return nil, err
 	}
 
@@ -208,7 +211,7 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 		return fmt.Errorf("reading manifests: %w", err)
 	}
 
-	if err := k.kubectl.Delete(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+	if err := k.kubectl.DeleteWithStrategy(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		return fmt.Errorf("delete: %w", err)
 	}
 
@@ -18,6 +18,7 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -33,7 +34,12 @@ import (
 
 const (
 	deploymentType          = "deployment"
+	statefulSetType         = "statefulset"
+	daemonSetType           = "daemonset"
+	jobType                 = "job"
 	rollOutSuccess          = "successfully rolled out"
+	jobComplete             = "Complete"
+	conditionFailed         = "Failed"
 	connectionErrMsg        = "Unable to connect to the server"
 	killedErrMsg            = "signal: killed"
 	defaultPodCheckDeadline = 30 * time.Second
@@ -46,6 +52,9 @@ var (
 	msgKubectlKilled     = "kubectl rollout status command interrupted\n"
 	MsgKubectlConnection = "kubectl connection error\n"
 
+	// statefulset rollouts don't report success with rollOutSuccess.
+	rollOutCompleteMessages = []string{rollOutSuccess, "rolling update complete", "roll out complete"}
+
 	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
 		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:       {},
 		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    {},
@@ -55,15 +64,18 @@ var (
 )
 
 type Deployment struct {
-	name         string
-	namespace    string
-	rType        string
-	status       Status
-	statusCode   proto.StatusCode
-	done         bool
-	deadline     time.Duration
-	pods         map[string]validator.Resource
-	podValidator diag.Diagnose
+	name       string
+	namespace  string
+	rType      string
+	status     Status
+	statusCode proto.StatusCode
+	done       bool
+	deadline   time.Duration
+	// readyCondition is the status condition type that is `True` once the resource is ready.
+	// Resources without a ready condition are checked with `kubectl rollout status`.
+	readyCondition string
+	pods           map[string]validator.Resource
+	podValidator   diag.Diagnose
 }
 
 func (d *Deployment) Deadline() time.Duration {
@@ -95,6 +107,35 @@ func NewDeployment(name string, ns string, deadline time.Duration) *Deployment {
 	}
 }
 
+// NewStatefulSet returns a resource checking the rollout of a statefulset.
+func NewStatefulSet(name string, ns string, deadline time.Duration) *Deployment {
+	d := NewDeployment(name, ns, deadline)
+	d.rType = statefulSetType
+	return d
+}
+
+// NewDaemonSet returns a resource checking the rollout of a daemonset.
+func NewDaemonSet(name string, ns string, deadline time.Duration) *Deployment {
+	d := NewDeployment(name, ns, deadline)
+	d.rType = daemonSetType
+	return d
+}
+
+// NewJob returns a resource waiting for a job to complete.
+func NewJob(name string, ns string, deadline time.Duration) *Deployment {
+	return NewCustomResource(jobType, name, ns, jobComplete, deadline)
+}
+
+// NewCustomResource returns a resource of any kind, checked with its status conditions.
+// The resource is ready once the given condition is `True` and fails
+// as soon as a `Failed` condition is `True`.
+func NewCustomResource(kind string, name string, ns string, condition string, deadline time.Duration) *Deployment {
+	d := NewDeployment(name, ns, deadline)
+	d.rType = strings.ToLower(kind)
+	d.readyCondition = condition
+	return d
+}
+
 func (d *Deployment) WithValidator(pd diag.Diagnose) *Deployment {
 	d.podValidator = pd
 	return d
@@ -103,14 +144,23 @@ func (d *Deployment) WithValidator(pd diag.Diagnose) *Deployment {
 func (d *Deployment) CheckStatus(ctx context.Context, cfg kubectl.Config) {
 	kubeCtl := kubectl.NewCLI(cfg, "")
 
-	b, err := kubeCtl.RunOut(ctx, "rollout", "status", "deployment", d.name, "--namespace", d.namespace, "--watch=false")
+	var b []byte
+	var err error
+	if d.readyCondition != "" {
+		b, err = kubeCtl.RunOut(ctx, "get", d.rType, d.name, "--namespace", d.namespace, "-o", "json")
+	} else {
+		b, err = kubeCtl.RunOut(ctx, "rollout", "status", d.rType, d.name, "--namespace", d.namespace, "--watch=false")
+	}
 	if ctx.Err() != nil {
 		return
 	}
 
-	details := d.cleanupStatus(string(b))
-
-	ae := parseKubectlRolloutError(details, err)
+	var ae proto.ActionableErr
+	if d.readyCondition != "" {
+		ae = parseConditions(b, d.readyCondition, err)
+	} else {
+		ae = parseKubectlRolloutError(d.cleanupStatus(string(b)), err)
+	}
 	if ae.ErrCode == proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED {
 		ae.Message = fmt.Sprintf("received Ctrl-C or deployments could not stabilize within %v: %v", d.deadline, err)
 	}
@@ -197,6 +247,7 @@ func (d *Deployment) ReportSinceLastUpdated(isMuted bool) string {
 
 func (d *Deployment) cleanupStatus(msg string) string {
 	clean := strings.ReplaceAll(msg, `deployment "`+d.Name()+`" `, "")
+	clean = strings.ReplaceAll(clean, `daemon set "`+d.Name()+`" `, "")
 	if len(clean) > 0 {
 		clean = strings.ToLower(clean[0:1]) + clean[1:]
 	}
@@ -213,7 +264,7 @@ func (d *Deployment) cleanupStatus(msg string) string {
 // Killed: 9
 func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
 	switch {
-	case err == nil && strings.Contains(details, rollOutSuccess):
+	case err == nil && isRolloutComplete(details):
 		return proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
 			Message: details,
@@ -241,6 +292,69 @@ func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
 	}
 }
 
+func isRolloutComplete(details string) bool {
+	for _, msg := range rollOutCompleteMessages {
+		if strings.Contains(details, msg) {
+			return true
+		}
+	}
+	return false
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// parseConditions parses the status conditions out of the json output of
+// $kubectl get certificate my-cert -o json
+func parseConditions(b []byte, readyCondition string, err error) proto.ActionableErr {
+	if err != nil {
+		return parseKubectlRolloutError("", err)
+	}
+
+	var resource struct {
+		Status struct {
+			Conditions []condition `json:"conditions"`
+		} `json:"status"`
+	}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing status conditions: %s", err),
+		}
+	}
+
+	pending := fmt.Sprintf("waiting for condition %s\n", readyCondition)
+	for _, c := range resource.Status.Conditions {
+		if c.Status != "True" && c.Type == readyCondition && c.Message != "" {
+			pending = fmt.Sprintf("waiting for condition %s: %s\n", readyCondition, c.Message)
+		}
+		if c.Status != "True" {
+			continue
+		}
+		switch c.Type {
+		case readyCondition:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: fmt.Sprintf("condition %s is true\n", readyCondition),
+			}
+		case conditionFailed:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNHEALTHY,
+				Message: fmt.Sprintf("%s: %s\n", c.Reason, c.Message),
+			}
+		}
+	}
+
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+		Message: pending,
+	}
+}
+
 func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
 	return statusCode != proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR &&
 		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING
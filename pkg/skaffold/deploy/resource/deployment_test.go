@@ -120,6 +120,145 @@ func TestDeploymentCheckStatus(t *testing.T) {
 	}
 }
 
+func TestRolloutCheckStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		resource        *Deployment
+		commands        util.Command
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "statefulset rolled out",
+			resource:    NewStatefulSet("sts", "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset sts --namespace test --watch=false",
+				"statefulset rolling update complete 2 pods at revision sts-7b9c...",
+			),
+			expectedDetails: "statefulset rolling update complete 2 pods at revision sts-7b9c...",
+			complete:        true,
+		},
+		{
+			description: "daemonset rolled out",
+			resource:    NewDaemonSet("ds", "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status daemonset ds --namespace test --watch=false",
+				"daemon set \"ds\" successfully rolled out",
+			),
+			expectedDetails: "successfully rolled out",
+			complete:        true,
+		},
+		{
+			description: "daemonset pending",
+			resource:    NewDaemonSet("ds", "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status daemonset ds --namespace test --watch=false",
+				"Waiting for daemon set \"ds\" rollout to finish: 0 of 1 updated pods are available...",
+			),
+			expectedDetails: "waiting for rollout to finish: 0 of 1 updated pods are available...",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			test.resource.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.complete, test.resource.IsStatusCheckCompleteOrCancelled())
+			t.CheckDeepEqual(test.expectedDetails, test.resource.status.ae.Message)
+		})
+	}
+}
+
+func TestConditionsCheckStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		resource        *Deployment
+		commands        util.Command
+		expectedCode    proto.StatusCode
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "job complete",
+			resource:    NewJob("job", "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job job --namespace test -o json",
+				`{"status":{"conditions":[{"type":"Complete","status":"True"}]}}`,
+			),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "condition Complete is true\n",
+			complete:        true,
+		},
+		{
+			description: "job failed",
+			resource:    NewJob("job", "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job job --namespace test -o json",
+				`{"status":{"conditions":[{"type":"Failed","status":"True","reason":"BackoffLimitExceeded","message":"Job has reached the specified backoff limit"}]}}`,
+			),
+			expectedCode:    proto.StatusCode_STATUSCHECK_UNHEALTHY,
+			expectedDetails: "BackoffLimitExceeded: Job has reached the specified backoff limit\n",
+			complete:        true,
+		},
+		{
+			description: "job running",
+			resource:    NewJob("job", "test", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job job --namespace test -o json",
+				`{"status":{"active":1}}`,
+			),
+			expectedCode:    proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedDetails: "waiting for condition Complete\n",
+		},
+		{
+			description: "custom resource not ready",
+			resource:    NewCustomResource("Certificate", "cert", "test", "Ready", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get certificate cert --namespace test -o json",
+				`{"status":{"conditions":[{"type":"Ready","status":"False","message":"Issuing certificate"}]}}`,
+			),
+			expectedCode:    proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedDetails: "waiting for condition Ready: Issuing certificate\n",
+		},
+		{
+			description: "custom resource ready",
+			resource:    NewCustomResource("Certificate", "cert", "test", "Ready", 0),
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get certificate cert --namespace test -o json",
+				`{"status":{"conditions":[{"type":"Ready","status":"True"}]}}`,
+			),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "condition Ready is true\n",
+			complete:        true,
+		},
+		{
+			description: "kubectl connection error",
+			resource:    NewCustomResource("Certificate", "cert", "test", "Ready", 0),
+			commands: testutil.CmdRunOutErr(
+				"kubectl --context kubecontext get certificate cert --namespace test -o json",
+				"",
+				errors.New("Unable to connect to the server"),
+			),
+			expectedCode:    proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+			expectedDetails: MsgKubectlConnection,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			test.resource.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.complete, test.resource.IsStatusCheckCompleteOrCancelled())
+			t.CheckDeepEqual(test.expectedCode, test.resource.status.ae.ErrCode)
+			t.CheckDeepEqual(test.expectedDetails, test.resource.status.ae.Message)
+		})
+	}
+}
+
 func TestParseKubectlError(t *testing.T) {
 	tests := []struct {
 		description string
@@ -55,6 +55,7 @@ var (
 const (
 	tabHeader             = " -"
 	kubernetesMaxDeadline = 600
+	defaultReadyCondition = "Ready"
 )
 
 type counter struct {
@@ -103,19 +104,25 @@ func (s statusChecker) Check(ctx context.Context, out io.Writer) error {
 }
 
 func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.StatusCode, error) {
-	client, err := kubernetesclient.Client()
+	client, err := kubernetesclient.ClientForContext(s.cfg.GetKubeContext())
 	if err != nil {
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
+	deadline := getDeadline(s.cfg.Pipeline().Deploy.StatusCheckDeadlineSeconds)
 	deployments := make([]*resource.Deployment, 0)
 	for _, n := range s.cfg.GetNamespaces() {
-		newDeployments, err := getDeployments(client, n, s.labeller,
-			getDeadline(s.cfg.Pipeline().Deploy.StatusCheckDeadlineSeconds))
+		newDeployments, err := getDeployments(client, n, s.labeller, deadline)
 		if err != nil {
 			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
 		}
 		deployments = append(deployments, newDeployments...)
+
+		newResources, err := getResources(ctx, s.cfg, client, n, s.labeller, deadline)
+		if err != nil {
+			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch resources: %w", err)
+		}
+		deployments = append(deployments, newResources...)
 	}
 
 	var wg sync.WaitGroup
@@ -167,17 +174,79 @@ func getDeployments(client kubernetes.Interface, ns string, l *label.DefaultLabe
 		} else {
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).
+			WithValidator(podValidator(client, d.Namespace, l, d.Spec.Template.Labels))
+	}
+	return deployments, nil
+}
+
+// getResources lists the statefulsets, daemonsets, jobs and configured custom resources
+// deployed in the given namespace.
+func getResources(ctx context.Context, cfg Config, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration) ([]*resource.Deployment, error) {
+	listOptions := metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	}
+	var resources []*resource.Deployment
+
+	statefulSets, err := client.AppsV1().StatefulSets(ns).List(listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch statefulsets: %w", err)
+	}
+	for _, s := range statefulSets.Items {
+		resources = append(resources, resource.NewStatefulSet(s.Name, s.Namespace, deadline).
+			WithValidator(podValidator(client, s.Namespace, l, s.Spec.Template.Labels)))
+	}
 
-		for k, v := range d.Spec.Template.Labels {
-			pd = pd.WithLabel(k, v)
+	daemonSets, err := client.AppsV1().DaemonSets(ns).List(listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch daemonsets: %w", err)
+	}
+	for _, d := range daemonSets.Items {
+		resources = append(resources, resource.NewDaemonSet(d.Name, d.Namespace, deadline).
+			WithValidator(podValidator(client, d.Namespace, l, d.Spec.Template.Labels)))
+	}
+
+	jobs, err := client.BatchV1().Jobs(ns).List(listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch jobs: %w", err)
+	}
+	for _, j := range jobs.Items {
+		jobDeadline := deadline
+		if j.Spec.ActiveDeadlineSeconds != nil {
+			jobDeadline = time.Duration(*j.Spec.ActiveDeadlineSeconds) * time.Second
+		}
+		resources = append(resources, resource.NewJob(j.Name, j.Namespace, jobDeadline).
+			WithValidator(podValidator(client, j.Namespace, l, j.Spec.Template.Labels)))
+	}
+
+	kubeCtl := pkgkubectl.NewCLI(cfg, "")
+	for _, r := range cfg.Pipeline().Deploy.StatusCheckResources {
+		out, err := kubeCtl.RunOut(ctx, "get", r.Kind, "--namespace", ns, "-l", l.RunIDSelector(), "-o", "jsonpath={.items[*].metadata.name}")
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch %s: %w", r.Kind, err)
 		}
 
-		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+		condition := r.Condition
+		if condition == "" {
+			condition = defaultReadyCondition
+		}
+		for _, name := range strings.Fields(string(out)) {
+			resources = append(resources, resource.NewCustomResource(r.Kind, name, ns, condition, deadline))
+		}
 	}
-	return deployments, nil
+
+	return resources, nil
+}
+
+func podValidator(client kubernetes.Interface, ns string, l *label.DefaultLabeller, templateLabels map[string]string) diag.Diagnose {
+	pd := diag.New([]string{ns}).
+		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+
+	for k, v := range templateLabels {
+		pd = pd.WithLabel(k, v)
+	}
+	return pd
 }
 
 func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Deployment) {
@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
@@ -228,6 +229,72 @@ func TestGetDeployments(t *testing.T) {
 	}
 }
 
+func TestGetResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil)
+	runIDLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	tests := []struct {
+		description string
+		objs        []runtime.Object
+		resources   []latest.StatusCheckResource
+		commands    util.Command
+		expected    []*resource.Deployment
+	}{
+		{
+			description: "statefulsets, daemonsets and jobs",
+			objs: []runtime.Object{
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "test", Labels: runIDLabels}},
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "ds", Namespace: "test", Labels: runIDLabels}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "test", Labels: runIDLabels}},
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "job-with-deadline", Namespace: "test", Labels: runIDLabels},
+					Spec:       batchv1.JobSpec{ActiveDeadlineSeconds: utilpointer.Int64Ptr(30)},
+				},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "other-run", Namespace: "test", Labels: map[string]string{label.RunIDLabel: "9876-6789"}}},
+			},
+			expected: []*resource.Deployment{
+				resource.NewStatefulSet("sts", "test", 200*time.Second),
+				resource.NewDaemonSet("ds", "test", 200*time.Second),
+				resource.NewJob("job", "test", 200*time.Second),
+				resource.NewJob("job-with-deadline", "test", 30*time.Second),
+			},
+		},
+		{
+			description: "custom resources",
+			resources: []latest.StatusCheckResource{
+				{Kind: "certificates.cert-manager.io"},
+				{Kind: "Database", Condition: "Available"},
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext get certificates.cert-manager.io --namespace test -l "+labeller.RunIDSelector()+" -o jsonpath={.items[*].metadata.name}", "cert1 cert2").
+				AndRunOut("kubectl --context kubecontext get Database --namespace test -l "+labeller.RunIDSelector()+" -o jsonpath={.items[*].metadata.name}", "db"),
+			expected: []*resource.Deployment{
+				resource.NewCustomResource("certificates.cert-manager.io", "cert1", "test", "Ready", 200*time.Second),
+				resource.NewCustomResource("certificates.cert-manager.io", "cert2", "test", "Ready", 200*time.Second),
+				resource.NewCustomResource("Database", "db", "test", "Available", 200*time.Second),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			client := fakekubeclientset.NewSimpleClientset(test.objs...)
+			cfg := &statusConfig{
+				RunContext: runcontext.RunContext{
+					Cfg: latest.Pipeline{
+						Deploy: latest.DeployConfig{StatusCheckResources: test.resources},
+					},
+				},
+			}
+
+			actual, err := getResources(context.Background(), cfg, client, "test", labeller, 200*time.Second)
+			t.CheckErrorAndDeepEqual(false, err, test.expected, actual,
+				cmp.AllowUnexported(resource.Deployment{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
 func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description  string
@@ -30,7 +30,16 @@ func ApplyDefaultRepo(globalConfig string, defaultRepo *string, tag string) (str
 		return "", fmt.Errorf("getting default repo: %w", err)
 	}
 
-	newTag, err := docker.SubstituteDefaultRepoIntoImage(repo, tag)
+	if repo == "" {
+		return tag, nil
+	}
+
+	strategy, err := config.GetDefaultRepoStrategy(globalConfig)
+	if err != nil {
+		return "", fmt.Errorf("getting default repo strategy: %w", err)
+	}
+
+	newTag, err := docker.SubstituteDefaultRepoIntoImage(repo, strategy, tag)
 	if err != nil {
 		return "", fmt.Errorf("applying default repo to %q: %w", tag, err)
 	}
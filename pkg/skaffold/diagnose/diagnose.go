@@ -24,7 +24,10 @@ import (
 	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -35,12 +38,32 @@ type Config interface {
 	docker.Config
 
 	Pipeline() latest.Pipeline
+	GlobalConfig() string
+	DefaultRepo() *string
+	DetectMinikube() bool
 }
 
-func CheckArtifacts(ctx context.Context, cfg Config, out io.Writer) error {
+// TaggerFor returns the tagger of an artifact.
+type TaggerFor func(*latest.Artifact) (tag.Tagger, error)
+
+// CheckCluster prints the current Kubernetes context and whether it's considered a local cluster.
+func CheckCluster(cfg Config, out io.Writer) {
+	fmt.Fprintln(out, "Kubernetes context:", cfg.GetKubeContext())
+
+	localCluster, err := config.GetLocalCluster(cfg.GlobalConfig(), cfg.MinikubeProfile(), cfg.DetectMinikube())
+	if err != nil {
+		fmt.Fprintf(out, "Local cluster: unknown (%v)\n", err)
+		return
+	}
+	fmt.Fprintln(out, "Local cluster:", localCluster)
+}
+
+func CheckArtifacts(ctx context.Context, cfg Config, taggerFor TaggerFor, out io.Writer) error {
 	for _, artifact := range cfg.Pipeline().Build.Artifacts {
 		color.Default.Fprintf(out, "\n%s: %s\n", typeOfArtifact(artifact), artifact.ImageName)
 
+		fmt.Fprintln(out, " - Tag:", imageTag(cfg, taggerFor, artifact))
+
 		if artifact.DockerArtifact != nil {
 			size, err := sizeOfDockerContext(ctx, artifact, cfg)
 			if err != nil {
@@ -60,6 +83,12 @@ func CheckArtifacts(ctx context.Context, cfg Config, out io.Writer) error {
 		}
 
 		fmt.Fprintln(out, " - Dependencies:", len(deps), "files")
+
+		watched, err := build.FilterIgnored(artifact, deps)
+		if err != nil {
+			return fmt.Errorf("listing watched files: %w", err)
+		}
+		fmt.Fprintln(out, " - Watched files:", len(watched), "files")
 		fmt.Fprintf(out, " - Time to list dependencies: %v (2nd time: %v)\n", timeDeps1, timeDeps2)
 
 		timeSyncMap1, err := timeToConstructSyncMap(artifact, cfg)
@@ -92,6 +121,62 @@ func CheckArtifacts(ctx context.Context, cfg Config, out io.Writer) error {
 	return nil
 }
 
+// CheckTagPolicies validates the tag policies of the pipeline and its artifacts.
+func CheckTagPolicies(cfg Config) error {
+	buildCfg := cfg.Pipeline().Build
+	if err := checkTagPolicy(buildCfg.TagPolicy); err != nil {
+		return err
+	}
+
+	for _, a := range buildCfg.Artifacts {
+		if a.TagPolicy == nil {
+			continue
+		}
+		if err := checkTagPolicy(*a.TagPolicy); err != nil {
+			return fmt.Errorf("artifact %q: %w", a.ImageName, err)
+		}
+	}
+
+	return nil
+}
+
+func checkTagPolicy(t latest.TagPolicy) error {
+	if t.DateTimeTagger != nil {
+		if err := tag.ValidateDateTime(t.DateTimeTagger.Format, t.DateTimeTagger.TimeZone); err != nil {
+			return fmt.Errorf("invalid dateTime tagger: %w", err)
+		}
+	}
+
+	if t.CustomTemplateTagger != nil {
+		for _, c := range t.CustomTemplateTagger.Components {
+			if err := checkTagPolicy(c.Component); err != nil {
+				return fmt.Errorf("component %q: %w", c.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// imageTag returns the tag that an artifact would be built with, or the error that prevents it.
+func imageTag(cfg Config, taggerFor TaggerFor, a *latest.Artifact) string {
+	tagger, err := taggerFor(a)
+	if err != nil {
+		return fmt.Sprintf("error (%v)", err)
+	}
+
+	t, err := tag.GenerateFullyQualifiedImageName(tagger, a.Workspace, a.ImageName)
+	if err != nil {
+		return fmt.Sprintf("error (%v)", err)
+	}
+
+	t, err = deployutil.ApplyDefaultRepo(cfg.GlobalConfig(), cfg.DefaultRepo(), t)
+	if err != nil {
+		return fmt.Sprintf("error (%v)", err)
+	}
+	return t
+}
+
 func typeOfArtifact(a *latest.Artifact) string {
 	switch {
 	case a.DockerArtifact != nil:
@@ -106,6 +191,8 @@ func typeOfArtifact(a *latest.Artifact) string {
 		return "Custom artifact"
 	case a.BuildpackArtifact != nil:
 		return "Buildpack artifact"
+	case a.KoArtifact != nil:
+		return "Ko artifact"
 	default:
 		panic("Unknown artifact")
 	}
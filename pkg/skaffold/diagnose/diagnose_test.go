@@ -18,9 +18,11 @@ package diagnose
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
@@ -90,12 +92,46 @@ func TestCheckArtifacts(t *testing.T) {
 					},
 				},
 			}},
-		}, ioutil.Discard)
+		}, func(*latest.Artifact) (tag.Tagger, error) { return &tag.ChecksumTagger{}, nil }, ioutil.Discard)
 
 		t.CheckNoError(err)
 	})
 }
 
+func TestImageTag(t *testing.T) {
+	tests := []struct {
+		description string
+		defaultRepo string
+		taggerFor   TaggerFor
+		expected    string
+	}{
+		{
+			description: "generated tag",
+			taggerFor:   func(*latest.Artifact) (tag.Tagger, error) { return &tag.CustomTag{Tag: "v1"}, nil },
+			expected:    "image:v1",
+		},
+		{
+			description: "with default repo",
+			defaultRepo: "gcr.io/project",
+			taggerFor:   func(*latest.Artifact) (tag.Tagger, error) { return &tag.CustomTag{Tag: "v1"}, nil },
+			expected:    "gcr.io/project/image:v1",
+		},
+		{
+			description: "tagger error",
+			taggerFor:   func(*latest.Artifact) (tag.Tagger, error) { return nil, errors.New("BUG") },
+			expected:    "error (BUG)",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cfg := &mockConfig{}
+			cfg.Opts.DefaultRepo.Set(test.defaultRepo)
+
+			t.CheckDeepEqual(test.expected, imageTag(cfg, test.taggerFor, &latest.Artifact{ImageName: "image"}))
+		})
+	}
+}
+
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	artifacts             []*latest.Artifact
@@ -106,3 +142,56 @@ func (c *mockConfig) Pipeline() latest.Pipeline {
 	pipeline.Build.Artifacts = c.artifacts
 	return pipeline
 }
+
+func TestCheckTagPolicies(t *testing.T) {
+	tests := []struct {
+		description string
+		build       latest.BuildConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no dateTime tagger",
+			build:       latest.BuildConfig{TagPolicy: latest.TagPolicy{GitTagger: &latest.GitTagger{}}},
+		},
+		{
+			description: "valid dateTime tagger",
+			build:       latest.BuildConfig{TagPolicy: latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{Format: "20060102", TimeZone: "UTC"}}},
+		},
+		{
+			description: "invalid format",
+			build:       latest.BuildConfig{TagPolicy: latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{Format: "2006/01/02"}}},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid timezone",
+			build:       latest.BuildConfig{TagPolicy: latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{TimeZone: "foo"}}},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid customTemplate component",
+			build: latest.BuildConfig{TagPolicy: latest.TagPolicy{CustomTemplateTagger: &latest.CustomTemplateTagger{
+				Template:   "{{.DATE}}",
+				Components: []latest.TaggerComponent{{Name: "DATE", Component: latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{Format: "latest"}}}},
+			}}},
+			shouldErr: true,
+		},
+		{
+			description: "invalid artifact tag policy",
+			build: latest.BuildConfig{Artifacts: []*latest.Artifact{{
+				ImageName: "image",
+				TagPolicy: &latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{Format: "15:04"}},
+			}}},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{}
+			runCtx.Cfg.Build = test.build
+
+			err := CheckTagPolicies(runCtx)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
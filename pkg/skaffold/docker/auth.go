@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 
 	"github.com/docker/cli/cli/config"
@@ -32,6 +33,7 @@ import (
 	"github.com/docker/docker/registry"
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/aws"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/gcp"
 )
 
@@ -84,9 +86,59 @@ func (h credsHelper) GetAuthConfig(registry string) (types.AuthConfig, error) {
 		return types.AuthConfig{}, err
 	}
 
+	// Without configured credentials, ECR registries are authenticated with the `aws` CLI.
+	if auth.Username == "" && auth.IdentityToken == "" && aws.IsECRRegistry(registry) {
+		if path, _ := exec.LookPath("aws"); path != "" {
+			ecrAuth, err := aws.ECRAuthConfig(registry)
+			if err == nil {
+				return ecrAuth, nil
+			}
+			logrus.Warnf("unable to get ECR credentials: %v", err)
+		}
+	}
+
+	// Without configured credentials, GCR and Artifact Registry use the application default credentials.
+	if auth.Username == "" && auth.IdentityToken == "" && gcp.IsGoogleRegistry(registry) {
+		adcAuth, err := applicationDefaultAuthConfig(registry)
+		if err == nil {
+			return adcAuth, nil
+		}
+		logrus.Debugf("no application default credentials for %s: %v", registry, err)
+	}
+
 	return types.AuthConfig(auth), nil
 }
 
+func applicationDefaultAuthConfig(registry string) (types.AuthConfig, error) {
+	auth, err := newGoogleEnvAuthenticator()
+	if err != nil {
+		return types.AuthConfig{}, err
+	}
+
+	authorization, err := auth.Authorization()
+	if err != nil {
+		return types.AuthConfig{}, err
+	}
+
+	switch {
+	case authorization.RegistryToken != "":
+		// The Docker daemon expects access tokens as the password of this special user.
+		return types.AuthConfig{
+			Username:      "oauth2accesstoken",
+			Password:      authorization.RegistryToken,
+			ServerAddress: registry,
+		}, nil
+	case authorization.Username != "" && authorization.Password != "":
+		return types.AuthConfig{
+			Username:      authorization.Username,
+			Password:      authorization.Password,
+			ServerAddress: registry,
+		}, nil
+	default:
+		return types.AuthConfig{}, fmt.Errorf("no application default credentials for %s", registry)
+	}
+}
+
 // GetAllAuthConfigs retrieves all the auth configs.
 // Because this can take a long time, we make sure it can be interrupted by the user.
 func (h credsHelper) GetAllAuthConfigs(ctx context.Context) (map[string]types.AuthConfig, error) {
@@ -18,11 +18,13 @@ package docker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"testing"
 
 	"github.com/docker/docker/api/types"
+	"github.com/google/go-containerregistry/pkg/authn"
 
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -91,6 +93,63 @@ echo "{\"Username\":\"<token>\",\"Secret\":\"TOKEN_$server\"}"`)
 	})
 }
 
+func TestGetAuthConfigWithApplicationDefaultCredentials(t *testing.T) {
+	tests := []struct {
+		description   string
+		authenticator authn.Authenticator
+		authErr       error
+		expected      types.AuthConfig
+	}{
+		{
+			description:   "access token",
+			authenticator: &authn.Bearer{Token: "TOKEN"},
+			expected:      types.AuthConfig{Username: "oauth2accesstoken", Password: "TOKEN", ServerAddress: "us-docker.pkg.dev"},
+		},
+		{
+			description:   "username and password",
+			authenticator: &authn.Basic{Username: "user", Password: "pass"},
+			expected:      types.AuthConfig{Username: "user", Password: "pass", ServerAddress: "us-docker.pkg.dev"},
+		},
+		{
+			description:   "no credential",
+			authenticator: authn.Anonymous,
+			expected:      types.AuthConfig{},
+		},
+		{
+			description: "no application default credentials",
+			authErr:     errors.New("no application default credentials"),
+			expected:    types.AuthConfig{},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("config.json", "{}")
+			t.Override(&configDir, tmpDir.Root())
+			t.SetEnvs(map[string]string{"PATH": tmpDir.Root()})
+			t.Override(&newGoogleEnvAuthenticator, func() (authn.Authenticator, error) {
+				return test.authenticator, test.authErr
+			})
+
+			auth, err := DefaultAuthHelper.GetAuthConfig("us-docker.pkg.dev")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, auth)
+		})
+	}
+}
+
+func TestApplicationDefaultAuthConfigWithoutCredential(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&newGoogleEnvAuthenticator, func() (authn.Authenticator, error) {
+			return authn.Anonymous, nil
+		})
+
+		_, err := applicationDefaultAuthConfig("us-docker.pkg.dev")
+
+		t.CheckErrorContains("no application default credentials", err)
+	})
+}
+
 func TestGetEncodedRegistryAuth(t *testing.T) {
 	tests := []struct {
 		description string
@@ -17,18 +17,22 @@ limitations under the License.
 package docker
 
 import (
-	"strings"
 	"sync"
 
 	"github.com/docker/cli/cli/config"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/v1/google"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/gcp"
 )
 
 var primaryKeychain = &Keychain{
 	configDir: configDir,
 }
 
+// For testing
+var newGoogleEnvAuthenticator = google.NewEnvAuthenticator
+
 // Keychain stores an authenticator per registry.
 type Keychain struct {
 	configDir  string
@@ -78,7 +82,7 @@ func (a *lockedAuthenticator) Authorization() (*authn.AuthConfig, error) {
 // Create a new authenticator for a given reference
 // 1. If `gcloud` is configured, we use google.NewGcloudAuthenticator(). It is more efficient because it reuses tokens.
 // 2. If something else is configured, we use that authenticator
-// 3. If nothing is configured, we check if `gcloud` can be used
+// 3. If nothing is configured, we check if the application default credentials or `gcloud` can be used
 // 4. Default to anonymous
 func (a *Keychain) newAuthenticator(res authn.Resource) authn.Authenticator {
 	registry := res.RegistryStr()
@@ -97,8 +101,11 @@ func (a *Keychain) newAuthenticator(res authn.Resource) authn.Authenticator {
 		return auth
 	}
 
-	// 3. Try gcloud for *.gcr.io
-	if registry == "gcr.io" || strings.HasSuffix(registry, ".gcr.io") {
+	// 3. Try the application default credentials (e.g. workload identity), then gcloud, for GCR and Artifact Registry
+	if gcp.IsGoogleRegistry(registry) {
+		if auth, err := newGoogleEnvAuthenticator(); err == nil {
+			return auth
+		}
 		if auth, err := google.NewGcloudAuthenticator(); err == nil {
 			return auth
 		}
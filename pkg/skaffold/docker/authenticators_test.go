@@ -17,6 +17,7 @@ limitations under the License.
 package docker
 
 import (
+	"errors"
 	"os"
 	"runtime"
 	"testing"
@@ -38,7 +39,9 @@ func TestResolve(t *testing.T) {
 		registry        string
 		gcloudOutput    string
 		gcloudInPath    bool
+		adcToken        string
 		expectAnonymous bool
+		expectedToken   string
 	}{
 		{
 			description:     "gcloud is configured and working",
@@ -47,6 +50,7 @@ func TestResolve(t *testing.T) {
 			gcloudInPath:    true,
 			gcloudOutput:    "#!/bin/sh\necho '{\"credential\":{\"access_token\":\"TOKEN\",\"token_expiry\":\"2999-01-01T08:48:55Z\"}}'",
 			expectAnonymous: false,
+			expectedToken:   "TOKEN",
 		},
 		{
 			description:     "gcloud is configured but not found (anonymous)",
@@ -70,6 +74,7 @@ func TestResolve(t *testing.T) {
 			gcloudInPath:    true,
 			gcloudOutput:    "#!/bin/sh\necho '{\"credential\":{\"access_token\":\"TOKEN\",\"token_expiry\":\"2999-01-01T08:48:55Z\"}}'",
 			expectAnonymous: false,
+			expectedToken:   "TOKEN",
 		},
 		{
 			description:     "gcloud is not configured and not working (anonymous)",
@@ -79,6 +84,22 @@ func TestResolve(t *testing.T) {
 			gcloudOutput:    `exit 1`,
 			expectAnonymous: true,
 		},
+		{
+			description:   "application default credentials",
+			registry:      "gcr.io",
+			dockerConfig:  `{}`,
+			adcToken:      "ADC_TOKEN",
+			expectedToken: "ADC_TOKEN",
+		},
+		{
+			description:   "application default credentials for Artifact Registry",
+			registry:      "us-docker.pkg.dev",
+			dockerConfig:  `{}`,
+			gcloudInPath:  true,
+			gcloudOutput:  `exit 1`,
+			adcToken:      "ADC_TOKEN",
+			expectedToken: "ADC_TOKEN",
+		},
 		{
 			description:     "anonymous",
 			registry:        "docker",
@@ -106,6 +127,13 @@ func TestResolve(t *testing.T) {
 			registry, err := name.NewRegistry(test.registry)
 			t.CheckNoError(err)
 
+			t.Override(&newGoogleEnvAuthenticator, func() (authn.Authenticator, error) {
+				if test.adcToken == "" {
+					return nil, errors.New("no application default credentials")
+				}
+				return &authn.Bearer{Token: test.adcToken}, nil
+			})
+
 			kc := &Keychain{configDir: tmpDir.Root()}
 			authenticator, err := kc.Resolve(registry)
 			t.CheckNotNil(authenticator)
@@ -115,7 +143,7 @@ func TestResolve(t *testing.T) {
 			if test.expectAnonymous {
 				t.CheckDeepEqual(&authn.AuthConfig{}, authConfig)
 			} else {
-				t.CheckDeepEqual("TOKEN", authConfig.RegistryToken)
+				t.CheckDeepEqual(test.expectedToken, authConfig.RegistryToken)
 			}
 			t.CheckNoError(err)
 		})
@@ -56,12 +56,13 @@ type Config interface {
 	GetKubeContext() string
 	MinikubeProfile() string
 	GetInsecureRegistries() map[string]bool
+	UsePodman() bool
 }
 
 // NewAPIClientImpl guesses the docker client to use based on current Kubernetes context.
 func NewAPIClientImpl(cfg Config) (LocalDaemon, error) {
 	dockerAPIClientOnce.Do(func() {
-		env, apiClient, err := newAPIClient(cfg.GetKubeContext(), cfg.MinikubeProfile())
+		env, apiClient, err := newAPIClient(cfg.GetKubeContext(), cfg.MinikubeProfile(), cfg.UsePodman())
 		dockerAPIClient = NewLocalDaemon(apiClient, env, cfg.Prune(), cfg)
 		dockerAPIClientErr = err
 	})
@@ -74,7 +75,11 @@ func NewAPIClientImpl(cfg Config) (LocalDaemon, error) {
 // kubecontext API Server to minikube profiles
 
 // newAPIClient guesses the docker client to use based on current Kubernetes context.
-func newAPIClient(kubeContext string, minikubeProfile string) ([]string, client.CommonAPIClient, error) {
+// Images built with Podman are always handled by the Podman API service.
+func newAPIClient(kubeContext string, minikubeProfile string, usePodman bool) ([]string, client.CommonAPIClient, error) {
+	if usePodman {
+		return newPodmanAPIClient()
+	}
 	if minikubeProfile != "" { // skip validation if explicitly specifying minikubeProfile.
 		return newMinikubeAPIClient(minikubeProfile)
 	}
@@ -97,6 +102,27 @@ func newEnvAPIClient() ([]string, client.CommonAPIClient, error) {
 	return nil, cli, nil
 }
 
+// newPodmanAPIClient returns a docker client that talks to the Podman API service.
+func newPodmanAPIClient() ([]string, client.CommonAPIClient, error) {
+	host, err := podmanHost()
+	if err != nil {
+		return nil, nil, err
+	}
+	if host == "" {
+		// DOCKER_HOST points to the Podman API service.
+		return newEnvAPIClient()
+	}
+
+	logrus.Infof("Using Podman API service at %s", host)
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithHost(host), client.WithHTTPHeaders(getUserAgentHeader()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting podman client: %s", err)
+	}
+	cli.NegotiateAPIVersion(context.Background())
+
+	return []string{"DOCKER_HOST=" + host}, cli, nil
+}
+
 type ExitCoder interface {
 	ExitCode() int
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package docker
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -28,7 +29,20 @@ var (
 	prefixRegex = regexp.MustCompile(`(.*\.)?gcr.io/[a-zA-Z0-9-_]+/?`)
 )
 
-func SubstituteDefaultRepoIntoImage(defaultRepo string, image string) (string, error) {
+// Strategies used to rewrite image names with the default repo.
+const (
+	// EscapeStrategy keeps the path of images that share the default repo's GCR project
+	// and otherwise escapes the image name into a single path component. This is the default.
+	EscapeStrategy = "escape"
+	// PrefixStrategy concatenates the default repo and the image name.
+	PrefixStrategy = "prefix"
+	// ReplaceStrategy replaces the registry and the path of the image name with the default repo.
+	ReplaceStrategy = "replace"
+)
+
+// SubstituteDefaultRepoIntoImage rewrites the image name with the default repo,
+// following the given strategy. An empty strategy means EscapeStrategy.
+func SubstituteDefaultRepoIntoImage(defaultRepo string, strategy string, image string) (string, error) {
 	if defaultRepo == "" {
 		return image, nil
 	}
@@ -38,7 +52,17 @@ func SubstituteDefaultRepoIntoImage(defaultRepo string, image string) (string, e
 		return "", err
 	}
 
-	replaced := replace(defaultRepo, parsed.BaseName)
+	var replaced string
+	switch strategy {
+	case "", EscapeStrategy:
+		replaced = replace(defaultRepo, parsed.BaseName)
+	case PrefixStrategy:
+		replaced = prefix(defaultRepo, parsed.BaseName)
+	case ReplaceStrategy:
+		replaced = substitute(defaultRepo, parsed.BaseName)
+	default:
+		return "", fmt.Errorf("unknown default-repo strategy %q, expected one of %s, %s or %s", strategy, EscapeStrategy, PrefixStrategy, ReplaceStrategy)
+	}
 	if parsed.Tag != "" {
 		replaced = replaced + ":" + parsed.Tag
 	}
@@ -67,6 +91,21 @@ func replace(defaultRepo string, baseImage string) string {
 	return truncate(defaultRepo + "/" + escapeRegex.ReplaceAllString(baseImage, "_"))
 }
 
+func prefix(defaultRepo string, baseImage string) string {
+	if strings.HasPrefix(baseImage, defaultRepo) {
+		return baseImage
+	}
+	return truncate(defaultRepo + "/" + baseImage)
+}
+
+func substitute(defaultRepo string, baseImage string) string {
+	if strings.HasPrefix(baseImage, defaultRepo) {
+		return baseImage
+	}
+	name := baseImage[strings.LastIndex(baseImage, "/")+1:]
+	return truncate(defaultRepo + "/" + name)
+}
+
 func truncate(image string) string {
 	if len(image) > maxLength {
 		return image[0:maxLength]
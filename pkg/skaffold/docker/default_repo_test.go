@@ -27,6 +27,7 @@ func TestImageReplaceDefaultRepo(t *testing.T) {
 		description   string
 		image         string
 		defaultRepo   string
+		strategy      string
 		expectedImage string
 		shouldErr     bool
 	}{
@@ -107,10 +108,52 @@ func TestImageReplaceDefaultRepo(t *testing.T) {
 			image:       "!!invalid!!",
 			shouldErr:   true,
 		},
+		{
+			description:   "escape strategy",
+			image:         "docker.io/org/app",
+			defaultRepo:   "gcr.io/default",
+			strategy:      EscapeStrategy,
+			expectedImage: "gcr.io/default/docker_io_org_app",
+		},
+		{
+			description:   "prefix strategy",
+			image:         "docker.io/org/app:tag",
+			defaultRepo:   "registry.example.com/team",
+			strategy:      PrefixStrategy,
+			expectedImage: "registry.example.com/team/docker.io/org/app:tag",
+		},
+		{
+			description:   "prefix strategy with defaultRepo prefix",
+			image:         "registry.example.com/team/app",
+			defaultRepo:   "registry.example.com/team",
+			strategy:      PrefixStrategy,
+			expectedImage: "registry.example.com/team/app",
+		},
+		{
+			description:   "replace strategy",
+			image:         "gcr.io/other/org/app:tag",
+			defaultRepo:   "registry.example.com/team",
+			strategy:      ReplaceStrategy,
+			expectedImage: "registry.example.com/team/app:tag",
+		},
+		{
+			description:   "replace strategy with single component image",
+			image:         "app",
+			defaultRepo:   "registry.example.com/team",
+			strategy:      ReplaceStrategy,
+			expectedImage: "registry.example.com/team/app",
+		},
+		{
+			description: "unknown strategy",
+			image:       "app",
+			defaultRepo: "gcr.io/default",
+			strategy:    "unknown",
+			shouldErr:   true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			replaced, err := SubstituteDefaultRepoIntoImage(test.defaultRepo, test.image)
+			replaced, err := SubstituteDefaultRepoIntoImage(test.defaultRepo, test.strategy, test.image)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedImage, replaced)
 		})
@@ -44,7 +44,8 @@ func NormalizeDockerfilePath(context, dockerfile string) (string, error) {
 
 // GetDependencies finds the sources dependencies for the given docker artifact.
 // All paths are relative to the workspace.
-func GetDependencies(ctx context.Context, workspace string, dockerfilePath string, buildArgs map[string]*string, cfg Config) ([]string, error) {
+// When filter is not nil, only the files it matches are walked. The Dockerfile is always listed.
+func GetDependencies(ctx context.Context, workspace string, dockerfilePath string, buildArgs map[string]*string, cfg Config, filter walk.Predicate) ([]string, error) {
 	absDockerfilePath, err := NormalizeDockerfilePath(workspace, dockerfilePath)
 	if err != nil {
 		return nil, fmt.Errorf("normalizing dockerfile path: %w", err)
@@ -73,7 +74,7 @@ func GetDependencies(ctx context.Context, workspace string, dockerfilePath strin
 		deps = append(deps, ft.from)
 	}
 
-	files, err := WalkWorkspace(workspace, excludes, deps)
+	files, err := WalkWorkspace(workspace, excludes, deps, filter)
 	if err != nil {
 		return nil, fmt.Errorf("walking workspace: %w", err)
 	}
@@ -123,8 +124,9 @@ func readDockerignore(workspace string, absDockerfilePath string) ([]string, err
 }
 
 // WalkWorkspace walks the given host directories and records all files found.
+// When filter is not nil, only the entries it matches are walked.
 // Note: if you change this function, you might also want to modify `walkWorkspaceWithDestinations`.
-func WalkWorkspace(workspace string, excludes, deps []string) (map[string]bool, error) {
+func WalkWorkspace(workspace string, excludes, deps []string, filter walk.Predicate) (map[string]bool, error) {
 	dockerIgnored, err := NewDockerIgnorePredicate(workspace, excludes)
 	if err != nil {
 		return nil, err
@@ -149,7 +151,12 @@ func WalkWorkspace(workspace string, excludes, deps []string) (map[string]bool,
 			return !ignored, nil
 		}
 
-		if err := walk.From(absFrom).Unsorted().When(keepFile).WhenIsFile().Do(func(path string, info walk.Dirent) error {
+		w := walk.From(absFrom).Unsorted().When(keepFile)
+		if filter != nil {
+			w = w.When(filter)
+		}
+
+		if err := w.WhenIsFile().Do(func(path string, info walk.Dirent) error {
 			relPath, err := filepath.Rel(workspace, path)
 			if err != nil {
 				return err
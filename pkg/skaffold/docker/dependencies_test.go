@@ -288,6 +288,12 @@ func TestGetDependencies(t *testing.T) {
 			dockerfile:  remoteFileAdd,
 			expected:    []string{"Dockerfile"},
 		},
+		{
+			description: "base image from a build arg without value",
+			dockerfile:  "ARG BASE\nFROM $BASE\nCOPY server.go .\n",
+			workspace:   ".",
+			expected:    []string{"Dockerfile", "server.go"},
+		},
 		{
 			description: "multistage dockerfile",
 			dockerfile:  multiStageDockerfile1,
@@ -553,7 +559,7 @@ func TestGetDependencies(t *testing.T) {
 			}
 
 			workspace := tmpDir.Path(test.workspace)
-			deps, err := GetDependencies(context.Background(), workspace, "Dockerfile", test.buildArgs, nil)
+			deps, err := GetDependencies(context.Background(), workspace, "Dockerfile", test.buildArgs, nil, nil)
 
 			t.CheckError(test.shouldErr, err)
 			t.CheckDeepEqual(test.expected, deps)
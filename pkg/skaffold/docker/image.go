@@ -41,6 +41,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -163,6 +164,12 @@ func (l *localDaemon) CheckCompatible(a *latest.DockerArtifact) error {
 	if a.Secret != nil {
 		return fmt.Errorf("docker build secrets require BuildKit - set `useBuildkit: true` in your config, or run with `DOCKER_BUILDKIT=1`")
 	}
+	if a.SSH != "" {
+		return fmt.Errorf("docker build ssh requires BuildKit - set `useBuildkit: true` in your config, or run with `DOCKER_BUILDKIT=1`")
+	}
+	if a.InlineCache {
+		return fmt.Errorf("docker build inline cache requires BuildKit - set `useBuildkit: true` in your config, or run with `DOCKER_BUILDKIT=1`")
+	}
 	return nil
 }
 
@@ -253,6 +260,13 @@ func streamDockerMessages(dst io.Writer, src io.Reader, auxCallback func(jsonmes
 
 // Push pushes an image reference to a registry. Returns the image digest.
 func (l *localDaemon) Push(ctx context.Context, out io.Writer, ref string) (string, error) {
+	ctx, endTrace := tracing.StartSpan(ctx, "Push", map[string]string{"image": ref})
+	digest, err := l.push(ctx, out, ref)
+	endTrace(err)
+	return digest, err
+}
+
+func (l *localDaemon) push(ctx context.Context, out io.Writer, ref string) (string, error) {
 	registryAuth, err := l.encodedRegistryAuth(ctx, DefaultAuthHelper, ref)
 	if err != nil {
 		return "", fmt.Errorf("getting auth config for %q: %w", ref, err)
@@ -504,6 +518,14 @@ func ToCLIBuildArgs(a *latest.DockerArtifact, evaluatedArgs map[string]*string)
 		args = append(args, "--secret", secretString)
 	}
 
+	if a.SSH != "" {
+		args = append(args, "--ssh", a.SSH)
+	}
+
+	if a.InlineCache {
+		args = append(args, "--build-arg", "BUILDKIT_INLINE_CACHE=1")
+	}
+
 	return args, nil
 }
 
@@ -353,6 +353,20 @@ func TestGetBuildArgs(t *testing.T) {
 			},
 			want: []string{"--secret", "id=mysecret,src=foo.src,dst=foo.dst"},
 		},
+		{
+			description: "ssh",
+			artifact: &latest.DockerArtifact{
+				SSH: "default",
+			},
+			want: []string{"--ssh", "default"},
+		},
+		{
+			description: "inline cache",
+			artifact: &latest.DockerArtifact{
+				InlineCache: true,
+			},
+			want: []string{"--build-arg", "BUILDKIT_INLINE_CACHE=1"},
+		},
 		{
 			description: "all",
 			artifact: &latest.DockerArtifact{
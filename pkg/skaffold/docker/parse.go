@@ -212,8 +212,10 @@ func extractCopyCommands(nodes []*parser.Node, onlyLastImage bool, cfg Config) (
 			}
 
 			// If `from` references a previous stage, then the `workdir`
-			// was already changed.
-			if !stages[strings.ToLower(from.image)] {
+			// was already changed. An unresolved base image defaults to `/`.
+			if from.image == "" {
+				workdir = "/"
+			} else if !stages[strings.ToLower(from.image)] {
 				img, err := RetrieveImage(from.image, cfg)
 				if err != nil {
 					return nil, err
@@ -313,7 +315,11 @@ func expandOnbuildInstructions(nodes []*parser.Node, cfg Config) ([]*parser.Node
 			n = m + 1
 
 			var onbuildNodes []*parser.Node
-			if ons, found := onbuildNodesCache[strings.ToLower(from.image)]; found {
+			if from.image == "" {
+				// The base image is a build arg only known at build time,
+				// for example the tag of a required artifact.
+				logrus.Debugf("Skipping ONBUILD triggers of an unresolved base image")
+			} else if ons, found := onbuildNodesCache[strings.ToLower(from.image)]; found {
 				onbuildNodes = ons
 			} else if ons, err := parseOnbuild(from.image, cfg); err == nil {
 				onbuildNodes = ons
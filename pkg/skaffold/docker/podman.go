@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// For testing
+var (
+	podmanSockets = func() []string {
+		sockets := []string{"/run/podman/podman.sock"}
+		if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); runtimeDir != "" {
+			sockets = append([]string{filepath.Join(runtimeDir, "podman", "podman.sock")}, sockets...)
+		}
+		return sockets
+	}
+)
+
+// podmanHost returns the host of a running Podman API service, either rootless or rootful.
+// An empty host means that DOCKER_HOST should be used as configured.
+func podmanHost() (string, error) {
+	if os.Getenv("DOCKER_HOST") != "" {
+		return "", nil
+	}
+
+	sockets := podmanSockets()
+	for _, socket := range sockets {
+		if _, err := os.Stat(socket); err == nil {
+			return "unix://" + socket, nil
+		}
+	}
+	return "", fmt.Errorf("`usePodman` requires the Podman API service, but no socket was found in %s. start it with `podman system service --time=0` or set DOCKER_HOST", strings.Join(sockets, ", "))
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPodmanHost(t *testing.T) {
+	tests := []struct {
+		description string
+		dockerHost  string
+		files       []string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "rootless podman socket",
+			files:       []string{"rootless.sock", "rootful.sock"},
+			expected:    "rootless.sock",
+		},
+		{
+			description: "rootful podman socket",
+			files:       []string{"rootful.sock"},
+			expected:    "rootful.sock",
+		},
+		{
+			description: "docker host is set",
+			dockerHost:  "tcp://127.0.0.1:2375",
+			files:       []string{"rootless.sock"},
+		},
+		{
+			description: "no podman socket",
+			files:       []string{"docker.sock"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			for _, file := range test.files {
+				tmpDir.Touch(file)
+			}
+			t.SetEnvs(map[string]string{"DOCKER_HOST": test.dockerHost})
+			t.Override(&podmanSockets, func() []string {
+				return []string{tmpDir.Path("rootless.sock"), tmpDir.Path("rootful.sock")}
+			})
+
+			host, err := podmanHost()
+
+			t.CheckError(test.shouldErr, err)
+			if test.expected == "" {
+				t.CheckEmpty(host)
+			} else {
+				t.CheckDeepEqual("unix://"+tmpDir.Path(test.expected), host)
+			}
+		})
+	}
+}
+
+func TestNewAPIClientWithPodman(t *testing.T) {
+	testutil.Run(t, "no podman socket", func(t *testutil.T) {
+		t.SetEnvs(map[string]string{"DOCKER_HOST": ""})
+		t.Override(&podmanSockets, func() []string { return []string{t.NewTempDir().Path("podman.sock")} })
+
+		_, _, err := newAPIClient("kind-kind", "", true)
+
+		t.CheckErrorContains("requires the Podman API service", err)
+	})
+
+	testutil.Run(t, "podman socket", func(t *testutil.T) {
+		t.SetEnvs(map[string]string{"DOCKER_HOST": ""})
+		socket := t.NewTempDir().Touch("podman.sock").Path("podman.sock")
+		t.Override(&podmanSockets, func() []string { return []string{socket} })
+
+		env, client, err := newAPIClient("kind-kind", "", true)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"DOCKER_HOST=unix://" + socket}, env)
+		t.CheckDeepEqual("unix://"+socket, client.DaemonHost())
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package docker
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/go-containerregistry/pkg/name"
@@ -26,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 )
 
 // for testing
@@ -64,6 +66,11 @@ func getRemoteDigest(identifier string, cfg Config) (string, error) {
 	return digest(img)
 }
 
+// RetrieveRemoteImage retrieves an image from its registry.
+func RetrieveRemoteImage(identifier string, cfg Config) (v1.Image, error) {
+	return getRemoteImage(identifier, cfg)
+}
+
 // RetrieveRemoteConfig retrieves the remote config file for an image
 func RetrieveRemoteConfig(identifier string, cfg Config) (*v1.ConfigFile, error) {
 	img, err := getRemoteImage(identifier, cfg)
@@ -75,7 +82,14 @@ func RetrieveRemoteConfig(identifier string, cfg Config) (*v1.ConfigFile, error)
 }
 
 // Push pushes the tarball image
-func Push(tarPath, tag string, cfg Config) (string, error) {
+func Push(ctx context.Context, tarPath, tag string, cfg Config) (string, error) {
+	_, endTrace := tracing.StartSpan(ctx, "Push", map[string]string{"image": tag})
+	digest, err := pushTarball(tarPath, tag, cfg)
+	endTrace(err)
+	return digest, err
+}
+
+func pushTarball(tarPath, tag string, cfg Config) (string, error) {
 	t, err := name.NewTag(tag, name.WeakValidation)
 	if err != nil {
 		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
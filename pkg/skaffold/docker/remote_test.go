@@ -182,3 +182,4 @@ type mockConfig struct {
 }
 
 func (c *mockConfig) GetInsecureRegistries() map[string]bool { return c.insecureRegistries }
+func (c *mockConfig) UsePodman() bool                        { return false }
@@ -27,7 +27,9 @@ import (
 
 // These are phases in a DevLoop
 const (
+	Config      = Phase("Config")
 	Build       = Phase("Build")
+	Test        = Phase("Test")
 	Deploy      = Phase("Deploy")
 	StatusCheck = Phase("StatusCheck")
 	FileSync    = Phase("FileSync")
@@ -64,9 +66,9 @@ func ShowAIError(err error) error {
 	for _, v := range knownBuildProblems {
 		if v.regexp.MatchString(err.Error()) {
 			if suggestions := v.suggestion(skaffoldOpts); suggestions != nil {
-				return fmt.Errorf("%s. %s", strings.Trim(v.description(err), "."), concatSuggestions(suggestions))
+				return keepExitCode(err, fmt.Errorf("%s. %s", strings.Trim(v.description(err), "."), concatSuggestions(suggestions)))
 			}
-			return fmt.Errorf(v.description(err))
+			return keepExitCode(err, fmt.Errorf(v.description(err)))
 		}
 	}
 	return err
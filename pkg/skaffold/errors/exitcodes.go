@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import stderrors "errors"
+
+// Exit codes returned by Skaffold, by class of failure.
+// `skaffold init` uses the 101-104 range for its own errors.
+const (
+	ConfigExitCode      = 110
+	BuildExitCode       = 111
+	TestExitCode        = 112
+	DeployExitCode      = 113
+	StatusCheckExitCode = 114
+)
+
+// exitCodeErr annotates an error with the exit code of its class of failure.
+type exitCodeErr struct {
+	err  error
+	code int
+}
+
+func (e *exitCodeErr) Error() string { return e.err.Error() }
+func (e *exitCodeErr) Unwrap() error { return e.err }
+func (e *exitCodeErr) ExitCode() int { return e.code }
+
+// WithExitCode annotates an error with the exit code Skaffold should return if
+// it fails because of this error. The innermost annotation wins, so that a build
+// failure that ends a deploy still exits with the build exit code.
+func WithExitCode(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var annotated *exitCodeErr
+	if stderrors.As(err, &annotated) {
+		return err
+	}
+	return &exitCodeErr{err: err, code: code}
+}
+
+// keepExitCode gives an error that replaces another one the exit code of the replaced error.
+func keepExitCode(replaced, err error) error {
+	var annotated *exitCodeErr
+	if stderrors.As(replaced, &annotated) {
+		return &exitCodeErr{err: err, code: annotated.code}
+	}
+	return err
+}
+
+// ExitCode returns the exit code Skaffold should return when it fails because of the given error.
+func ExitCode(err error) int {
+	var exitCoder interface{ ExitCode() int }
+	if stderrors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+	return 1
+}
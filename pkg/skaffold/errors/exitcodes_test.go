@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type exitCoder interface {
+	ExitCode() int
+}
+
+func TestWithExitCode(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    int
+	}{
+		{
+			description: "annotated error",
+			err:         WithExitCode(BuildExitCode, errors.New("build failed")),
+			expected:    BuildExitCode,
+		},
+		{
+			description: "wrapped annotated error",
+			err:         fmt.Errorf("exiting dev mode: %w", WithExitCode(DeployExitCode, errors.New("deploy failed"))),
+			expected:    DeployExitCode,
+		},
+		{
+			description: "innermost annotation wins",
+			err:         WithExitCode(DeployExitCode, fmt.Errorf("deploying: %w", WithExitCode(StatusCheckExitCode, errors.New("deadline exceeded")))),
+			expected:    StatusCheckExitCode,
+		},
+		{
+			description: "known build problem keeps its exit code",
+			err:         ShowAIError(WithExitCode(BuildExitCode, errors.New("could not push image: unknown: Project"))),
+			expected:    BuildExitCode,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var coder exitCoder
+			t.CheckTrue(errors.As(test.err, &coder))
+			t.CheckDeepEqual(test.expected, coder.ExitCode())
+		})
+	}
+}
+
+func TestWithExitCodeNil(t *testing.T) {
+	testutil.CheckDeepEqual(t, nil, WithExitCode(BuildExitCode, nil))
+}
+
+func TestWithExitCodeMessage(t *testing.T) {
+	err := WithExitCode(TestExitCode, errors.New("test failed"))
+
+	testutil.CheckDeepEqual(t, "test failed", err.Error())
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// phaseForExitCode is the phase that fails with each exit code.
+var phaseForExitCode = map[int]Phase{
+	ConfigExitCode:      Config,
+	BuildExitCode:       Build,
+	TestExitCode:        Test,
+	DeployExitCode:      Deploy,
+	StatusCheckExitCode: StatusCheck,
+}
+
+// Record describes the error that ended a run, for wrappers and IDEs to present fixes.
+type Record struct {
+	Code        proto.StatusCode
+	ExitCode    int
+	Phase       Phase
+	Artifact    string
+	Message     string
+	Suggestions []string
+}
+
+// artifactErr annotates an error with the artifact that failed.
+type artifactErr struct {
+	err      error
+	artifact string
+}
+
+func (e *artifactErr) Error() string { return e.err.Error() }
+func (e *artifactErr) Unwrap() error { return e.err }
+
+// WithArtifact annotates an error with the image name of the artifact that failed.
+func WithArtifact(artifact string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &artifactErr{err: err, artifact: artifact}
+}
+
+// NewRecord describes the error that ended a run with its phase, the artifact that failed, if any,
+// and suggestions to fix it.
+func NewRecord(err error) Record {
+	exitCode := ExitCode(err)
+	phase := phaseForExitCode[exitCode]
+	code, suggestions := getErrorCodeFromError(phase, err)
+
+	record := Record{
+		Code:     code,
+		ExitCode: exitCode,
+		Phase:    phase,
+		Message:  err.Error(),
+	}
+	var annotated *artifactErr
+	if stderrors.As(err, &annotated) {
+		record.Artifact = annotated.artifact
+	}
+	for _, s := range suggestions {
+		record.Suggestions = append(record.Suggestions, s.Action)
+	}
+	return record
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestNewRecord(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    Record
+	}{
+		{
+			description: "push access denied",
+			err:         WithExitCode(BuildExitCode, fmt.Errorf("build failed: %w", WithArtifact("image", errors.New("could not push image: denied: push access to resource")))),
+			expected: Record{
+				Code:        proto.StatusCode_BUILD_PUSH_ACCESS_DENIED,
+				ExitCode:    BuildExitCode,
+				Phase:       Build,
+				Artifact:    "image",
+				Message:     "build failed: could not push image: denied: push access to resource",
+				Suggestions: []string{"Check your `--default-repo` value", "try `gcloud auth configure-docker`"},
+			},
+		},
+		{
+			description: "deploy error",
+			err:         WithExitCode(DeployExitCode, errors.New("kubectl apply failed")),
+			expected: Record{
+				Code:     proto.StatusCode_DEPLOY_UNKNOWN,
+				ExitCode: DeployExitCode,
+				Phase:    Deploy,
+				Message:  "kubectl apply failed",
+			},
+		},
+		{
+			description: "unclassified error",
+			err:         errors.New("something went wrong"),
+			expected: Record{
+				Code:     proto.StatusCode_UNKNOWN_ERROR,
+				ExitCode: 1,
+				Message:  "something went wrong",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&getConfigForCurrentContext, func(string) (*config.ContextConfig, error) {
+				return &config.ContextConfig{}, nil
+			})
+			t.Override(&skaffoldOpts, config.SkaffoldOptions{DefaultRepo: stringOrUndefined("gcr.io/test")})
+
+			t.CheckDeepEqual(test.expected, NewRecord(test.err))
+		})
+	}
+}
@@ -19,6 +19,7 @@ package event
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/ptypes"
@@ -206,6 +207,18 @@ func DeployInfoEvent(err error) {
 	handler.handleDeployEvent(&proto.DeployEvent{Status: Info, Err: err.Error()})
 }
 
+// CloudRunServiceAvailable notifies that a Cloud Run service was deployed and can be reached at the given URL.
+func CloudRunServiceAvailable(name, url string) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_CloudRunServiceEvent{
+			CloudRunServiceEvent: &proto.CloudRunServiceEvent{
+				Service: name,
+				Url:     url,
+			},
+		},
+	})
+}
+
 func StatusCheckEventEnded(errCode proto.StatusCode, err error) {
 	if err != nil {
 		handler.stateLock.Lock()
@@ -301,6 +314,21 @@ func BuildInProgress(imageName string) {
 	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: InProgress})
 }
 
+// BuildStepCompleted notifies that a step of a build has completed.
+// The build is still in progress so the state is left untouched
+// and only the log entry describes the step.
+func BuildStepCompleted(imageName, step string) {
+	handler.logEvent(proto.LogEntry{
+		Timestamp: ptypes.TimestampNow(),
+		Event: &proto.Event{
+			EventType: &proto.Event_BuildEvent{
+				BuildEvent: &proto.BuildEvent{Artifact: imageName, Status: InProgress},
+			},
+		},
+		Entry: fmt.Sprintf("Build step %s completed for artifact %s", step, imageName),
+	})
+}
+
 // BuildCanceled notifies that a build has been canceled.
 func BuildCanceled(imageName string) {
 	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: Canceled})
@@ -505,6 +533,29 @@ func LogMetaEvent() {
 	})
 }
 
+// APIServerAvailable notifies on which ports the gRPC and HTTP servers of the API are listening.
+func APIServerAvailable(grpcPort, httpPort int) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_ApiServerEvent{
+			ApiServerEvent: &proto.APIServerEvent{
+				GrpcPort: int32(grpcPort),
+				HttpPort: int32(httpPort),
+			},
+		},
+	})
+}
+
+// TimingsSummary publishes the phase breakdown of a run or dev loop iteration.
+func TimingsSummary(phases []*proto.PhaseTiming) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_TimingsSummaryEvent{
+			TimingsSummaryEvent: &proto.TimingsSummaryEvent{
+				Phases: phases,
+			},
+		},
+	})
+}
+
 func (ev *eventHandler) handle(event *proto.Event) {
 	go func(t *timestamp.Timestamp) {
 		ev.eventChan <- firedEvent{
@@ -627,6 +678,22 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		case Terminated:
 			logEntry.Entry = fmt.Sprintf("Debuggable container terminated pod/%s:%s (%s)", de.PodName, de.ContainerName, de.Namespace)
 		}
+	case *proto.Event_CloudRunServiceEvent:
+		ce := e.CloudRunServiceEvent
+		logEntry.Entry = fmt.Sprintf("Cloud Run service %s is available at %s", ce.Service, ce.Url)
+	case *proto.Event_TimingsSummaryEvent:
+		var phases []string
+		for _, p := range e.TimingsSummaryEvent.Phases {
+			phase := fmt.Sprintf("%s: %s", p.Phase, p.Duration)
+			if p.Failed {
+				phase += " (failed)"
+			}
+			phases = append(phases, phase)
+		}
+		logEntry.Entry = "Timings: " + strings.Join(phases, ", ")
+	case *proto.Event_ApiServerEvent:
+		ae := e.ApiServerEvent
+		logEntry.Entry = fmt.Sprintf("Skaffold API available on gRPC port %d and HTTP port %d", ae.GrpcPort, ae.HttpPort)
 	case *proto.Event_DevLoopEvent:
 		de := e.DevLoopEvent
 		switch de.Status {
@@ -109,6 +109,27 @@ func TestDeployComplete(t *testing.T) {
 	})
 }
 
+func TestCloudRunServiceAvailable(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	CloudRunServiceAvailable("svc", "https://svc-abc-uc.a.run.app")
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) != 1 {
+			return false
+		}
+		e := handler.eventLog[0]
+		ce := e.GetEvent().GetCloudRunServiceEvent()
+		return ce.GetService() == "svc" && ce.GetUrl() == "https://svc-abc-uc.a.run.app" &&
+			e.Entry == "Cloud Run service svc is available at https://svc-abc-uc.a.run.app"
+	})
+	testutil.CheckDeepEqual(t, NotStarted, handler.getState().DeployState.Status)
+}
+
 func TestBuildInProgress(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -142,6 +163,23 @@ func TestBuildFailed(t *testing.T) {
 	})
 }
 
+func TestBuildStepCompleted(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{Build: latest.BuildConfig{
+		Artifacts: []*latest.Artifact{{
+			ImageName: "img",
+		}},
+	}}, "test", true, true, true)
+
+	BuildStepCompleted("img", "[2/3] RUN make")
+
+	testutil.CheckDeepEqual(t, 1, len(handler.eventLog))
+	testutil.CheckDeepEqual(t, "Build step [2/3] RUN make completed for artifact img", handler.eventLog[0].Entry)
+	testutil.CheckDeepEqual(t, NotStarted, handler.getState().BuildState.Artifacts["img"])
+}
+
 func TestBuildComplete(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -329,6 +367,48 @@ func wait(t *testing.T, condition func() bool) {
 	}
 }
 
+func TestTimingsSummary(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	TimingsSummary([]*proto.PhaseTiming{
+		{Phase: "build app", Duration: "1s"},
+		{Phase: "deploy", Duration: "2s", Failed: true},
+	})
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) != 1 {
+			return false
+		}
+		e := handler.eventLog[0]
+		return len(e.GetEvent().GetTimingsSummaryEvent().GetPhases()) == 2 &&
+			e.Entry == "Timings: build app: 1s, deploy: 2s (failed)"
+	})
+}
+
+func TestAPIServerAvailable(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	APIServerAvailable(50051, 50053)
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) != 1 {
+			return false
+		}
+		e := handler.eventLog[0]
+		ae := e.GetEvent().GetApiServerEvent()
+		return ae.GetGrpcPort() == 50051 && ae.GetHttpPort() == 50053 &&
+			e.Entry == "Skaffold API available on gRPC port 50051 and HTTP port 50053"
+	})
+}
+
 func TestResetStateOnBuild(t *testing.T) {
 	defer func() { handler = newHandler() }()
 	handler = newHandler()
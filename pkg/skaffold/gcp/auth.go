@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"strings"
 	"sync"
 
 	"github.com/docker/cli/cli/config/configfile"
@@ -59,6 +60,11 @@ func AutoConfigureGCRCredentialHelper(cf *configfile.ConfigFile) {
 	}
 }
 
+// IsGoogleRegistry tells if the given registry is hosted on GCR or Artifact Registry.
+func IsGoogleRegistry(registry string) bool {
+	return registry == "gcr.io" || strings.HasSuffix(registry, ".gcr.io") || strings.HasSuffix(registry, "-docker.pkg.dev")
+}
+
 func activeUserCredentials() (*google.Credentials, error) {
 	credsOnce.Do(func() {
 		cmd := exec.Command("gcloud", "auth", "print-access-token", "--format=json")
@@ -110,3 +110,22 @@ func TestAutoConfigureGCRCredentialHelper(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGoogleRegistry(t *testing.T) {
+	tests := []struct {
+		registry string
+		expected bool
+	}{
+		{registry: "gcr.io", expected: true},
+		{registry: "eu.gcr.io", expected: true},
+		{registry: "europe-west1-docker.pkg.dev", expected: true},
+		{registry: "docker.io"},
+		{registry: "notgcr.io"},
+		{registry: "europe-west1-npm.pkg.dev"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.registry, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, IsGoogleRegistry(test.registry))
+		})
+	}
+}
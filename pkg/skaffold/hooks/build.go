@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// BuildRunner runs the lifecycle hooks of the build step of an artifact.
+type BuildRunner struct {
+	artifact  *latest.Artifact
+	pushImage bool
+}
+
+// NewBuildRunner returns a runner for the build hooks of an artifact.
+func NewBuildRunner(artifact *latest.Artifact, pushImage bool) BuildRunner {
+	return BuildRunner{
+		artifact:  artifact,
+		pushImage: pushImage,
+	}
+}
+
+// RunPreHooks runs the hooks that come before the build of the image.
+// Since they can change the sources of the image, they run before it's tagged.
+func (r BuildRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	if len(r.artifact.LifecycleHooks.PreHooks) == 0 {
+		return nil
+	}
+	color.Default.Fprintf(out, "Starting pre-build hooks for artifact %q...\n", r.artifact.ImageName)
+	return r.run(ctx, out, r.artifact.LifecycleHooks.PreHooks, r.preEnv())
+}
+
+// RunPostHooks runs the hooks that come after the build of the image.
+func (r BuildRunner) RunPostHooks(ctx context.Context, out io.Writer, image string) error {
+	if len(r.artifact.LifecycleHooks.PostHooks) == 0 {
+		return nil
+	}
+	color.Default.Fprintf(out, "Starting post-build hooks for artifact %q...\n", r.artifact.ImageName)
+	env, err := r.postEnv(image)
+	if err != nil {
+		return err
+	}
+	return r.run(ctx, out, r.artifact.LifecycleHooks.PostHooks, env)
+}
+
+func (r BuildRunner) run(ctx context.Context, out io.Writer, hooks []latest.HostHook, env map[string]string) error {
+	for _, h := range hooks {
+		if err := runHostHook(ctx, out, h, r.artifact.Workspace, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// preEnv returns the environment variables of the pre-build hooks.
+// The image is not tagged yet.
+func (r BuildRunner) preEnv() map[string]string {
+	return map[string]string{
+		"SKAFFOLD_IMAGE":         r.artifact.ImageName,
+		"SKAFFOLD_IMAGE_REPO":    r.artifact.ImageName,
+		"SKAFFOLD_PUSH_IMAGE":    strconv.FormatBool(r.pushImage),
+		"SKAFFOLD_BUILD_CONTEXT": r.artifact.Workspace,
+	}
+}
+
+// postEnv returns the environment variables of the post-build hooks.
+func (r BuildRunner) postEnv(image string) (map[string]string, error) {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return nil, fmt.Errorf("parsing image %q: %w", image, err)
+	}
+
+	return map[string]string{
+		"SKAFFOLD_IMAGE":         image,
+		"SKAFFOLD_IMAGE_REPO":    ref.BaseName,
+		"SKAFFOLD_IMAGE_TAG":     ref.Tag,
+		"SKAFFOLD_PUSH_IMAGE":    strconv.FormatBool(r.pushImage),
+		"SKAFFOLD_BUILD_CONTEXT": r.artifact.Workspace,
+	}, nil
+}
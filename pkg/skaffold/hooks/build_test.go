@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildHooks(t *testing.T) {
+	tests := []struct {
+		description string
+		hooks       latest.BuildHooks
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "no hooks",
+		},
+		{
+			description: "pre and post hooks",
+			hooks: latest.BuildHooks{
+				PreHooks:  []latest.HostHook{{Command: []string{"go", "generate", "./..."}}},
+				PostHooks: []latest.HostHook{{Command: []string{"smoke", "{{.SKAFFOLD_IMAGE_REPO}}", "{{.SKAFFOLD_IMAGE_TAG}}", "{{.SKAFFOLD_PUSH_IMAGE}}"}}},
+			},
+			commands: testutil.
+				CmdRunEnv("go generate ./...", []string{
+					"SKAFFOLD_BUILD_CONTEXT=app",
+					"SKAFFOLD_IMAGE=gcr.io/k8s-skaffold/app",
+					"SKAFFOLD_IMAGE_REPO=gcr.io/k8s-skaffold/app",
+					"SKAFFOLD_PUSH_IMAGE=true",
+				}).
+				AndRun("smoke gcr.io/k8s-skaffold/app v1 true"),
+		},
+		{
+			description: "failing pre hook",
+			hooks: latest.BuildHooks{
+				PreHooks: []latest.HostHook{{Command: []string{"protoc"}}},
+			},
+			commands:  testutil.CmdRunErr("protoc", errors.New("BUG")),
+			shouldErr: true,
+		},
+		{
+			description: "invalid template",
+			hooks: latest.BuildHooks{
+				PreHooks: []latest.HostHook{{Command: []string{"echo", "{{.SKAFFOLD_IMAGE"}}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&util.OSEnviron, func() []string { return nil })
+
+			runner := NewBuildRunner(&latest.Artifact{ImageName: "gcr.io/k8s-skaffold/app", Workspace: "app", LifecycleHooks: test.hooks}, true)
+
+			err := runner.RunPreHooks(context.Background(), ioutil.Discard)
+			if err == nil {
+				err = runner.RunPostHooks(context.Background(), ioutil.Discard, "gcr.io/k8s-skaffold/app:v1")
+			}
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
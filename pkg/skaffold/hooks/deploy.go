@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// DeployRunner runs the lifecycle hooks of the deploy step.
+type DeployRunner struct {
+	hooks      latest.DeployHooks
+	cli        *kubectl.CLI
+	namespaces func() []string
+}
+
+// NewDeployRunner returns a runner for the deploy hooks.
+// The namespaces are read each time the hooks run since they change with each deploy.
+func NewDeployRunner(cli *kubectl.CLI, hooks latest.DeployHooks, namespaces func() []string) DeployRunner {
+	return DeployRunner{
+		hooks:      hooks,
+		cli:        cli,
+		namespaces: namespaces,
+	}
+}
+
+// RunPreHooks runs the hooks that come before the deploy step.
+func (r DeployRunner) RunPreHooks(ctx context.Context, out io.Writer, builds []build.Artifact) error {
+	if len(r.hooks.PreHooks) == 0 {
+		return nil
+	}
+	color.Default.Fprintln(out, "Starting pre-deploy hooks...")
+	return r.run(ctx, out, r.hooks.PreHooks, builds)
+}
+
+// RunPostHooks runs the hooks that come after the deploy step.
+func (r DeployRunner) RunPostHooks(ctx context.Context, out io.Writer, builds []build.Artifact) error {
+	if len(r.hooks.PostHooks) == 0 {
+		return nil
+	}
+	color.Default.Fprintln(out, "Starting post-deploy hooks...")
+	return r.run(ctx, out, r.hooks.PostHooks, builds)
+}
+
+func (r DeployRunner) run(ctx context.Context, out io.Writer, hooks []latest.DeployHookItem, builds []build.Artifact) error {
+	env := r.env(builds)
+
+	for _, h := range hooks {
+		if h.HostHook != nil {
+			if err := runHostHook(ctx, out, *h.HostHook, "", env); err != nil {
+				return err
+			}
+		}
+
+		if h.ContainerHook != nil {
+			for _, ns := range r.namespacesOrDefault() {
+				pods, err := matchingPods(ctx, r.cli, ns, h.ContainerHook.PodName)
+				if err != nil {
+					return err
+				}
+				for _, pod := range pods {
+					if err := runContainerHook(ctx, out, r.cli, h.ContainerHook.ContainerHook, ns, pod, h.ContainerHook.ContainerName, env); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// env returns the environment variables of the deploy hooks.
+func (r DeployRunner) env(builds []build.Artifact) map[string]string {
+	env := imagesEnv(builds)
+	env["SKAFFOLD_KUBE_CONTEXT"] = r.cli.KubeContext
+	env["SKAFFOLD_NAMESPACES"] = strings.Join(r.namespaces(), ",")
+	return env
+}
+
+// namespacesOrDefault returns the namespaces to look for pods in.
+// An empty namespace stands for the default namespace.
+func (r DeployRunner) namespacesOrDefault() []string {
+	if len(r.namespaces()) == 0 {
+		return []string{""}
+	}
+	return r.namespaces()
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDeployHooks(t *testing.T) {
+	builds := []build.Artifact{{ImageName: "gcr.io/k8s-skaffold/web", Tag: "gcr.io/k8s-skaffold/web:v1"}}
+	tests := []struct {
+		description string
+		hooks       latest.DeployHooks
+		namespaces  []string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "no hooks",
+		},
+		{
+			description: "host hooks with templates",
+			hooks: latest.DeployHooks{
+				PreHooks: []latest.DeployHookItem{
+					{HostHook: &latest.HostHook{Command: []string{"migrate", "--image", "{{.SKAFFOLD_IMAGE_GCR_IO_K8S_SKAFFOLD_WEB}}"}}},
+				},
+				PostHooks: []latest.DeployHookItem{
+					{HostHook: &latest.HostHook{Command: []string{"warm", "{{.SKAFFOLD_KUBE_CONTEXT}}", "{{.SKAFFOLD_NAMESPACES}}"}}},
+				},
+			},
+			namespaces: []string{"ns1", "ns2"},
+			commands: testutil.
+				CmdRun("migrate --image gcr.io/k8s-skaffold/web:v1").
+				AndRun("warm kubecontext ns1,ns2"),
+		},
+		{
+			description: "host hook skipped on other os",
+			hooks: latest.DeployHooks{
+				PreHooks: []latest.DeployHookItem{
+					{HostHook: &latest.HostHook{Command: []string{"migrate"}, OS: []string{"plan9"}}},
+				},
+			},
+		},
+		{
+			description: "container hooks on matching pods",
+			hooks: latest.DeployHooks{
+				PostHooks: []latest.DeployHookItem{
+					{ContainerHook: &latest.NamedContainerHook{
+						ContainerHook: latest.ContainerHook{Command: []string{"warm-cache"}},
+						PodName:       "web-*",
+						ContainerName: "app",
+					}},
+				},
+			},
+			namespaces: []string{"ns1"},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace ns1 get pods -o jsonpath={.items[*].metadata.name}", "web-1 db-1 web-2").
+				AndRun("kubectl --context kubecontext --namespace ns1 exec web-1 -c app -- warm-cache").
+				AndRun("kubectl --context kubecontext --namespace ns1 exec web-2 -c app -- warm-cache"),
+		},
+		{
+			description: "container hook in default namespace",
+			hooks: latest.DeployHooks{
+				PreHooks: []latest.DeployHookItem{
+					{ContainerHook: &latest.NamedContainerHook{
+						ContainerHook: latest.ContainerHook{Command: []string{"echo", "{{.SKAFFOLD_IMAGE_GCR_IO_K8S_SKAFFOLD_WEB}}"}},
+						PodName:       "web",
+					}},
+				},
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext get pods -o jsonpath={.items[*].metadata.name}", "web").
+				AndRun("kubectl --context kubecontext exec web -- echo gcr.io/k8s-skaffold/web:v1"),
+		},
+		{
+			description: "failing hook",
+			hooks: latest.DeployHooks{
+				PreHooks: []latest.DeployHookItem{
+					{HostHook: &latest.HostHook{Command: []string{"migrate"}}},
+				},
+			},
+			commands:  testutil.CmdRunErr("migrate", errors.New("BUG")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&util.OSEnviron, func() []string { return nil })
+
+			cli := kubectl.NewCLI(&runcontext.RunContext{KubeContext: "kubecontext"}, "")
+			runner := NewDeployRunner(cli, test.hooks, func() []string { return test.namespaces })
+
+			err := runner.RunPreHooks(context.Background(), ioutil.Discard, builds)
+			if err == nil {
+				err = runner.RunPostHooks(context.Background(), ioutil.Discard, builds)
+			}
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
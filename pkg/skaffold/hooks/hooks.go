@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+var nonAlphaNum = regexp.MustCompile("[^A-Za-z0-9]")
+
+// runHostHook runs a command on the host, in the given directory, with the given
+// environment variables added to the environment of skaffold.
+func runHostHook(ctx context.Context, out io.Writer, h latest.HostHook, dir string, env map[string]string) error {
+	if len(h.OS) > 0 && !util.StrSliceContains(h.OS, runtime.GOOS) {
+		logrus.Debugf("Skipping hook %v on %s", h.Command, runtime.GOOS)
+		return nil
+	}
+
+	command, err := expandCommand(h.Command, env)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Dir = dir
+	cmd.Env = append(util.OSEnviron(), util.EnvMapToSlice(env, "=")...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("running host hook %v: %w", h.Command, err)
+	}
+	return nil
+}
+
+// runContainerHook runs a command in a container with `kubectl exec`.
+// Environment variables can't be passed to the container so they can only be used in templates.
+func runContainerHook(ctx context.Context, out io.Writer, cli *kubectl.CLI, h latest.ContainerHook, namespace, pod, container string, env map[string]string) error {
+	command, err := expandCommand(h.Command, env)
+	if err != nil {
+		return err
+	}
+
+	args := []string{pod}
+	if container != "" {
+		args = append(args, "-c", container)
+	}
+	args = append(append(args, "--"), command...)
+
+	if err := cli.RunInNamespace(ctx, nil, out, "exec", namespace, args...); err != nil {
+		return fmt.Errorf("running hook %v in pod %q: %w", h.Command, pod, err)
+	}
+	return nil
+}
+
+// matchingPods lists the pods of the given namespace whose name match the given glob pattern.
+func matchingPods(ctx context.Context, cli *kubectl.CLI, namespace, pattern string) ([]string, error) {
+	out, err := util.RunCmdOut(cli.CommandWithNamespaceArg(ctx, "get", namespace, "pods", "-o", "jsonpath={.items[*].metadata.name}"))
+	if err != nil {
+		return nil, fmt.Errorf("listing pods: %w", err)
+	}
+
+	var pods []string
+	for _, name := range strings.Fields(string(out)) {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			pods = append(pods, name)
+		}
+	}
+	return pods, nil
+}
+
+// expandCommand expands the templates of each argument of a command.
+func expandCommand(command []string, env map[string]string) ([]string, error) {
+	var expanded []string
+	for _, arg := range command {
+		e, err := util.ExpandEnvTemplate(arg, env)
+		if err != nil {
+			return nil, fmt.Errorf("expanding hook command %v: %w", command, err)
+		}
+		expanded = append(expanded, e)
+	}
+	return expanded, nil
+}
+
+// imagesEnv exposes the tag of each built image with an environment variable.
+// For example, the tag of `gcr.io/k8s-skaffold/leeroy-web` is in `SKAFFOLD_IMAGE_GCR_IO_K8S_SKAFFOLD_LEEROY_WEB`.
+func imagesEnv(builds []build.Artifact) map[string]string {
+	env := map[string]string{}
+	for _, b := range builds {
+		env["SKAFFOLD_IMAGE_"+strings.ToUpper(nonAlphaNum.ReplaceAllString(b.ImageName, "_"))] = b.Tag
+	}
+	return env
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// ContainerExec runs a command in each of the containers the files are synced to.
+type ContainerExec func(ctx context.Context, out io.Writer, command []string) error
+
+// SyncRunner runs the lifecycle hooks of the file sync of an artifact.
+type SyncRunner struct {
+	hooks     latest.SyncHooks
+	imageName string
+	workspace string
+	env       map[string]string
+}
+
+// NewSyncRunner returns a runner for the sync hooks of an artifact.
+func NewSyncRunner(artifact *latest.Artifact, image string, addedOrModified, deleted []string) SyncRunner {
+	if artifact == nil || artifact.Sync == nil {
+		return SyncRunner{}
+	}
+
+	return SyncRunner{
+		hooks:     artifact.Sync.LifecycleHooks,
+		imageName: artifact.ImageName,
+		workspace: artifact.Workspace,
+		env: map[string]string{
+			"SKAFFOLD_IMAGE":                   image,
+			"SKAFFOLD_BUILD_CONTEXT":           artifact.Workspace,
+			"SKAFFOLD_FILES_ADDED_OR_MODIFIED": strings.Join(addedOrModified, ","),
+			"SKAFFOLD_FILES_DELETED":           strings.Join(deleted, ","),
+		},
+	}
+}
+
+// RunPreHooks runs the hooks that come before the files are synced.
+func (r SyncRunner) RunPreHooks(ctx context.Context, out io.Writer, exec ContainerExec) error {
+	if len(r.hooks.PreHooks) == 0 {
+		return nil
+	}
+	color.Default.Fprintf(out, "Starting pre-sync hooks for artifact %q...\n", r.imageName)
+	return r.run(ctx, out, r.hooks.PreHooks, exec)
+}
+
+// RunPostHooks runs the hooks that come after the files are synced.
+func (r SyncRunner) RunPostHooks(ctx context.Context, out io.Writer, exec ContainerExec) error {
+	if len(r.hooks.PostHooks) == 0 {
+		return nil
+	}
+	color.Default.Fprintf(out, "Starting post-sync hooks for artifact %q...\n", r.imageName)
+	return r.run(ctx, out, r.hooks.PostHooks, exec)
+}
+
+func (r SyncRunner) run(ctx context.Context, out io.Writer, hooks []latest.SyncHookItem, exec ContainerExec) error {
+	for _, h := range hooks {
+		switch {
+		case h.HostHook != nil:
+			if err := runHostHook(ctx, out, *h.HostHook, r.workspace, r.env); err != nil {
+				return err
+			}
+
+		case h.ContainerHook != nil:
+			command, err := expandCommand(h.ContainerHook.Command, r.env)
+			if err != nil {
+				return err
+			}
+			if err := exec(ctx, out, command); err != nil {
+				return fmt.Errorf("running container hook %v: %w", h.ContainerHook.Command, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncHooks(t *testing.T) {
+	tests := []struct {
+		description string
+		hooks       latest.SyncHooks
+		commands    util.Command
+		execErr     error
+		expected    [][]string
+		shouldErr   bool
+	}{
+		{
+			description: "no hooks",
+		},
+		{
+			description: "host and container hooks",
+			hooks: latest.SyncHooks{
+				PreHooks: []latest.SyncHookItem{{HostHook: &latest.HostHook{Command: []string{"npm", "run", "lint"}}}},
+				PostHooks: []latest.SyncHookItem{
+					{ContainerHook: &latest.ContainerHook{Command: []string{"nginx", "-s", "reload"}}},
+					{ContainerHook: &latest.ContainerHook{Command: []string{"echo", "{{.SKAFFOLD_FILES_ADDED_OR_MODIFIED}}"}}},
+				},
+			},
+			commands: testutil.CmdRunEnv("npm run lint", []string{
+				"SKAFFOLD_BUILD_CONTEXT=app",
+				"SKAFFOLD_FILES_ADDED_OR_MODIFIED=index.html,style.css",
+				"SKAFFOLD_FILES_DELETED=old.html",
+				"SKAFFOLD_IMAGE=app:v1",
+			}),
+			expected: [][]string{{"nginx", "-s", "reload"}, {"echo", "index.html,style.css"}},
+		},
+		{
+			description: "failing container hook",
+			hooks: latest.SyncHooks{
+				PreHooks: []latest.SyncHookItem{{ContainerHook: &latest.ContainerHook{Command: []string{"bundle", "install"}}}},
+			},
+			execErr:   errors.New("BUG"),
+			expected:  [][]string{{"bundle", "install"}},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&util.OSEnviron, func() []string { return nil })
+
+			var executed [][]string
+			exec := func(_ context.Context, _ io.Writer, command []string) error {
+				executed = append(executed, command)
+				return test.execErr
+			}
+			artifact := &latest.Artifact{ImageName: "app", Workspace: "app", Sync: &latest.Sync{LifecycleHooks: test.hooks}}
+			runner := NewSyncRunner(artifact, "app:v1", []string{"index.html", "style.css"}, []string{"old.html"})
+
+			err := runner.RunPreHooks(context.Background(), ioutil.Discard, exec)
+			if err == nil {
+				err = runner.RunPostHooks(context.Background(), ioutil.Discard, exec)
+			}
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, executed)
+		})
+	}
+}
+
+func TestSyncHooksWithoutSync(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		runner := NewSyncRunner(nil, "app:v1", nil, nil)
+
+		err := runner.RunPreHooks(context.Background(), ioutil.Discard, nil)
+
+		t.CheckNoError(err)
+	})
+}
@@ -30,8 +30,9 @@ import (
 
 // for tests
 var (
-	Client        = getClientset
-	DynamicClient = getDynamicClient
+	Client           = getClientset
+	ClientForContext = getClientsetForContext
+	DynamicClient    = getDynamicClient
 )
 
 func getClientset() (kubernetes.Interface, error) {
@@ -42,6 +43,23 @@ func getClientset() (kubernetes.Interface, error) {
 	return kubernetes.NewForConfig(config)
 }
 
+func getClientsetForContext(kubeContext string) (kubernetes.Interface, error) {
+	config, err := context.GetRestClientConfigForContext(kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// ForKubeContext returns a client for the given kube-context,
+// or for the current one when kubeContext is empty.
+func ForKubeContext(kubeContext string) (kubernetes.Interface, error) {
+	if kubeContext == "" {
+		return Client()
+	}
+	return ClientForContext(kubeContext)
+}
+
 func getDynamicClient() (dynamic.Interface, error) {
 	config, err := context.GetRestClientConfig()
 	if err != nil {
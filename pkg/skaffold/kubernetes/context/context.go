@@ -70,6 +70,12 @@ func GetRestClientConfig() (*restclient.Config, error) {
 	return getRestClientConfig(kubeContext, kubeConfigFile)
 }
 
+// GetRestClientConfigForContext returns a REST client config for API calls against the
+// Kubernetes API of the given kubeContext, rather than the current one.
+func GetRestClientConfigForContext(kctx string) (*restclient.Config, error) {
+	return getRestClientConfig(kctx, kubeConfigFile)
+}
+
 // GetClusterInfo returns the Cluster information for the given kubeContext
 func GetClusterInfo(kctx string) (*clientcmdapi.Cluster, error) {
 	rawConfig, err := getCurrentConfig()
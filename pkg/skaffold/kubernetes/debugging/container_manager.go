@@ -40,11 +40,11 @@ type ContainerManager struct {
 	events     chan kubernetes.PodEvent
 }
 
-func NewContainerManager(podSelector kubernetes.PodSelector, namespaces []string) *ContainerManager {
+func NewContainerManager(podSelector kubernetes.PodSelector, namespaces func() []string) *ContainerManager {
 	// Create the channel here as Stop() may be called before Start() when a build fails, thus
 	// avoiding the possibility of closing a nil channel. Channels are cheap.
 	return &ContainerManager{
-		podWatcher: kubernetes.NewPodWatcher(podSelector, namespaces),
+		podWatcher: kubernetes.NewPodWatcher("", podSelector, namespaces),
 		active:     map[string]string{},
 		events:     make(chan kubernetes.PodEvent),
 	}
@@ -18,11 +18,15 @@ package kubernetes
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"text/template"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -35,31 +39,55 @@ import (
 
 // LogAggregator aggregates the logs for all the deployed pods.
 type LogAggregator struct {
+	// KubeContext labels the log lines, when the application is deployed to several kube-contexts.
+	KubeContext string
+
 	output      io.Writer
 	kubectlcli  *kubectl.CLI
 	config      latest.LogsConfig
 	podWatcher  PodWatcher
 	colorPicker ColorPicker
+	include     []*regexp.Regexp
+	exclude     []*regexp.Regexp
 
 	muted             int32
 	sinceTime         time.Time
 	events            chan PodEvent
 	trackedContainers trackedContainers
 	outputLock        sync.Mutex
+	stopWatchers      []func()
+	stopWatchersLock  sync.Mutex
 }
 
 // NewLogAggregator creates a new LogAggregator for a given output.
-func NewLogAggregator(out io.Writer, cli *kubectl.CLI, imageNames []string, podSelector PodSelector, namespaces []string, config latest.LogsConfig) *LogAggregator {
+// The pods are watched in the given kube-context, or in the current one when it's empty.
+func NewLogAggregator(out io.Writer, cli *kubectl.CLI, kubeContext string, imageNames []string, podSelector PodSelector, namespaces func() []string, config latest.LogsConfig) *LogAggregator {
 	return &LogAggregator{
 		output:      out,
 		kubectlcli:  cli,
 		config:      config,
-		podWatcher:  NewPodWatcher(podSelector, namespaces),
+		podWatcher:  NewPodWatcher(kubeContext, podSelector, namespaces),
 		colorPicker: NewColorPicker(imageNames),
+		include:     compilePatterns(config.Include),
+		exclude:     compilePatterns(config.Exclude),
 		events:      make(chan PodEvent),
 	}
 }
 
+// compilePatterns compiles the log filters, skipping the invalid ones.
+func compilePatterns(patterns []string) []*regexp.Regexp {
+	var regexps []*regexp.Regexp
+	for _, p := range patterns {
+		r, err := regexp.Compile(p)
+		if err != nil {
+			logrus.Warnf("ignoring invalid log filter %q: %v", p, err)
+			continue
+		}
+		regexps = append(regexps, r)
+	}
+	return regexps
+}
+
 func (a *LogAggregator) SetSince(t time.Time) {
 	if a == nil {
 		// Logs are not activated.
@@ -105,6 +133,10 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 						continue
 					}
 
+					if !a.shouldTail(pod, c) {
+						continue
+					}
+
 					if !a.trackedContainers.add(c.ContainerID) {
 						go a.streamContainerLogs(ctx, pod, c)
 					}
@@ -116,6 +148,26 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 	return nil
 }
 
+// WatchNewNamespaces starts tailing the logs of pods
+// in namespaces that were added since the logger was started.
+func (a *LogAggregator) WatchNewNamespaces() error {
+	if a == nil {
+		// Logs are not activated.
+		return nil
+	}
+
+	stopWatcher, err := a.podWatcher.Start()
+	if err != nil {
+		return err
+	}
+
+	a.stopWatchersLock.Lock()
+	a.stopWatchers = append(a.stopWatchers, stopWatcher)
+	a.stopWatchersLock.Unlock()
+
+	return nil
+}
+
 // Stop stops the logger.
 func (a *LogAggregator) Stop() {
 	if a == nil {
@@ -123,6 +175,13 @@ func (a *LogAggregator) Stop() {
 		return
 	}
 
+	a.stopWatchersLock.Lock()
+	for _, stop := range a.stopWatchers {
+		stop()
+	}
+	a.stopWatchers = nil
+	a.stopWatchersLock.Unlock()
+
 	close(a.events)
 }
 
@@ -174,22 +233,77 @@ func (a *LogAggregator) printLogLine(headerColor color.Color, prefix, text strin
 	}
 }
 
+// shouldTail checks the name of the pod and of the container against the log filters.
+func (a *LogAggregator) shouldTail(pod *v1.Pod, container v1.ContainerStatus) bool {
+	name := pod.Name + "/" + container.Name
+
+	for _, r := range a.exclude {
+		if r.MatchString(name) {
+			return false
+		}
+	}
+
+	if len(a.include) == 0 {
+		return true
+	}
+	for _, r := range a.include {
+		if r.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string {
+	var prefix string
 	switch a.config.Prefix {
 	case "auto":
 		if pod.Name != container.Name {
-			return podAndContainerPrefix(pod, container)
+			prefix = podAndContainerPrefix(pod, container)
+		} else {
+			prefix = autoPrefix(pod, container)
 		}
-		return autoPrefix(pod, container)
 	case "container":
-		return containerPrefix(container)
+		prefix = containerPrefix(container)
 	case "podAndContainer":
-		return podAndContainerPrefix(pod, container)
+		prefix = podAndContainerPrefix(pod, container)
 	case "none":
-		return ""
 	default:
+		if strings.Contains(a.config.Prefix, "{{") {
+			return templatePrefix(a.config.Prefix, a.KubeContext, pod, container)
+		}
 		panic("unsupported prefix: " + a.config.Prefix)
 	}
+
+	if a.KubeContext == "" {
+		return prefix
+	}
+	if prefix == "" {
+		return fmt.Sprintf("[%s]", a.KubeContext)
+	}
+	return fmt.Sprintf("[%s] %s", a.KubeContext, prefix)
+}
+
+// templatePrefix executes a prefix template against the names of the pod, of the container,
+// of the namespace and of the kube-context.
+func templatePrefix(text string, kubeContext string, pod *v1.Pod, container v1.ContainerStatus) string {
+	tmpl, err := template.New("prefix").Parse(text)
+	if err != nil {
+		logrus.Warnf("invalid log prefix template %q: %v", text, err)
+		return podAndContainerPrefix(pod, container)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]string{
+		"PodName":       pod.Name,
+		"ContainerName": container.Name,
+		"Namespace":     pod.Namespace,
+		"KubeContext":   kubeContext,
+	}); err != nil {
+		logrus.Warnf("executing log prefix template %q: %v", text, err)
+		return podAndContainerPrefix(pod, container)
+	}
+	return buf.String()
 }
 
 func autoPrefix(pod *v1.Pod, container v1.ContainerStatus) string {
@@ -138,6 +138,7 @@ func TestPrefix(t *testing.T) {
 	tests := []struct {
 		description    string
 		prefix         string
+		kubeContext    string
 		pod            v1.Pod
 		container      v1.ContainerStatus
 		expectedPrefix string
@@ -184,12 +185,51 @@ func TestPrefix(t *testing.T) {
 			container:      containerWithName("hello"),
 			expectedPrefix: "",
 		},
+		{
+			description:    "template",
+			prefix:         "[{{.Namespace}}/{{.PodName}} {{.ContainerName}}]",
+			pod:            v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"}},
+			container:      containerWithName("container"),
+			expectedPrefix: "[ns/pod container]",
+		},
+		{
+			description:    "kube-context",
+			prefix:         "auto",
+			kubeContext:    "cluster1",
+			pod:            podWithName("pod"),
+			container:      containerWithName("container"),
+			expectedPrefix: "[cluster1] [pod container]",
+		},
+		{
+			description:    "kube-context without prefix",
+			prefix:         "none",
+			kubeContext:    "cluster1",
+			pod:            podWithName("pod"),
+			container:      containerWithName("container"),
+			expectedPrefix: "[cluster1]",
+		},
+		{
+			description:    "kube-context in template",
+			prefix:         "[{{.KubeContext}} {{.PodName}}]",
+			kubeContext:    "cluster1",
+			pod:            podWithName("pod"),
+			container:      containerWithName("container"),
+			expectedPrefix: "[cluster1 pod]",
+		},
+		{
+			description:    "invalid template",
+			prefix:         "{{.Unknown",
+			pod:            podWithName("pod"),
+			container:      containerWithName("container"),
+			expectedPrefix: "[pod container]",
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			logger := NewLogAggregator(nil, nil, nil, nil, nil, latest.LogsConfig{
+			logger := NewLogAggregator(nil, nil, "", nil, nil, nil, latest.LogsConfig{
 				Prefix: test.prefix,
 			})
+			logger.KubeContext = test.kubeContext
 
 			p := logger.prefix(&test.pod, test.container)
 
@@ -198,6 +238,79 @@ func TestPrefix(t *testing.T) {
 	}
 }
 
+func TestShouldTail(t *testing.T) {
+	tests := []struct {
+		description string
+		include     []string
+		exclude     []string
+		pod         v1.Pod
+		container   v1.ContainerStatus
+		expected    bool
+	}{
+		{
+			description: "no filter",
+			pod:         podWithName("pod"),
+			container:   containerWithName("istio-proxy"),
+			expected:    true,
+		},
+		{
+			description: "excluded container",
+			exclude:     []string{"/istio-proxy$"},
+			pod:         podWithName("pod"),
+			container:   containerWithName("istio-proxy"),
+			expected:    false,
+		},
+		{
+			description: "not excluded container",
+			exclude:     []string{"/istio-proxy$"},
+			pod:         podWithName("pod"),
+			container:   containerWithName("app"),
+			expected:    true,
+		},
+		{
+			description: "included pod",
+			include:     []string{"^frontend-"},
+			pod:         podWithName("frontend-1234"),
+			container:   containerWithName("app"),
+			expected:    true,
+		},
+		{
+			description: "not included pod",
+			include:     []string{"^frontend-"},
+			pod:         podWithName("backend-1234"),
+			container:   containerWithName("app"),
+			expected:    false,
+		},
+		{
+			description: "exclude takes precedence",
+			include:     []string{"^frontend-"},
+			exclude:     []string{"istio-proxy"},
+			pod:         podWithName("frontend-1234"),
+			container:   containerWithName("istio-proxy"),
+			expected:    false,
+		},
+		{
+			description: "invalid filter is ignored",
+			exclude:     []string{"[", "sidecar"},
+			pod:         podWithName("pod"),
+			container:   containerWithName("app"),
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			logger := NewLogAggregator(nil, nil, "", nil, nil, nil, latest.LogsConfig{
+				Include: test.include,
+				Exclude: test.exclude,
+			})
+
+			tail := logger.shouldTail(&test.pod, test.container)
+
+			t.CheckDeepEqual(test.expected, tail)
+		})
+	}
+}
+
 func podWithName(n string) v1.Pod {
 	return v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -88,7 +88,7 @@ type EntryManager struct {
 	entryForwarder EntryForwarder
 
 	// forwardedPorts serves as a synchronized set of ports we've forwarded.
-	forwardedPorts util.PortSet
+	forwardedPorts *util.PortSet
 
 	// forwardedResources is a map of portForwardEntry key (string) -> portForwardEntry
 	forwardedResources forwardedResources
@@ -96,10 +96,11 @@ type EntryManager struct {
 
 // NewEntryManager returns a new port forward entry manager to keep track
 // of forwarded ports and resources
-func NewEntryManager(out io.Writer, entryForwarder EntryForwarder) *EntryManager {
+func NewEntryManager(out io.Writer, forwardedPorts *util.PortSet, entryForwarder EntryForwarder) *EntryManager {
 	return &EntryManager{
 		output:         out,
 		entryForwarder: entryForwarder,
+		forwardedPorts: forwardedPorts,
 	}
 }
 
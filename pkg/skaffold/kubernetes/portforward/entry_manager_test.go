@@ -24,6 +24,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -43,7 +44,7 @@ func TestStop(t *testing.T) {
 	}, "", "", "", "", 9001, false)
 
 	fakeForwarder := newTestForwarder()
-	em := NewEntryManager(ioutil.Discard, fakeForwarder)
+	em := NewEntryManager(ioutil.Discard, &util.PortSet{}, fakeForwarder)
 	em.forwardPortForwardEntry(context.Background(), pfe1)
 	em.forwardPortForwardEntry(context.Background(), pfe2)
 
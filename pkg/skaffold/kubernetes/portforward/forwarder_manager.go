@@ -24,6 +24,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 // Forwarder is an interface that can modify and manage port-forward processes
@@ -38,13 +39,26 @@ type ForwarderManager struct {
 }
 
 // NewForwarderManager returns a new port manager which handles starting and stopping port forwarding
-func NewForwarderManager(out io.Writer, cli *kubectl.CLI, podSelector kubernetes.PodSelector, namespaces []string, label string, opts config.PortForwardOptions, userDefined []*latest.PortForwardResource) *ForwarderManager {
-	entryManager := NewEntryManager(out, NewKubectlForwarder(out, cli))
+// Only the kinds of ports selected by the port forwarding modes are forwarded.
+// The resources are looked up in the given kube-context, or in the current one when it's empty.
+func NewForwarderManager(out io.Writer, cli *kubectl.CLI, kubeContext string, podSelector kubernetes.PodSelector, namespaces func() []string, label string, runMode config.RunMode, opts config.PortForwardOptions, userDefined []*latest.PortForwardResource) *ForwarderManager {
+	forwardedPorts := &util.PortSet{}
+	entryManager := NewEntryManager(out, forwardedPorts, NewKubectlForwarder(out, cli, kubeContext, forwardedPorts))
+
+	if !opts.ForwardUser(runMode) {
+		userDefined = nil
+	}
+	forwardServices := opts.ForwardServices(runMode)
 
 	var forwarders []Forwarder
-	forwarders = append(forwarders, NewResourceForwarder(entryManager, namespaces, label, userDefined))
-	if opts.ForwardPods {
-		forwarders = append(forwarders, NewWatchingPodForwarder(entryManager, podSelector, namespaces))
+	if len(userDefined) > 0 || forwardServices {
+		forwarders = append(forwarders, NewResourceForwarder(entryManager, kubeContext, namespaces, label, userDefined, forwardServices))
+	}
+	switch {
+	case opts.ForwardPods(runMode):
+		forwarders = append(forwarders, NewWatchingPodForwarder(entryManager, kubeContext, podSelector, namespaces, allPorts))
+	case opts.ForwardDebug(runMode):
+		forwarders = append(forwarders, NewWatchingPodForwarder(entryManager, kubeContext, podSelector, namespaces, debugPorts))
 	}
 
 	return &ForwarderManager{
@@ -18,7 +18,13 @@ package portforward
 
 import (
 	"context"
+	"io/ioutil"
 	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
 func TestForwarderManagerZeroValue(t *testing.T) {
@@ -28,3 +34,64 @@ func TestForwarderManagerZeroValue(t *testing.T) {
 	m.Start(context.Background())
 	m.Stop()
 }
+
+func TestNewForwarderManagerModes(t *testing.T) {
+	userDefined := []*latest.PortForwardResource{{Type: "pod", Name: "pod", Port: 8080}}
+
+	tests := []struct {
+		description      string
+		modes            []string
+		mode             config.RunMode
+		expectedResource bool
+		expectedServices bool
+		expectedPods     bool
+	}{
+		{
+			description:      "user and services",
+			modes:            []string{"true"},
+			mode:             config.RunModes.Dev,
+			expectedResource: true,
+			expectedServices: true,
+		},
+		{
+			description:      "user only",
+			modes:            []string{"user"},
+			mode:             config.RunModes.Dev,
+			expectedResource: true,
+		},
+		{
+			description:  "debug ports",
+			modes:        []string{"debug"},
+			mode:         config.RunModes.Debug,
+			expectedPods: true,
+		},
+		{
+			description:  "all pod ports",
+			modes:        []string{"pods"},
+			mode:         config.RunModes.Dev,
+			expectedPods: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			m := NewForwarderManager(ioutil.Discard, nil, "", kubernetes.NewImageList(), func() []string { return []string{} }, "", test.mode, config.PortForwardOptions{Modes: test.modes}, userDefined)
+
+			var resourceForwarder *ResourceForwarder
+			var podForwarder *WatchingPodForwarder
+			for _, f := range m.forwarders {
+				switch f := f.(type) {
+				case *ResourceForwarder:
+					resourceForwarder = f
+				case *WatchingPodForwarder:
+					podForwarder = f
+				}
+			}
+
+			t.CheckDeepEqual(test.expectedResource, resourceForwarder != nil)
+			if resourceForwarder != nil {
+				t.CheckDeepEqual(test.expectedServices, resourceForwarder.forwardServices)
+			}
+			t.CheckDeepEqual(test.expectedPods, podForwarder != nil)
+		})
+	}
+}
@@ -47,13 +47,22 @@ type EntryForwarder interface {
 type KubectlForwarder struct {
 	out     io.Writer
 	kubectl *kubectl.CLI
+
+	// kubeContext is used to find the pods of services, the current one when empty.
+	kubeContext string
+
+	// forwardedPorts are the local ports brokered by the entry manager.
+	// Another one is picked from them when the local port of an entry is taken.
+	forwardedPorts *util.PortSet
 }
 
 // NewKubectlForwarder returns a new KubectlForwarder
-func NewKubectlForwarder(out io.Writer, cli *kubectl.CLI) *KubectlForwarder {
+func NewKubectlForwarder(out io.Writer, cli *kubectl.CLI, kubeContext string, forwardedPorts *util.PortSet) *KubectlForwarder {
 	return &KubectlForwarder{
-		out:     out,
-		kubectl: cli,
+		out:            out,
+		kubectl:        cli,
+		kubeContext:    kubeContext,
+		forwardedPorts: forwardedPorts,
 	}
 }
 
@@ -89,8 +98,21 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 
 		if !isPortFree(util.Loopback, pfe.localPort) {
 			//assuming that Skaffold brokered ports don't overlap, this has to be an external process that started
-			//since the dev loop kicked off. We are notifying the user in the hope that they can fix it
-			color.Red.Fprintf(k.out, "failed to port forward %v, port %d is taken, retrying...\n", pfe, pfe.localPort)
+			//since the dev loop kicked off. Move to the next free port if there's one, otherwise we are notifying
+			//the user in the hope that they can fix it
+			takenPort := pfe.localPort
+			if port := retrieveAvailablePort(pfe.resource.Address, takenPort, k.forwardedPorts); port != -1 && port != takenPort {
+				k.forwardedPorts.Delete(takenPort)
+				pfe.terminationLock.Lock()
+				pfe.localPort = port
+				pfe.terminationLock.Unlock()
+
+				color.Yellow.Fprintf(k.out, "port %d is taken, port forwarding %v on port %d instead\n", takenPort, pfe, port)
+				portForwardEvent(pfe)
+				continue
+			}
+
+			color.Red.Fprintf(k.out, "failed to port forward %v, port %d is taken, retrying...\n", pfe, takenPort)
 			notifiedUser = true
 			time.Sleep(waitPortNotFree)
 			continue
@@ -104,7 +126,7 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 		ctx, cancel := context.WithCancel(parentCtx)
 		pfe.cancel = cancel
 
-		args := portForwardArgs(ctx, pfe)
+		args := portForwardArgs(ctx, k.kubeContext, pfe)
 		var buf bytes.Buffer
 		cmd := k.kubectl.CommandWithStrictCancellation(ctx, "port-forward", args...)
 		cmd.Stdout = &buf
@@ -137,14 +159,14 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 	}
 }
 
-func portForwardArgs(ctx context.Context, pfe *portForwardEntry) []string {
+func portForwardArgs(ctx context.Context, kubeContext string, pfe *portForwardEntry) []string {
 	args := []string{"--pod-running-timeout", "1s", "--namespace", pfe.resource.Namespace}
 
 	_, disableServiceForwarding := os.LookupEnv("SKAFFOLD_DISABLE_SERVICE_FORWARDING")
 	switch {
 	case pfe.resource.Type == "service" && !disableServiceForwarding:
 		// Services need special handling: https://github.com/GoogleContainerTools/skaffold/issues/4522
-		podName, remotePort, err := findNewestPodForSvc(ctx, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
+		podName, remotePort, err := findNewestPodForSvc(ctx, kubeContext, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
 		if err == nil {
 			args = append(args, fmt.Sprintf("pod/%s", podName), fmt.Sprintf("%d:%d", pfe.localPort, remotePort))
 			break
@@ -212,8 +234,8 @@ func (*KubectlForwarder) monitorErrorLogs(ctx context.Context, logs io.Reader, c
 // findNewestPodForService queries the cluster to find a pod that fulfills the given service, giving
 // preference to pods that were most recently created.  This is in contrast to the selection algorithm
 // used by kubectl (see https://github.com/GoogleContainerTools/skaffold/issues/4522 for details).
-func findNewestPodForService(ctx context.Context, ns, serviceName string, servicePort int) (string, int, error) {
-	client, err := kubernetesclient.Client()
+func findNewestPodForService(ctx context.Context, kubeContext, ns, serviceName string, servicePort int) (string, int, error) {
+	client, err := kubernetesclient.ForKubeContext(kubeContext)
 	if err != nil {
 		return "", -1, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
@@ -37,6 +37,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -53,6 +54,9 @@ func TestUnavailablePort(t *testing.T) {
 			portFreeWG.Done()
 			return false
 		})
+		t.Override(&retrieveAvailablePort, func(_ string, port int, _ *util.PortSet) int {
+			return port
+		})
 
 		// Create a wait group that will only be
 		// fulfilled when the forward function returns
@@ -84,6 +88,37 @@ func TestUnavailablePort(t *testing.T) {
 	})
 }
 
+func TestTakenPortFallback(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&isPortFree, func(_ string, port int) bool { return port != 8080 })
+		t.Override(&retrieveAvailablePort, func(_ string, _ int, ports *util.PortSet) int {
+			ports.Set(8081)
+			return 8081
+		})
+
+		pfe := newPortForwardEntry(0, latest.PortForwardResource{Type: "pod", Name: "pod"}, "", "", "", "", 8080, false)
+
+		// Stop forwarding as soon as the new port is reported.
+		var reportedPort int
+		t.Override(&portForwardEvent, func(entry *portForwardEntry) {
+			reportedPort = entry.localPort
+			entry.terminated = true
+		})
+
+		forwardedPorts := &util.PortSet{}
+		forwardedPorts.Set(8080)
+
+		var buf bytes.Buffer
+		k := KubectlForwarder{out: &buf, forwardedPorts: forwardedPorts}
+		k.forward(context.Background(), pfe)
+
+		t.CheckDeepEqual([]int{8081}, forwardedPorts.List())
+		t.CheckDeepEqual(8081, reportedPort)
+		t.CheckDeepEqual(8081, pfe.localPort)
+		t.CheckContains("port 8080 is taken, port forwarding pod-pod--0 on port 8081 instead", buf.String())
+	})
+}
+
 func TestTerminate(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -224,11 +259,11 @@ func TestPortForwardArgs(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 			defer cancel()
 
-			t.Override(&findNewestPodForSvc, func(ctx context.Context, ns, serviceName string, servicePort int) (string, int, error) {
+			t.Override(&findNewestPodForSvc, func(ctx context.Context, kubeContext, ns, serviceName string, servicePort int) (string, int, error) {
 				return test.servicePod, test.servicePort, test.serviceErr
 			})
 
-			args := portForwardArgs(ctx, test.input)
+			args := portForwardArgs(ctx, "", test.input)
 			t.CheckDeepEqual(test.result, args)
 		})
 	}
@@ -403,7 +438,7 @@ func TestFindNewestPodForService(t *testing.T) {
 				return fake.NewSimpleClientset(test.clientResources...), test.clientErr
 			})
 
-			pod, port, err := findNewestPodForService(ctx, "", test.serviceName, test.servicePort)
+			pod, port, err := findNewestPodForService(ctx, "", "", test.serviceName, test.servicePort)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.chosenPod, pod)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.chosenPort, port)
 		})
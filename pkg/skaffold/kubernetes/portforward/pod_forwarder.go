@@ -18,6 +18,7 @@ package portforward
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -27,6 +28,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -40,20 +42,53 @@ var (
 // WatchingPodForwarder is responsible for selecting pods satisfying a certain condition and port-forwarding the exposed
 // container ports within those pods. It also tracks and manages the port-forward connections.
 type WatchingPodForwarder struct {
-	entryManager *EntryManager
-	podWatcher   kubernetes.PodWatcher
-	events       chan kubernetes.PodEvent
+	entryManager   *EntryManager
+	podWatcher     kubernetes.PodWatcher
+	events         chan kubernetes.PodEvent
+	containerPorts func(*v1.Pod, v1.Container) []v1.ContainerPort
 }
 
-// NewWatchingPodForwarder returns a struct that tracks and port-forwards pods as they are created and modified
-func NewWatchingPodForwarder(entryManager *EntryManager, podSelector kubernetes.PodSelector, namespaces []string) *WatchingPodForwarder {
+// NewWatchingPodForwarder returns a struct that tracks and port-forwards pods as they are created and modified.
+// containerPorts selects which ports of each container are forwarded.
+func NewWatchingPodForwarder(entryManager *EntryManager, kubeContext string, podSelector kubernetes.PodSelector, namespaces func() []string, containerPorts func(*v1.Pod, v1.Container) []v1.ContainerPort) *WatchingPodForwarder {
 	return &WatchingPodForwarder{
-		entryManager: entryManager,
-		podWatcher:   newPodWatcher(podSelector, namespaces),
-		events:       make(chan kubernetes.PodEvent),
+		entryManager:   entryManager,
+		podWatcher:     newPodWatcher(kubeContext, podSelector, namespaces),
+		events:         make(chan kubernetes.PodEvent),
+		containerPorts: containerPorts,
 	}
 }
 
+// allPorts selects all the ports exposed by a container.
+func allPorts(_ *v1.Pod, c v1.Container) []v1.ContainerPort {
+	return c.Ports
+}
+
+// debugPorts selects the debugging ports that `skaffold debug` set up on a container,
+// as recorded in the debug annotation of its pod.
+func debugPorts(pod *v1.Pod, c v1.Container) []v1.ContainerPort {
+	annotation, found := pod.Annotations[debug.DebugConfigAnnotation]
+	if !found {
+		return nil
+	}
+	var configs map[string]debug.ContainerDebugConfiguration
+	if err := json.Unmarshal([]byte(annotation), &configs); err != nil {
+		logrus.Warnf("unable to parse debug configuration of pod %s: %v", pod.Name, err)
+		return nil
+	}
+
+	var ports []v1.ContainerPort
+	for _, port := range c.Ports {
+		for _, debugPort := range configs[c.Name].Ports {
+			if uint32(port.ContainerPort) == debugPort {
+				ports = append(ports, port)
+				break
+			}
+		}
+	}
+	return ports
+}
+
 func (p *WatchingPodForwarder) Start(ctx context.Context) error {
 	p.podWatcher.Register(p.events)
 	stopWatcher, err := p.podWatcher.Start()
@@ -95,7 +130,7 @@ func (p *WatchingPodForwarder) Stop() {
 func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod) error {
 	ownerReference := topLevelOwnerKey(pod, pod.Kind)
 	for _, c := range pod.Spec.Containers {
-		for _, port := range c.Ports {
+		for _, port := range p.containerPorts(pod, c) {
 			// get current entry for this container
 			resource := latest.PortForwardResource{
 				Type:      constants.Pod,
@@ -141,7 +176,7 @@ func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName
 	}
 
 	// retrieve an open port on the host
-	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port, &p.entryManager.forwardedPorts)
+	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port, p.entryManager.forwardedPorts)
 
 	return entry, nil
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -408,10 +409,10 @@ func TestAutomaticPortForwardPod(t *testing.T) {
 			if test.forwarder == nil {
 				test.forwarder = newTestForwarder()
 			}
-			entryManager := NewEntryManager(ioutil.Discard, nil)
+			entryManager := NewEntryManager(ioutil.Discard, &util.PortSet{}, nil)
 			entryManager.entryForwarder = test.forwarder
 
-			p := NewWatchingPodForwarder(entryManager, kubernetes.NewImageList(), nil)
+			p := NewWatchingPodForwarder(entryManager, "", kubernetes.NewImageList(), nil, allPorts)
 			for _, pod := range test.pods {
 				err := p.portForwardPod(context.Background(), pod)
 				t.CheckError(test.shouldErr, err)
@@ -475,7 +476,7 @@ func TestStartPodForwarder(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			event.InitializeState(latest.Pipeline{}, "", true, true, true)
 			t.Override(&topLevelOwnerKey, func(metav1.Object, string) string { return "owner" })
-			t.Override(&newPodWatcher, func(kubernetes.PodSelector, []string) kubernetes.PodWatcher {
+			t.Override(&newPodWatcher, func(string, kubernetes.PodSelector, func() []string) kubernetes.PodWatcher {
 				return &fakePodWatcher{
 					events: []kubernetes.PodEvent{test.event},
 				}
@@ -485,9 +486,9 @@ func TestStartPodForwarder(t *testing.T) {
 			imageList.Add("image")
 
 			fakeForwarder := newTestForwarder()
-			entryManager := NewEntryManager(ioutil.Discard, fakeForwarder)
+			entryManager := NewEntryManager(ioutil.Discard, &util.PortSet{}, fakeForwarder)
 
-			p := NewWatchingPodForwarder(entryManager, imageList, nil)
+			p := NewWatchingPodForwarder(entryManager, "", imageList, nil, allPorts)
 			p.Start(context.Background())
 
 			// wait for the pod resource to be forwarded
@@ -520,3 +521,43 @@ func (f *fakePodWatcher) Start() (func(), error) {
 
 	return func() {}, nil
 }
+
+func TestDebugPorts(t *testing.T) {
+	container := v1.Container{
+		Name: "app",
+		Ports: []v1.ContainerPort{
+			{Name: "http", ContainerPort: 8080},
+			{Name: "jdwp", ContainerPort: 5005},
+		},
+	}
+
+	tests := []struct {
+		description string
+		annotations map[string]string
+		expected    []v1.ContainerPort
+	}{
+		{
+			description: "debug ports only",
+			annotations: map[string]string{"debug.cloud.google.com/config": `{"app":{"runtime":"jvm","ports":{"jdwp":5005}}}`},
+			expected:    []v1.ContainerPort{{Name: "jdwp", ContainerPort: 5005}},
+		},
+		{
+			description: "other container",
+			annotations: map[string]string{"debug.cloud.google.com/config": `{"other":{"runtime":"jvm","ports":{"jdwp":5005}}}`},
+		},
+		{
+			description: "not debugged",
+		},
+		{
+			description: "invalid annotation",
+			annotations: map[string]string{"debug.cloud.google.com/config": `{`},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Annotations: test.annotations}}
+
+			t.CheckDeepEqual(test.expected, debugPorts(pod, container))
+		})
+	}
+}
@@ -32,9 +32,11 @@ import (
 // services deployed by skaffold.
 type ResourceForwarder struct {
 	entryManager         *EntryManager
-	namespaces           []string
+	kubeContext          string
+	namespaces           func() []string
 	label                string
 	userDefinedResources []*latest.PortForwardResource
+	forwardServices      bool
 }
 
 var (
@@ -43,24 +45,31 @@ var (
 	retrieveServices      = retrieveServiceResources
 )
 
-// NewResourceForwarder returns a struct that tracks and port-forwards services as they are created and modified
-func NewResourceForwarder(entryManager *EntryManager, namespaces []string, label string, userDefinedResources []*latest.PortForwardResource) *ResourceForwarder {
+// NewResourceForwarder returns a struct that port-forwards user defined resources and, if forwardServices is true,
+// services as they are created and modified
+func NewResourceForwarder(entryManager *EntryManager, kubeContext string, namespaces func() []string, label string, userDefinedResources []*latest.PortForwardResource, forwardServices bool) *ResourceForwarder {
 	return &ResourceForwarder{
 		entryManager:         entryManager,
+		kubeContext:          kubeContext,
 		namespaces:           namespaces,
 		label:                label,
 		userDefinedResources: userDefinedResources,
+		forwardServices:      forwardServices,
 	}
 }
 
 // Start gets a list of services deployed by skaffold as []latest.PortForwardResource and
-// forwards them.
+// forwards them along with the user defined resources.
 func (p *ResourceForwarder) Start(ctx context.Context) error {
-	serviceResources, err := retrieveServices(p.label, p.namespaces)
-	if err != nil {
-		return fmt.Errorf("retrieving services for automatic port forwarding: %w", err)
+	resources := p.userDefinedResources
+	if p.forwardServices {
+		serviceResources, err := retrieveServices(p.kubeContext, p.label, p.namespaces())
+		if err != nil {
+			return fmt.Errorf("retrieving services for automatic port forwarding: %w", err)
+		}
+		resources = append(resources, serviceResources...)
 	}
-	p.portForwardResources(ctx, append(p.userDefinedResources, serviceResources...))
+	p.portForwardResources(ctx, resources)
 	return nil
 }
 
@@ -96,14 +105,14 @@ func (p *ResourceForwarder) getCurrentEntry(resource latest.PortForwardResource)
 	}
 
 	// retrieve an open port on the host
-	entry.localPort = retrieveAvailablePort(resource.Address, resource.LocalPort, &p.entryManager.forwardedPorts)
+	entry.localPort = retrieveAvailablePort(resource.Address, resource.LocalPort, p.entryManager.forwardedPorts)
 	return entry
 }
 
 // retrieveServiceResources retrieves all services in the cluster matching the given label
 // as a list of PortForwardResources
-func retrieveServiceResources(label string, namespaces []string) ([]*latest.PortForwardResource, error) {
-	client, err := kubernetesclient.Client()
+func retrieveServiceResources(kubeContext, label string, namespaces []string) ([]*latest.PortForwardResource, error) {
+	client, err := kubernetesclient.ForKubeContext(kubeContext)
 	if err != nil {
 		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
@@ -121,14 +121,14 @@ func TestStart(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			event.InitializeState(latest.Pipeline{}, "", true, true, true)
 			t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort("127.0.0.1", map[int]struct{}{}, test.availablePorts))
-			t.Override(&retrieveServices, func(string, []string) ([]*latest.PortForwardResource, error) {
+			t.Override(&retrieveServices, func(string, string, []string) ([]*latest.PortForwardResource, error) {
 				return test.resources, nil
 			})
 
 			fakeForwarder := newTestForwarder()
-			entryManager := NewEntryManager(ioutil.Discard, fakeForwarder)
+			entryManager := NewEntryManager(ioutil.Discard, &util.PortSet{}, fakeForwarder)
 
-			rf := NewResourceForwarder(entryManager, []string{"test"}, "", nil)
+			rf := NewResourceForwarder(entryManager, "", func() []string { return []string{"test"} }, "", nil, true)
 			if err := rf.Start(context.Background()); err != nil {
 				t.Fatalf("error starting resource forwarder: %v", err)
 			}
@@ -188,11 +188,11 @@ func TestGetCurrentEntryFunc(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort("127.0.0.1", map[int]struct{}{}, test.availablePorts))
 
-			entryManager := NewEntryManager(ioutil.Discard, newTestForwarder())
+			entryManager := NewEntryManager(ioutil.Discard, &util.PortSet{}, newTestForwarder())
 			entryManager.forwardedResources = forwardedResources{
 				resources: test.forwardedResources,
 			}
-			rf := NewResourceForwarder(entryManager, []string{"test"}, "", nil)
+			rf := NewResourceForwarder(entryManager, "", func() []string { return []string{"test"} }, "", nil, true)
 			actualEntry := rf.getCurrentEntry(test.resource)
 
 			expectedEntry := test.expected
@@ -231,14 +231,14 @@ func TestUserDefinedResources(t *testing.T) {
 	testutil.Run(t, "one service and one user defined pod", func(t *testutil.T) {
 		event.InitializeState(latest.Pipeline{}, "", true, true, true)
 		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort("127.0.0.1", map[int]struct{}{}, []int{8080, 9000}))
-		t.Override(&retrieveServices, func(string, []string) ([]*latest.PortForwardResource, error) {
+		t.Override(&retrieveServices, func(string, string, []string) ([]*latest.PortForwardResource, error) {
 			return []*latest.PortForwardResource{svc}, nil
 		})
 
 		fakeForwarder := newTestForwarder()
-		entryManager := NewEntryManager(ioutil.Discard, fakeForwarder)
+		entryManager := NewEntryManager(ioutil.Discard, &util.PortSet{}, fakeForwarder)
 
-		rf := NewResourceForwarder(entryManager, []string{"test"}, "", []*latest.PortForwardResource{pod})
+		rf := NewResourceForwarder(entryManager, "", func() []string { return []string{"test"} }, "", []*latest.PortForwardResource{pod}, true)
 		if err := rf.Start(context.Background()); err != nil {
 			t.Fatalf("error starting resource forwarder: %v", err)
 		}
@@ -346,7 +346,7 @@ func TestRetrieveServices(t *testing.T) {
 			client := fakekubeclientset.NewSimpleClientset(objs...)
 			t.Override(&kubernetesclient.Client, mockClient(client))
 
-			actual, err := retrieveServiceResources(fmt.Sprintf("%s=9876-6789", label.RunIDLabel), test.namespaces)
+			actual, err := retrieveServiceResources("", fmt.Sprintf("%s=9876-6789", label.RunIDLabel), test.namespaces)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
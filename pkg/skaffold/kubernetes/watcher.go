@@ -19,6 +19,7 @@ package kubernetes
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -34,10 +35,16 @@ type PodWatcher interface {
 }
 
 // podWatcher is a pod watcher for multiple namespaces.
+// The namespaces are read each time the watcher is started so that
+// namespaces discovered after a deploy are taken into account.
 type podWatcher struct {
+	kubeContext string
 	podSelector PodSelector
-	namespaces  []string
+	namespaces  func() []string
 	receivers   []chan<- PodEvent
+
+	watchedLock sync.Mutex
+	watched     map[string]bool
 }
 
 type PodEvent struct {
@@ -45,10 +52,14 @@ type PodEvent struct {
 	Pod  *v1.Pod
 }
 
-func NewPodWatcher(podSelector PodSelector, namespaces []string) PodWatcher {
+// NewPodWatcher creates a watcher for the pods of the given kube-context,
+// or of the current one when kubeContext is empty.
+func NewPodWatcher(kubeContext string, podSelector PodSelector, namespaces func() []string) PodWatcher {
 	return &podWatcher{
+		kubeContext: kubeContext,
 		podSelector: podSelector,
 		namespaces:  namespaces,
+		watched:     map[string]bool{},
 	}
 }
 
@@ -56,26 +67,33 @@ func (w *podWatcher) Register(receiver chan<- PodEvent) {
 	w.receivers = append(w.receivers, receiver)
 }
 
+// Start watches the pods of every namespace that isn't watched yet.
+// It can be called again after the list of namespaces was updated.
+// The returned function stops the watchers created by this call.
 func (w *podWatcher) Start() (func(), error) {
 	if len(w.receivers) == 0 {
 		return func() {}, errors.New("no receiver was registered")
 	}
 
+	namespaces := w.markWatched()
+
 	var watchers []watch.Interface
 	stopWatchers := func() {
 		for _, w := range watchers {
 			w.Stop()
 		}
+		w.unmarkWatched(namespaces)
 	}
 
-	kubeclient, err := client.Client()
+	kubeclient, err := client.ForKubeContext(w.kubeContext)
 	if err != nil {
+		stopWatchers()
 		return func() {}, fmt.Errorf("getting k8s client: %w", err)
 	}
 
 	var forever int64 = 3600 * 24 * 365 * 100
 
-	for _, ns := range w.namespaces {
+	for _, ns := range namespaces {
 		watcher, err := kubeclient.CoreV1().Pods(ns).Watch(metav1.ListOptions{
 			TimeoutSeconds: &forever,
 		})
@@ -116,3 +134,28 @@ func (w *podWatcher) Start() (func(), error) {
 
 	return stopWatchers, nil
 }
+
+// markWatched returns the namespaces that are not watched yet
+// and marks them as watched.
+func (w *podWatcher) markWatched() []string {
+	w.watchedLock.Lock()
+	defer w.watchedLock.Unlock()
+
+	var namespaces []string
+	for _, ns := range w.namespaces() {
+		if !w.watched[ns] {
+			w.watched[ns] = true
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+func (w *podWatcher) unmarkWatched(namespaces []string) {
+	w.watchedLock.Lock()
+	defer w.watchedLock.Unlock()
+
+	for _, ns := range namespaces {
+		delete(w.watched, ns)
+	}
+}
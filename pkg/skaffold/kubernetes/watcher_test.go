@@ -59,7 +59,7 @@ func (h *hasName) Select(pod *v1.Pod) bool {
 
 func TestPodWatcher(t *testing.T) {
 	testutil.Run(t, "need to register first", func(t *testutil.T) {
-		watcher := NewPodWatcher(&anyPod{}, []string{"ns"})
+		watcher := NewPodWatcher("", &anyPod{}, func() []string { return []string{"ns"} })
 		cleanup, err := watcher.Start()
 		defer cleanup()
 
@@ -69,7 +69,7 @@ func TestPodWatcher(t *testing.T) {
 	testutil.Run(t, "fail to get client", func(t *testutil.T) {
 		t.Override(&client.Client, func() (kubernetes.Interface, error) { return nil, errors.New("unable to get client") })
 
-		watcher := NewPodWatcher(&anyPod{}, []string{"ns"})
+		watcher := NewPodWatcher("", &anyPod{}, func() []string { return []string{"ns"} })
 		watcher.Register(make(chan PodEvent))
 		cleanup, err := watcher.Start()
 		defer cleanup()
@@ -77,6 +77,23 @@ func TestPodWatcher(t *testing.T) {
 		t.CheckErrorContains("unable to get client", err)
 	})
 
+	testutil.Run(t, "client of another kube-context", func(t *testutil.T) {
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return nil, errors.New("wrong client") })
+		var kubeContext string
+		t.Override(&client.ClientForContext, func(kctx string) (kubernetes.Interface, error) {
+			kubeContext = kctx
+			return fake.NewSimpleClientset(), nil
+		})
+
+		watcher := NewPodWatcher("canary", &anyPod{}, func() []string { return []string{"ns"} })
+		watcher.Register(make(chan PodEvent))
+		cleanup, err := watcher.Start()
+		defer cleanup()
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("canary", kubeContext)
+	})
+
 	testutil.Run(t, "fail to watch pods", func(t *testutil.T) {
 		clientset := fake.NewSimpleClientset()
 		t.Override(&client.Client, func() (kubernetes.Interface, error) { return clientset, nil })
@@ -85,7 +102,7 @@ func TestPodWatcher(t *testing.T) {
 			return true, nil, errors.New("unable to watch")
 		})
 
-		watcher := NewPodWatcher(&anyPod{}, []string{"ns"})
+		watcher := NewPodWatcher("", &anyPod{}, func() []string { return []string{"ns"} })
 		watcher.Register(make(chan PodEvent))
 		cleanup, err := watcher.Start()
 		defer cleanup()
@@ -101,7 +118,7 @@ func TestPodWatcher(t *testing.T) {
 			validNames: []string{"pod1", "pod2", "pod3"},
 		}
 		events := make(chan PodEvent)
-		watcher := NewPodWatcher(podSelector, []string{"ns1", "ns2"})
+		watcher := NewPodWatcher("", podSelector, func() []string { return []string{"ns1", "ns2"} })
 		watcher.Register(events)
 		cleanup, err := watcher.Start()
 		defer cleanup()
@@ -128,4 +145,28 @@ func TestPodWatcher(t *testing.T) {
 		t.CheckDeepEqual("pod2", podEvents[1].Pod.Name)
 		t.CheckDeepEqual("pod3", podEvents[2].Pod.Name)
 	})
+	testutil.Run(t, "watch new namespaces", func(t *testutil.T) {
+		clientset := fake.NewSimpleClientset()
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return clientset, nil })
+
+		var watchedNamespaces []string
+		clientset.Fake.PrependWatchReactor("pods", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			watchedNamespaces = append(watchedNamespaces, action.GetNamespace())
+			return false, nil, nil
+		})
+
+		namespaces := []string{"ns1"}
+		watcher := NewPodWatcher("", &anyPod{}, func() []string { return namespaces })
+		watcher.Register(make(chan PodEvent))
+		cleanup, err := watcher.Start()
+		defer cleanup()
+		t.CheckNoError(err)
+
+		namespaces = append(namespaces, "ns2")
+		cleanupNew, err := watcher.Start()
+		defer cleanupNew()
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual([]string{"ns1", "ns2"}, watchedNamespaces)
+	})
 }
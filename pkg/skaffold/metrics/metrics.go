@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "skaffold"
+
+// Phases of the dev loop that are timed.
+const (
+	Build  = "build"
+	Test   = "test"
+	Sync   = "sync"
+	Deploy = "deploy"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	// Builds and deploys take from seconds to minutes, syncs are expected to be much faster.
+	durations = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "phase_duration_seconds",
+		Help:      "Duration of the builds, tests, syncs and deploys, by phase.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"phase"})
+
+	runs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "phase_runs_total",
+		Help:      "Number of builds, tests, syncs and deploys, by phase.",
+	}, []string{"phase"})
+
+	failures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "phase_failures_total",
+		Help:      "Number of failed builds, tests, syncs and deploys, by phase.",
+	}, []string{"phase"})
+)
+
+func init() {
+	registry.MustRegister(durations, runs, failures, prometheus.NewGoCollector())
+}
+
+// Observe records the outcome of a phase that started at the given time.
+// Only successful runs are recorded in the duration histogram.
+func Observe(phase string, start time.Time, err error) {
+	runs.WithLabelValues(phase).Inc()
+	if err != nil {
+		failures.WithLabelValues(phase).Inc()
+		return
+	}
+	durations.WithLabelValues(phase).Observe(time.Since(start).Seconds())
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestObserve(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		Observe("test-phase", time.Now().Add(-3*time.Second), nil)
+		Observe("test-phase", time.Now(), errors.New("BUG"))
+
+		server := httptest.NewServer(Handler())
+		defer server.Close()
+
+		resp, err := server.Client().Get(server.URL)
+		t.CheckNoError(err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		t.CheckNoError(err)
+
+		t.CheckContains(`skaffold_phase_runs_total{phase="test-phase"} 2`, string(body))
+		t.CheckContains(`skaffold_phase_failures_total{phase="test-phase"} 1`, string(body))
+		t.CheckContains(`skaffold_phase_duration_seconds_count{phase="test-phase"} 1`, string(body))
+		t.CheckContains(`skaffold_phase_duration_seconds_bucket{phase="test-phase",le="2.5"} 0`, string(body))
+		t.CheckContains(`skaffold_phase_duration_seconds_bucket{phase="test-phase",le="5"} 1`, string(body))
+	})
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// For testing
+var timeNow = time.Now
+
+// Record is a line of output in the JSON output format.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	Phase     string    `json:"phase,omitempty"`
+	Artifact  string    `json:"artifact,omitempty"`
+	Payload   string    `json:"payload"`
+	Error     *Error    `json:"error,omitempty"`
+}
+
+// Error details the failure that ended a run, in the last Record.
+type Error struct {
+	Code        string   `json:"code"`
+	ExitCode    int      `json:"exitCode"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// jsonWriter wraps each line written to it in a JSON Record.
+// Writers derived with WithPhase and WithArtifact share the same output.
+type jsonWriter struct {
+	shared   *sharedOutput
+	phase    string
+	artifact string
+}
+
+// source identifies the writers whose incomplete lines are buffered together.
+type source struct {
+	phase    string
+	artifact string
+}
+
+type sharedOutput struct {
+	sync.Mutex
+	out io.Writer
+
+	// partials are the incomplete lines, in the order they were started.
+	partials map[source][]byte
+	pending  []source
+}
+
+// NewJSONWriter returns a writer that prints each line as a JSON Record to out.
+func NewJSONWriter(out io.Writer) io.Writer {
+	return &jsonWriter{shared: &sharedOutput{out: out, partials: map[source][]byte{}}}
+}
+
+// WithPhase returns a writer that records the given phase.
+// Writers that don't produce JSON records are returned as is.
+func WithPhase(out io.Writer, phase string) io.Writer {
+	w, ok := out.(*jsonWriter)
+	if !ok {
+		return out
+	}
+	return &jsonWriter{shared: w.shared, phase: phase, artifact: w.artifact}
+}
+
+// WithArtifact returns a writer that records the given artifact.
+// Writers that don't produce JSON records are returned as is.
+func WithArtifact(out io.Writer, artifact string) io.Writer {
+	w, ok := out.(*jsonWriter)
+	if !ok {
+		return out
+	}
+	return &jsonWriter{shared: w.shared, phase: w.phase, artifact: artifact}
+}
+
+func (w *jsonWriter) Write(p []byte) (int, error) {
+	w.shared.Lock()
+	defer w.shared.Unlock()
+
+	src := source{phase: w.phase, artifact: w.artifact}
+	partial, found := w.shared.partials[src]
+	if !found {
+		w.shared.pending = append(w.shared.pending, src)
+	}
+
+	partial = append(partial, p...)
+	for {
+		i := bytes.IndexByte(partial, '\n')
+		if i < 0 {
+			w.shared.partials[src] = partial
+			return len(p), nil
+		}
+
+		line := partial[:i]
+		partial = partial[i+1:]
+		if err := w.writeLine(src, line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Flush prints the Records of the lines that were not terminated by a newline.
+// It should be called before exiting so that no output is lost.
+// Writers that don't produce JSON records print nothing.
+func Flush(out io.Writer) error {
+	w, ok := out.(*jsonWriter)
+	if !ok {
+		return nil
+	}
+
+	w.shared.Lock()
+	defer w.shared.Unlock()
+
+	pending := w.shared.pending
+	w.shared.pending = nil
+	for _, src := range pending {
+		partial := w.shared.partials[src]
+		delete(w.shared.partials, src)
+		if len(partial) == 0 {
+			continue
+		}
+		if err := w.writeLine(src, partial); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *jsonWriter) writeLine(src source, line []byte) error {
+	return w.writeRecord(Record{
+		Phase:    src.phase,
+		Artifact: src.artifact,
+		Payload:  string(bytes.TrimSuffix(line, []byte("\r"))),
+	})
+}
+
+// WriteError prints the Record of the error that ended a run, so that wrappers and IDEs can present fixes.
+// Writers that don't produce JSON records print nothing since the error is already printed to stderr.
+func WriteError(out io.Writer, record Record) error {
+	w, ok := out.(*jsonWriter)
+	if !ok {
+		return nil
+	}
+
+	w.shared.Lock()
+	defer w.shared.Unlock()
+	return w.writeRecord(record)
+}
+
+func (w *jsonWriter) writeRecord(record Record) error {
+	record.Timestamp = timeNow()
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(record); err != nil {
+		return err
+	}
+
+	_, err := buf.WriteTo(w.shared.out)
+	return err
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestJSONWriter(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&timeNow, func() time.Time { return time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC) })
+
+		var buf bytes.Buffer
+		out := NewJSONWriter(&buf)
+		fmt.Fprintln(out, "Generating tags...")
+		build := WithPhase(out, "Build")
+		fmt.Fprint(build, " - image -> ")
+		fmt.Fprintln(build, "image:tag")
+		fmt.Fprint(WithArtifact(build, "image"), "Step 1/2\nStep 2/2\n")
+		fmt.Fprint(build, "no newline")
+		err := Flush(out)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`{"timestamp":"2020-10-01T12:00:00Z","payload":"Generating tags..."}
+{"timestamp":"2020-10-01T12:00:00Z","phase":"Build","payload":" - image -> image:tag"}
+{"timestamp":"2020-10-01T12:00:00Z","phase":"Build","artifact":"image","payload":"Step 1/2"}
+{"timestamp":"2020-10-01T12:00:00Z","phase":"Build","artifact":"image","payload":"Step 2/2"}
+{"timestamp":"2020-10-01T12:00:00Z","phase":"Build","payload":"no newline"}
+`, buf.String())
+	})
+}
+
+func TestFlush(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&timeNow, func() time.Time { return time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC) })
+
+		var buf bytes.Buffer
+		out := NewJSONWriter(&buf)
+		fmt.Fprint(WithArtifact(out, "app"), "Step 1/2")
+		fmt.Fprint(WithArtifact(out, "db"), "Step 1/3")
+		fmt.Fprint(WithArtifact(out, "app"), "...")
+		fmt.Fprint(WithArtifact(out, "db"), " done\n")
+
+		t.CheckNoError(Flush(WithPhase(out, "Deploy")))
+		t.CheckNoError(Flush(out))
+		t.CheckDeepEqual(`{"timestamp":"2020-10-01T12:00:00Z","artifact":"db","payload":"Step 1/3 done"}
+{"timestamp":"2020-10-01T12:00:00Z","artifact":"app","payload":"Step 1/2..."}
+`, buf.String())
+	})
+}
+
+func TestFlushNotJSON(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var buf bytes.Buffer
+
+		t.CheckNoError(Flush(&buf))
+		t.CheckEmpty(buf.String())
+	})
+}
+
+func TestWriteError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&timeNow, func() time.Time { return time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC) })
+
+		var buf bytes.Buffer
+		err := WriteError(NewJSONWriter(&buf), Record{
+			Phase:   "Build",
+			Payload: "no push access",
+			Error: &Error{
+				Code:        "BUILD_PUSH_ACCESS_DENIED",
+				ExitCode:    111,
+				Suggestions: []string{"try `gcloud auth configure-docker`"},
+			},
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`{"timestamp":"2020-10-01T12:00:00Z","phase":"Build","payload":"no push access","error":{"code":"BUILD_PUSH_ACCESS_DENIED","exitCode":111,"suggestions":["try `+"`gcloud auth configure-docker`"+`"]}}
+`, buf.String())
+	})
+}
+
+func TestWriteErrorNotJSON(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var buf bytes.Buffer
+
+		err := WriteError(&buf, Record{Payload: "error"})
+
+		t.CheckNoError(err)
+		t.CheckEmpty(buf.String())
+	})
+}
+
+func TestWithPhaseNotJSON(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var buf bytes.Buffer
+
+		t.CheckTrue(WithPhase(&buf, "Build") == &buf)
+		t.CheckTrue(WithArtifact(&buf, "image") == &buf)
+	})
+}
@@ -29,7 +29,11 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 )
 
 // BuildAndTest builds and tests a list of artifacts.
@@ -40,16 +44,32 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 	}
 
 	if err := checkWorkspaces(artifacts); err != nil {
-		return nil, err
+		return nil, sErrors.WithExitCode(sErrors.BuildExitCode, err)
+	}
+
+	out = output.WithPhase(out, "Build")
+
+	// In dry-run mode or with --digest-source set to 'remote', we don't build anything, just return the tag for each artifact.
+	buildImages := !r.runCtx.DryRun() && r.runCtx.DigestSource() != remoteDigestSource
+
+	// Pre-build hooks can change the sources of the artifacts, so they run before the tags and the cache keys are computed.
+	if buildImages {
+		for _, a := range artifacts {
+			if err := hooks.NewBuildRunner(a, !r.imagesAreLocal).RunPreHooks(ctx, out); err != nil {
+				return nil, sErrors.WithExitCode(sErrors.BuildExitCode, fmt.Errorf("running pre-build hooks: %w", err))
+			}
+		}
 	}
 
-	tags, err := r.imageTags(ctx, out, artifacts)
+	tagCtx, endTrace := tracing.StartSpan(ctx, "Tag", nil)
+	tags, err := r.imageTags(tagCtx, out, artifacts)
+	endTrace(err)
 	if err != nil {
-		return nil, err
+		return nil, sErrors.WithExitCode(sErrors.BuildExitCode, err)
 	}
+	r.addRequiredArtifactTags(artifacts, tags)
 
-	// In dry-run mode or with --digest-source  set to 'remote', we don't build anything, just return the tag for each artifact.
-	if r.runCtx.DryRun() || (r.runCtx.DigestSource() == remoteDigestSource) {
+	if !buildImages {
 		var bRes []build.Artifact
 		for _, artifact := range artifacts {
 			bRes = append(bRes, build.Artifact{
@@ -61,6 +81,8 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 		return bRes, nil
 	}
 
+	artifacts = r.withClusterPlatforms(ctx, artifacts)
+
 	bRes, err := r.cache.Build(ctx, out, tags, artifacts, func(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]build.Artifact, error) {
 		if len(artifacts) == 0 {
 			return nil, nil
@@ -70,12 +92,23 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 
 		bRes, err := r.builder.Build(ctx, out, tags, artifacts)
 		if err != nil {
-			return nil, err
+			return nil, sErrors.WithExitCode(sErrors.BuildExitCode, err)
+		}
+
+		for _, a := range artifacts {
+			for _, b := range bRes {
+				if b.ImageName != a.ImageName {
+					continue
+				}
+				if err := hooks.NewBuildRunner(a, !r.imagesAreLocal).RunPostHooks(ctx, out, b.Tag); err != nil {
+					return nil, sErrors.WithExitCode(sErrors.BuildExitCode, fmt.Errorf("running post-build hooks: %w", err))
+				}
+			}
 		}
 
 		if !r.runCtx.SkipTests() {
-			if err = r.tester.Test(ctx, out, bRes); err != nil {
-				return nil, err
+			if err = r.tester.Test(ctx, output.WithPhase(out, "Test"), bRes); err != nil {
+				return nil, sErrors.WithExitCode(sErrors.TestExitCode, err)
 			}
 		}
 
@@ -94,6 +127,23 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 	return bRes, nil
 }
 
+// addRequiredArtifactTags adds the tags of the artifacts that are required but not rebuilt, so that
+// the artifacts that depend on them are built from their latest build.
+func (r *SkaffoldRunner) addRequiredArtifactTags(artifacts []*latest.Artifact, tags tag.ImageTags) {
+	for _, a := range artifacts {
+		for _, d := range a.Dependencies {
+			if _, found := tags[d.ImageName]; found {
+				continue
+			}
+			for _, b := range r.builds {
+				if b.ImageName == d.ImageName {
+					tags[d.ImageName] = b.Tag
+				}
+			}
+		}
+	}
+}
+
 // DeployAndLog deploys a list of already built artifacts and optionally show the logs.
 func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
 	// Update which images are logged.
@@ -122,6 +172,8 @@ func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifa
 		return fmt.Errorf("starting logger: %w", err)
 	}
 
+	r.printTimings(out)
+
 	if r.runCtx.Tail() || r.runCtx.PortForward() {
 		color.Yellow.Fprintln(out, "Press Ctrl+C to exit")
 		<-ctx.Done()
@@ -147,6 +199,14 @@ func (r *SkaffoldRunner) ApplyDefaultRepo(tag string) (string, error) {
 	return deployutil.ApplyDefaultRepo(r.runCtx.GlobalConfig(), r.runCtx.DefaultRepo(), tag)
 }
 
+// taggerFor returns the tagger of an artifact, that can override the pipeline's tagger.
+func (r *SkaffoldRunner) taggerFor(a *latest.Artifact) tag.Tagger {
+	if t, found := r.artifactTaggers[a.ImageName]; found {
+		return t
+	}
+	return r.tagger
+}
+
 // imageTags generates tags for a list of artifacts
 func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) (tag.ImageTags, error) {
 	start := time.Now()
@@ -159,7 +219,7 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 
 		i := i
 		go func() {
-			tag, err := tag.GenerateFullyQualifiedImageName(r.tagger, artifacts[i].Workspace, artifacts[i].ImageName)
+			tag, err := tag.GenerateFullyQualifiedImageName(r.taggerFor(artifacts[i]), artifacts[i].Workspace, artifacts[i].ImageName)
 			tagErrs[i] <- tagErr{tag: tag, err: err}
 		}()
 	}
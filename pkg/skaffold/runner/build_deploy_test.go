@@ -25,17 +25,20 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
 func TestBuildTestDeploy(t *testing.T) {
 	tests := []struct {
-		description     string
-		testBench       *TestBench
-		shouldErr       bool
-		expectedActions []Actions
+		description      string
+		testBench        *TestBench
+		shouldErr        bool
+		expectedActions  []Actions
+		expectedExitCode int
 	}{
 		{
 			description: "run no error",
@@ -47,10 +50,11 @@ func TestBuildTestDeploy(t *testing.T) {
 			}},
 		},
 		{
-			description:     "run build error",
-			testBench:       &TestBench{buildErrors: []error{errors.New("")}},
-			shouldErr:       true,
-			expectedActions: []Actions{{}},
+			description:      "run build error",
+			testBench:        &TestBench{buildErrors: []error{errors.New("")}},
+			shouldErr:        true,
+			expectedActions:  []Actions{{}},
+			expectedExitCode: sErrors.BuildExitCode,
 		},
 		{
 			description: "run test error",
@@ -59,6 +63,7 @@ func TestBuildTestDeploy(t *testing.T) {
 			expectedActions: []Actions{{
 				Built: []string{"img:1"},
 			}},
+			expectedExitCode: sErrors.TestExitCode,
 		},
 		{
 			description: "run deploy error",
@@ -68,6 +73,7 @@ func TestBuildTestDeploy(t *testing.T) {
 				Built:  []string{"img:1"},
 				Tested: []string{"img:1"},
 			}},
+			expectedExitCode: sErrors.DeployExitCode,
 		},
 	}
 	for _, test := range tests {
@@ -87,6 +93,11 @@ func TestBuildTestDeploy(t *testing.T) {
 			}
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedActions, test.testBench.Actions())
+			if test.shouldErr {
+				var exitCoder interface{ ExitCode() int }
+				t.CheckTrue(errors.As(err, &exitCoder))
+				t.CheckDeepEqual(test.expectedExitCode, exitCoder.ExitCode())
+			}
 		})
 	}
 }
@@ -111,6 +122,51 @@ func TestBuildAndTestDryRun(t *testing.T) {
 	})
 }
 
+func TestBuildAndTestPreHooks(t *testing.T) {
+	tests := []struct {
+		description     string
+		dryRun          bool
+		commands        util.Command
+		shouldErr       bool
+		expectedActions []Actions
+	}{
+		{
+			description:     "pre-build hooks",
+			commands:        testutil.CmdRun("go generate ./..."),
+			expectedActions: []Actions{{Built: []string{"img:1"}, Tested: []string{"img:1"}}},
+		},
+		{
+			description:     "failing pre-build hook",
+			commands:        testutil.CmdRunErr("go generate ./...", errors.New("BUG")),
+			shouldErr:       true,
+			expectedActions: []Actions{{}},
+		},
+		{
+			description:     "no pre-build hooks in dry-run mode",
+			dryRun:          true,
+			expectedActions: []Actions{{}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testBench := &TestBench{}
+			runner := createRunner(t, testBench, nil)
+			runner.runCtx.Opts.DryRun = test.dryRun
+
+			_, err := runner.BuildAndTest(context.Background(), ioutil.Discard, []*latest.Artifact{{
+				ImageName: "img",
+				LifecycleHooks: latest.BuildHooks{
+					PreHooks: []latest.HostHook{{Command: []string{"go", "generate", "./..."}}},
+				},
+			}})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedActions, testBench.Actions())
+		})
+	}
+}
+
 func TestBuildAndTestSkipBuild(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		testBench := &TestBench{}
@@ -26,6 +26,8 @@ type changeSet struct {
 	rebuildTracker map[string]*latest.Artifact
 	needsResync    []*sync.Item
 	resyncTracker  map[string]*sync.Item
+	needsRetest    []*latest.Artifact
+	retestTracker  map[string]*latest.Artifact
 	needsRedeploy  bool
 	needsReload    bool
 }
@@ -43,6 +45,19 @@ func (c *changeSet) AddRebuild(a *latest.Artifact) {
 	c.needsRedeploy = true
 }
 
+func (c *changeSet) AddRetest(a *latest.Artifact) {
+	if _, ok := c.retestTracker[a.ImageName]; ok {
+		return
+	}
+
+	if c.retestTracker == nil {
+		c.retestTracker = map[string]*latest.Artifact{}
+	}
+	c.retestTracker[a.ImageName] = a
+	c.needsRetest = append(c.needsRetest, a)
+	c.needsRedeploy = true
+}
+
 func (c *changeSet) AddResync(s *sync.Item) {
 	if _, ok := c.resyncTracker[s.Image]; ok {
 		return
@@ -65,6 +80,11 @@ func (c *changeSet) resetSync() {
 	c.needsResync = nil
 }
 
+func (c *changeSet) resetTest() {
+	c.retestTracker = make(map[string]*latest.Artifact)
+	c.needsRetest = nil
+}
+
 func (c *changeSet) resetDeploy() {
 	c.needsRedeploy = false
 }
@@ -22,9 +22,9 @@ import (
 )
 
 func (r *SkaffoldRunner) createContainerManager() *debugging.ContainerManager {
-	if r.runCtx.Mode() != config.RunModes.Debug {
+	if r.runCtx.Mode() != config.RunModes.Debug || !r.runCtx.DeploysToKubernetes() {
 		return nil
 	}
 
-	return debugging.NewContainerManager(r.podSelector, r.runCtx.GetNamespaces())
+	return debugging.NewContainerManager(r.podSelector, r.runCtx.GetNamespaces)
 }
@@ -29,9 +29,13 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 )
 
 func (r *SkaffoldRunner) Deploy(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
@@ -39,6 +43,8 @@ func (r *SkaffoldRunner) Deploy(ctx context.Context, out io.Writer, artifacts []
 		return r.Render(ctx, out, artifacts, false, r.runCtx.RenderOutput())
 	}
 
+	out = output.WithPhase(out, "Deploy")
+
 	color.Default.Fprintln(out, "Tags used in deployment:")
 
 	for _, artifact := range artifacts {
@@ -52,20 +58,29 @@ They are tagged and referenced by a unique, local only, tag instead.
 See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
+	deploysToKubernetes := r.runCtx.DeploysToKubernetes()
+
 	// Check that the cluster is reachable.
 	// This gives a better error message when the cluster can't
 	// be reached.
-	if err := failIfClusterIsNotReachable(); err != nil {
-		return fmt.Errorf("unable to connect to Kubernetes: %w", err)
+	if deploysToKubernetes {
+		if err := failIfClusterIsNotReachable(); err != nil {
+			return sErrors.WithExitCode(sErrors.DeployExitCode, fmt.Errorf("unable to connect to Kubernetes: %w", err))
+		}
 	}
 
-	if r.imagesAreLocal && config.IsImageLoadingRequired(r.runCtx.GetKubeContext()) {
+	if deploysToKubernetes && r.imagesAreLocal && config.IsImageLoadingRequired(r.runCtx.GetKubeContext()) {
 		err := r.loadImagesIntoCluster(ctx, out, artifacts)
 		if err != nil {
-			return err
+			return sErrors.WithExitCode(sErrors.DeployExitCode, err)
 		}
 	}
 
+	deployHooks := hooks.NewDeployRunner(r.kubectlCLI, r.runCtx.Pipeline().Deploy.LifecycleHooks, r.runCtx.GetNamespaces)
+	if err := deployHooks.RunPreHooks(ctx, out, artifacts); err != nil {
+		return sErrors.WithExitCode(sErrors.DeployExitCode, fmt.Errorf("running pre-deploy hooks: %w", err))
+	}
+
 	deployOut, postDeployFn, err := deployutil.WithLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
 	if err != nil {
 		return err
@@ -77,12 +92,21 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	postDeployFn()
 	if err != nil {
 		event.DeployFailed(err)
-		return err
+		return sErrors.WithExitCode(sErrors.DeployExitCode, err)
 	}
 
 	event.DeployComplete()
 	r.runCtx.UpdateNamespaces(namespaces)
-	return r.performStatusCheck(ctx, out)
+	if deploysToKubernetes {
+		if err := r.performStatusCheck(ctx, out); err != nil {
+			return sErrors.WithExitCode(sErrors.StatusCheckExitCode, err)
+		}
+	}
+
+	if err := deployHooks.RunPostHooks(ctx, out, artifacts); err != nil {
+		return sErrors.WithExitCode(sErrors.DeployExitCode, fmt.Errorf("running post-deploy hooks: %w", err))
+	}
+	return nil
 }
 
 func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
@@ -91,24 +115,14 @@ func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Write
 		return err
 	}
 
-	if config.IsKindCluster(r.runCtx.GetKubeContext()) {
-		kindCluster := config.KindClusterName(currentContext.Cluster)
-
-		// With `kind`, docker images have to be loaded with the `kind` CLI.
-		if err := r.loadImagesInKindNodes(ctx, out, kindCluster, artifacts); err != nil {
-			return fmt.Errorf("loading images into kind nodes: %w", err)
-		}
+	detector := config.DetectLocalCluster(r.runCtx.GetKubeContext())
+	if detector == nil || !detector.RequiresImageLoading() {
+		return nil
 	}
 
-	if config.IsK3dCluster(r.runCtx.GetKubeContext()) {
-		k3dCluster := config.K3dClusterName(currentContext.Cluster)
-
-		// With `k3d`, docker images have to be loaded with the `k3d` CLI.
-		if err := r.loadImagesInK3dNodes(ctx, out, k3dCluster, artifacts); err != nil {
-			return fmt.Errorf("loading images into k3d nodes: %w", err)
-		}
+	if err := r.loadImagesWith(ctx, out, detector, currentContext.Cluster, artifacts); err != nil {
+		return fmt.Errorf("loading images into the local cluster: %w", err)
 	}
-
 	return nil
 }
 
@@ -146,9 +160,19 @@ func (r *SkaffoldRunner) performStatusCheck(ctx context.Context, out io.Writer)
 	start := time.Now()
 	color.Default.Fprintln(out, "Waiting for deployments to stabilize...")
 
-	s := newStatusCheck(r.runCtx, r.labeller)
-	if err := s.Check(ctx, out); err != nil {
-		return err
+	configs := statusCheckConfigs(r.runCtx)
+	for _, cfg := range configs {
+		if len(configs) > 1 {
+			color.Default.Fprintf(out, "Checking kube-context %q\n", cfg.GetKubeContext())
+		}
+
+		s := newStatusCheck(cfg, r.labeller)
+		checkCtx, endTrace := tracing.StartSpan(ctx, "StatusCheck", map[string]string{"kubeContext": cfg.GetKubeContext()})
+		err := s.Check(checkCtx, out)
+		endTrace(err)
+		if err != nil {
+			return err
+		}
 	}
 
 	color.Default.Fprintln(out, "Deployments stabilized in", time.Since(start))
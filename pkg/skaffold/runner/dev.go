@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -30,10 +31,12 @@ import (
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/metrics"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/proto"
 )
 
@@ -47,7 +50,7 @@ var (
 	fileSyncSucceeded  = event.FileSyncSucceeded
 )
 
-func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kubernetes.LogAggregator, forwarderManager portforward.Forwarder) error {
+func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger loggerMux, forwarderManager portforward.Forwarder) error {
 	if r.changeSet.needsReload {
 		return ErrorConfigurationChanged
 	}
@@ -55,15 +58,21 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 	buildIntent, syncIntent, deployIntent := r.intents.GetIntents()
 	needsSync := syncIntent && len(r.changeSet.needsResync) > 0
 	needsBuild := buildIntent && len(r.changeSet.needsRebuild) > 0
+	// Tests are part of the build, so changed tests are run again even when deploys are manual.
+	needsTest := buildIntent && len(r.changeSet.needsRetest) > 0
 	needsDeploy := deployIntent && r.changeSet.needsRedeploy
-	if !needsSync && !needsBuild && !needsDeploy {
+	if !needsSync && !needsBuild && !needsTest && !needsDeploy {
 		return nil
 	}
 
+	ctx, endTrace := tracing.StartSpan(ctx, "DevLoop", map[string]string{"iteration": strconv.Itoa(r.devIteration)})
+	defer endTrace(nil)
+
 	logger.Mute()
 	// if any action is going to be performed, reset the monitor's changed component tracker for debouncing
 	defer r.monitor.Reset()
 	defer r.listener.LogWatchToUser(out)
+	defer r.printTimings(out)
 	event.DevLoopInProgress(r.devIteration)
 	defer func() { r.devIteration++ }()
 	if needsSync {
@@ -72,12 +81,18 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 			r.intents.resetSync()
 		}()
 
+		syncOut := output.WithPhase(out, "Sync")
 		for _, s := range r.changeSet.needsResync {
 			fileCount := len(s.Copy) + len(s.Delete)
-			color.Default.Fprintf(out, "Syncing %d files for %s\n", fileCount, s.Image)
+			color.Default.Fprintf(syncOut, "Syncing %d files for %s\n", fileCount, s.Image)
 			fileSyncInProgress(fileCount, s.Image)
 
-			if err := r.syncer.Sync(ctx, s); err != nil {
+			start := time.Now()
+			syncCtx, endTrace := tracing.StartSpan(ctx, "Sync", map[string]string{"image": s.Image})
+			err := r.syncer.Sync(syncCtx, syncOut, s)
+			endTrace(err)
+			metrics.Observe(metrics.Sync, start, err)
+			if err != nil {
 				logrus.Warnln("Skipping deploy due to sync error:", err)
 				fileSyncFailed(fileCount, s.Image, err)
 				event.DevLoopFailedInPhase(r.devIteration, sErrors.FileSync, err)
@@ -102,6 +117,22 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 		}
 	}
 
+	if needsTest {
+		defer r.changeSet.resetTest()
+
+		// Images that were just rebuilt have already been tested.
+		var rebuilt []*latest.Artifact
+		if needsBuild {
+			rebuilt = r.changeSet.needsRebuild
+		}
+
+		if err := r.tester.Test(ctx, output.WithPhase(out, "Test"), buildsToRetest(r.builds, r.changeSet.needsRetest, rebuilt)); err != nil {
+			logrus.Warnln("Skipping deploy due to test error:", err)
+			event.DevLoopFailedInPhase(r.devIteration, sErrors.Test, err)
+			return nil
+		}
+	}
+
 	if needsDeploy {
 		event.ResetStateOnDeploy()
 		defer func() {
@@ -118,9 +149,14 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 		if err := forwarderManager.Start(ctx); err != nil {
 			logrus.Warnln("Port forwarding failed:", err)
 		}
+		if err := logger.WatchNewNamespaces(); err != nil {
+			logrus.Warnln("Tailing logs of new namespaces failed:", err)
+		}
 	}
 	event.DevLoopComplete(r.devIteration)
-	logger.Unmute()
+	if !r.keys.areLogsPaused() {
+		logger.Unmute()
+	}
 	return nil
 }
 
@@ -129,6 +165,10 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) error {
 	event.DevLoopInProgress(r.devIteration)
 	defer func() { r.devIteration++ }()
+	if r.timings != nil {
+		// A new runner is created when the configuration changes.
+		defer r.timings.Stop()
+	}
 	g := getTransposeGraph(artifacts)
 	// Watch artifacts
 	start := time.Now()
@@ -148,7 +188,7 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		default:
 			if err := r.monitor.Register(
 				func() ([]string, error) {
-					return build.DependenciesForArtifact(ctx, artifact, r.runCtx)
+					return build.WatchedDependencies(ctx, artifact, r.runCtx)
 				},
 				func(e filemon.Events) {
 					s, err := sync.NewItem(ctx, artifact, e, r.builds, r.runCtx, len(g[artifact.ImageName]))
@@ -169,12 +209,19 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	}
 
 	// Watch test configuration
-	if err := r.monitor.Register(
-		r.tester.TestDependencies,
-		func(filemon.Events) { r.changeSet.needsRedeploy = true },
-	); err != nil {
-		event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_TEST_DEPS, err)
-		return fmt.Errorf("watching test files: %w", err)
+	for i := range artifacts {
+		artifact := artifacts[i]
+		if !r.runCtx.Opts.IsTargetImage(artifact) {
+			continue
+		}
+
+		if err := r.monitor.Register(
+			func() ([]string, error) { return r.tester.TestDependencies(artifact) },
+			func(filemon.Events) { r.changeSet.AddRetest(artifact) },
+		); err != nil {
+			event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_TEST_DEPS, err)
+			return fmt.Errorf("watching test files for artifact %q: %w", artifact.ImageName, err)
+		}
 	}
 
 	// Watch deployment configuration
@@ -198,7 +245,7 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	logrus.Infoln("List generated in", time.Since(start))
 
 	// Init Sync State
-	if err := sync.Init(ctx, artifacts); err != nil {
+	if err := sync.Init(ctx, artifacts, r.runCtx); err != nil {
 		event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_SYNC_INIT_ERROR, err)
 		return fmt.Errorf("exiting dev mode because initializing sync state failed: %w", err)
 	}
@@ -239,12 +286,33 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		return fmt.Errorf("starting logger: %w", err)
 	}
 
+	r.printTimings(out)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	quit := make(chan error, 1)
+	if r.interactive() {
+		r.printKeys(out)
+		go r.listenToKeys(ctx, out, logger, func(err error) {
+			quit <- err
+			cancel()
+		})
+	}
 	color.Yellow.Fprintln(out, "Press Ctrl+C to exit")
 
 	event.DevLoopComplete(0)
-	return r.listener.WatchForChanges(ctx, out, func() error {
+	err = r.listener.WatchForChanges(ctx, out, func() error {
+		r.addForcedRebuild(artifacts)
+		r.addRequestedRebuilds(artifacts)
 		return r.doDev(ctx, out, logger, forwarderManager)
 	})
+
+	select {
+	case quitErr := <-quit:
+		return quitErr
+	default:
+		return err
+	}
 }
 
 // graph represents the artifact graph
@@ -270,3 +338,45 @@ func addRebuild(g graph, artifact *latest.Artifact, rebuild func(*latest.Artifac
 		addRebuild(g, a, rebuild, isTarget)
 	}
 }
+
+// buildsToRetest returns the builds of the artifacts to retest that were not just rebuilt.
+func buildsToRetest(builds []build.Artifact, retest, rebuilt []*latest.Artifact) []build.Artifact {
+	var filtered []build.Artifact
+	for _, b := range builds {
+		if containsImage(retest, b.ImageName) && !containsImage(rebuilt, b.ImageName) {
+			filtered = append(filtered, b)
+		}
+	}
+	return filtered
+}
+
+func containsImage(artifacts []*latest.Artifact, imageName string) bool {
+	for _, a := range artifacts {
+		if a.ImageName == imageName {
+			return true
+		}
+	}
+	return false
+}
+
+// addRequestedRebuilds marks the artifacts that were requested through
+// the API to be rebuilt, whether their files changed or not.
+func (r *SkaffoldRunner) addRequestedRebuilds(artifacts []*latest.Artifact) {
+	for _, imageName := range r.intents.takeArtifacts() {
+		artifact := findArtifact(artifacts, imageName)
+		if artifact == nil || !r.runCtx.Opts.IsTargetImage(artifact) {
+			logrus.Warnf("Ignoring build request for artifact %q, which isn't watched", imageName)
+			continue
+		}
+		r.changeSet.AddRebuild(artifact)
+	}
+}
+
+func findArtifact(artifacts []*latest.Artifact, imageName string) *latest.Artifact {
+	for _, a := range artifacts {
+		if a.ImageName == imageName {
+			return a
+		}
+	}
+	return nil
+}
@@ -78,8 +78,12 @@ func (t *TestMonitor) Run(bool) error {
 			t.callbacks[0](evt) // 1st artifact changed
 		case "file2":
 			t.callbacks[1](evt) // 2nd artifact changed
+		case "test1.yaml":
+			t.callbacks[2](evt) // 1st artifact's test configuration changed
+		case "test2.yaml":
+			t.callbacks[3](evt) // 2nd artifact's test configuration changed
 		case "manifest.yaml":
-			t.callbacks[3](evt) // deployment configuration changed
+			t.callbacks[4](evt) // deployment configuration changed
 		}
 	}
 
@@ -156,6 +160,7 @@ func TestDev(t *testing.T) {
 		description     string
 		testBench       *TestBench
 		watchEvents     []filemon.Events
+		manualDeploy    bool
 		expectedActions []Actions
 	}{
 		{
@@ -263,6 +268,76 @@ func TestDev(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "retest and redeploy",
+			testBench:   &TestBench{},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"test1.yaml"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Tested:   []string{"img1:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+			},
+		},
+		{
+			description: "retest without testing rebuilt artifact twice",
+			testBench:   &TestBench{},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"file2", "test1.yaml", "test2.yaml"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Built:    []string{"img2:2"},
+					Tested:   []string{"img2:2", "img1:1"},
+					Deployed: []string{"img1:1", "img2:2"},
+				},
+			},
+		},
+		{
+			description: "retest without autoDeploy",
+			testBench:   &TestBench{},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"test1.yaml"}},
+			},
+			manualDeploy: true,
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Tested: []string{"img1:1"},
+				},
+			},
+		},
+		{
+			description: "skip deploy on retest error",
+			testBench:   &TestBench{testErrors: []error{nil, errors.New("")}},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"test2.yaml"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{},
+			},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -274,6 +349,10 @@ func TestDev(t *testing.T) {
 				events:    test.watchEvents,
 				testBench: test.testBench,
 			})
+			if test.manualDeploy {
+				runner.intents.setAutoDeploy(false)
+				runner.intents.setDeploy(false)
+			}
 
 			err := runner.Dev(context.Background(), ioutil.Discard, []*latest.Artifact{
 				{ImageName: "img1"},
@@ -610,3 +689,24 @@ func TestDevSync_WithDependencies(t *testing.T) {
 		})
 	}
 }
+
+func TestAddRequestedRebuilds(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifacts := []*latest.Artifact{{ImageName: "img1"}, {ImageName: "img2"}}
+		r := createRunner(t, &TestBench{}, nil)
+		r.intents.setAutoBuild(false)
+		r.intents.setBuild(false)
+
+		r.addRequestedRebuilds(artifacts)
+		t.CheckEmpty(r.changeSet.needsRebuild)
+
+		r.intents.addArtifacts([]string{"img2", "unknown"})
+		buildIntent, _, _ := r.intents.GetIntents()
+		t.CheckTrue(buildIntent)
+
+		r.addRequestedRebuilds(artifacts)
+		t.CheckDeepEqual([]*latest.Artifact{{ImageName: "img2"}}, r.changeSet.needsRebuild)
+		t.CheckTrue(r.changeSet.needsRedeploy)
+		t.CheckEmpty(r.intents.takeArtifacts())
+	})
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+)
+
+// kubectl diff exits with this code when the cluster differs from the manifests.
+const kubectlDiffFound = 1
+
+type exitCoder interface {
+	ExitCode() int
+}
+
+// Diff prints the changes that deploying the given artifacts would make to the live state of the cluster.
+// It returns true when there are changes.
+func (r *SkaffoldRunner) Diff(ctx context.Context, out io.Writer, builds []build.Artifact) (bool, error) {
+	var manifests bytes.Buffer
+	if err := r.deployer.Render(ctx, &manifests, builds, false, ""); err != nil {
+		return false, fmt.Errorf("rendering manifests: %w", err)
+	}
+	if manifests.Len() == 0 {
+		logrus.Debugln("No manifests to diff")
+		return false, nil
+	}
+
+	err := r.kubectlCLI.Run(ctx, &manifests, out, "diff", "-f", "-")
+	if err == nil {
+		return false, nil
+	}
+
+	var exitErr exitCoder
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == kubectlDiffFound {
+		return true, nil
+	}
+	return false, fmt.Errorf("diffing manifests: %w", err)
+}
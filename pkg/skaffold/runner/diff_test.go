@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type renderingDeployer struct {
+	*TestBench
+	manifests string
+}
+
+func (d *renderingDeployer) Render(_ context.Context, out io.Writer, _ []build.Artifact, _ bool, _ string) error {
+	_, err := io.WriteString(out, d.manifests)
+	return err
+}
+
+type fakeExitErr struct{ code int }
+
+func (e *fakeExitErr) Error() string { return "exit status" }
+func (e *fakeExitErr) ExitCode() int { return e.code }
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		description string
+		manifests   string
+		commands    util.Command
+		expected    bool
+		shouldErr   bool
+	}{
+		{
+			description: "no manifests",
+		},
+		{
+			description: "no diff",
+			manifests:   "apiVersion: v1\nkind: Pod\n",
+			commands:    testutil.CmdRunInput("kubectl --context kubecontext diff -f -", "apiVersion: v1\nkind: Pod\n"),
+		},
+		{
+			description: "diff found",
+			manifests:   "apiVersion: v1\nkind: Pod\n",
+			commands:    testutil.CmdRunErr("kubectl --context kubecontext diff -f -", &fakeExitErr{code: 1}),
+			expected:    true,
+		},
+		{
+			description: "kubectl error",
+			manifests:   "apiVersion: v1\nkind: Pod\n",
+			commands:    testutil.CmdRunErr("kubectl --context kubecontext diff -f -", &fakeExitErr{code: 2}),
+			shouldErr:   true,
+		},
+		{
+			description: "kubectl not found",
+			manifests:   "apiVersion: v1\nkind: Pod\n",
+			commands:    testutil.CmdRunErr("kubectl --context kubecontext diff -f -", errors.New("executable file not found")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			runCtx := &runcontext.RunContext{KubeContext: "kubecontext"}
+			r := SkaffoldRunner{
+				runCtx:     runCtx,
+				kubectlCLI: kubectl.NewCLI(runCtx, ""),
+				deployer:   &renderingDeployer{TestBench: NewTestBench(), manifests: test.manifests},
+			}
+
+			changed, err := r.Diff(context.Background(), ioutil.Discard, nil)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, changed)
+		})
+	}
+}
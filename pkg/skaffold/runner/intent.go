@@ -26,6 +26,9 @@ type intents struct {
 	autoSync   bool
 	autoDeploy bool
 
+	// artifacts are the image names of the artifacts to rebuild on the next iteration.
+	artifacts []string
+
 	lock sync.Mutex
 }
 
@@ -75,6 +78,23 @@ func (i *intents) setDeploy(val bool) {
 	i.lock.Unlock()
 }
 
+// addArtifacts requests a build of the given artifacts, whether their files changed or not.
+func (i *intents) addArtifacts(imageNames []string) {
+	i.lock.Lock()
+	i.build = true
+	i.artifacts = append(i.artifacts, imageNames...)
+	i.lock.Unlock()
+}
+
+// takeArtifacts returns the artifacts to rebuild and forgets them.
+func (i *intents) takeArtifacts() []string {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	artifacts := i.artifacts
+	i.artifacts = nil
+	return artifacts
+}
+
 func (i *intents) getAutoBuild() bool {
 	i.lock.Lock()
 	defer i.lock.Unlock()
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Keys that control the dev loop when running interactively.
+const (
+	keyRebuild    = 'r'
+	keyToggleLogs = 'l'
+	keyToggleSync = 's'
+	keyQuit       = 'q'
+	keyDetach     = 'd'
+)
+
+var (
+	// ErrorQuit is returned when the user quits dev mode by pressing a key.
+	ErrorQuit = errors.New("quit")
+
+	// ErrorQuitWithoutCleanup is returned when the user quits dev mode by pressing a key
+	// and asks to keep what was deployed.
+	ErrorQuitWithoutCleanup = errors.New("quit without cleanup")
+)
+
+var (
+	// For testing
+	pressedKeys = readStdinKeys
+	isTerminal  = func() bool {
+		_, isTerm := util.IsTerminal(os.Stdin)
+		return isTerm
+	}
+
+	stdinKeys     chan rune
+	stdinKeysOnce sync.Once
+)
+
+// readStdinKeys returns the keys read from stdin.
+// Stdin is read by a single goroutine so that the keys are not lost
+// when the dev loop is restarted after a configuration change.
+func readStdinKeys() <-chan rune {
+	stdinKeysOnce.Do(func() {
+		stdinKeys = make(chan rune)
+		go func() {
+			reader := bufio.NewReader(os.Stdin)
+			for {
+				key, _, err := reader.ReadRune()
+				if err != nil {
+					logrus.Debugf("reading keys: %s", err)
+					close(stdinKeys)
+					return
+				}
+				stdinKeys <- key
+			}
+		}()
+	})
+	return stdinKeys
+}
+
+// keyState holds the state of the dev loop that's changed by pressed keys.
+type keyState struct {
+	forceRebuild int32
+	logsPaused   int32
+}
+
+func (k *keyState) takeForceRebuild() bool {
+	return atomic.SwapInt32(&k.forceRebuild, 0) == 1
+}
+
+func (k *keyState) areLogsPaused() bool {
+	return atomic.LoadInt32(&k.logsPaused) == 1
+}
+
+// interactive tells if the dev loop should be controlled with keys.
+// The manual trigger already reads the keys pressed by the user.
+func (r *SkaffoldRunner) interactive() bool {
+	return r.runCtx.Interactive() && r.runCtx.Trigger() != "manual" && isTerminal()
+}
+
+func (r *SkaffoldRunner) printKeys(out io.Writer) {
+	color.Yellow.Fprintf(out, "Press %c to rebuild and redeploy, %c to toggle logs, %c to toggle sync, %c to quit or %c to quit without cleaning up, followed by Enter\n",
+		keyRebuild, keyToggleLogs, keyToggleSync, keyQuit, keyDetach)
+}
+
+// listenToKeys handles the keys pressed by the user until the context is cancelled
+// or the user quits, in which case quit is called with the reason.
+func (r *SkaffoldRunner) listenToKeys(ctx context.Context, out io.Writer, logger loggerMux, quit func(error)) {
+	keys := pressedKeys()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case key, ok := <-keys:
+			if !ok {
+				return
+			}
+
+			switch key {
+			case keyRebuild:
+				color.Default.Fprintln(out, "Rebuilding and redeploying all the artifacts...")
+				atomic.StoreInt32(&r.keys.forceRebuild, 1)
+				r.intents.setBuild(true)
+				r.intents.setDeploy(true)
+				select {
+				case r.intentChan <- true:
+				default:
+					// A dev loop iteration is already pending.
+				}
+			case keyToggleLogs:
+				if r.keys.areLogsPaused() {
+					atomic.StoreInt32(&r.keys.logsPaused, 0)
+					logger.Unmute()
+					color.Default.Fprintln(out, "Resumed logs")
+				} else {
+					atomic.StoreInt32(&r.keys.logsPaused, 1)
+					logger.Mute()
+					color.Default.Fprintln(out, "Paused logs")
+				}
+			case keyToggleSync:
+				autoSync := !r.intents.getAutoSync()
+				r.intents.setAutoSync(autoSync)
+				r.intents.setSync(autoSync)
+				if autoSync {
+					color.Default.Fprintln(out, "Enabled file sync")
+				} else {
+					color.Default.Fprintln(out, "Disabled file sync")
+				}
+			case keyQuit:
+				quit(ErrorQuit)
+				return
+			case keyDetach:
+				quit(ErrorQuitWithoutCleanup)
+				return
+			}
+		}
+	}
+}
+
+// addForcedRebuild marks all the target artifacts to be rebuilt and redeployed
+// when the user asked for it.
+func (r *SkaffoldRunner) addForcedRebuild(artifacts []*latest.Artifact) {
+	if !r.keys.takeForceRebuild() {
+		return
+	}
+
+	for _, a := range artifacts {
+		if r.runCtx.Opts.IsTargetImage(a) {
+			r.changeSet.AddRebuild(a)
+		}
+	}
+	r.changeSet.needsRedeploy = true
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestListenToKeys(t *testing.T) {
+	tests := []struct {
+		description      string
+		keys             string
+		expectedQuit     error
+		expectedRebuild  bool
+		expectedPaused   bool
+		expectedAutoSync bool
+		expectedIntent   bool
+		expectedOutput   string
+	}{
+		{
+			description:      "rebuild",
+			keys:             "r\n",
+			expectedRebuild:  true,
+			expectedAutoSync: true,
+			expectedIntent:   true,
+			expectedOutput:   "Rebuilding and redeploying all the artifacts...\n",
+		},
+		{
+			description:      "pause logs",
+			keys:             "l\n",
+			expectedPaused:   true,
+			expectedAutoSync: true,
+			expectedOutput:   "Paused logs\n",
+		},
+		{
+			description:      "pause and resume logs",
+			keys:             "l\nl\n",
+			expectedAutoSync: true,
+			expectedOutput:   "Paused logs\nResumed logs\n",
+		},
+		{
+			description:    "disable sync",
+			keys:           "s\n",
+			expectedOutput: "Disabled file sync\n",
+		},
+		{
+			description:      "quit",
+			keys:             "q\nr\n",
+			expectedQuit:     ErrorQuit,
+			expectedAutoSync: true,
+		},
+		{
+			description:      "quit without cleanup",
+			keys:             "d\n",
+			expectedQuit:     ErrorQuitWithoutCleanup,
+			expectedAutoSync: true,
+		},
+		{
+			description:      "ignore other keys",
+			keys:             "x\n",
+			expectedAutoSync: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			keys := make(chan rune, len(test.keys))
+			for _, key := range test.keys {
+				keys <- key
+			}
+			close(keys)
+			t.Override(&pressedKeys, func() <-chan rune { return keys })
+
+			intentChan := make(chan bool, 1)
+			r := createRunner(t, &TestBench{}, nil)
+			r.intentChan = intentChan
+
+			var out bytes.Buffer
+			var quit error
+			r.listenToKeys(context.Background(), &out, nil, func(err error) { quit = err })
+
+			t.CheckTrue(quit == test.expectedQuit)
+			t.CheckDeepEqual(test.expectedRebuild, r.keys.takeForceRebuild())
+			t.CheckDeepEqual(test.expectedPaused, r.keys.areLogsPaused())
+			t.CheckDeepEqual(test.expectedAutoSync, r.intents.getAutoSync())
+			t.CheckDeepEqual(test.expectedIntent, len(intentChan) == 1)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestAddForcedRebuild(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifacts := []*latest.Artifact{{ImageName: "img1"}, {ImageName: "img2"}}
+		r := createRunner(t, &TestBench{}, nil)
+
+		r.addForcedRebuild(artifacts)
+		t.CheckEmpty(r.changeSet.needsRebuild)
+		t.CheckFalse(r.changeSet.needsRedeploy)
+
+		r.keys.forceRebuild = 1
+		r.addForcedRebuild(artifacts)
+		t.CheckDeepEqual(artifacts, r.changeSet.needsRebuild)
+		t.CheckTrue(r.changeSet.needsRedeploy)
+		t.CheckFalse(r.keys.takeForceRebuild())
+	})
+}
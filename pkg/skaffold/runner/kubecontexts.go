@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/status"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+// kubeContextConfig is a RunContext that targets another kube-context.
+type kubeContextConfig struct {
+	*runcontext.RunContext
+	kubeContext string
+}
+
+func (c kubeContextConfig) GetKubeContext() string { return c.kubeContext }
+
+// isMultiContext returns true when the manifests are deployed to more than one kube-context.
+func isMultiContext(runCtx *runcontext.RunContext) bool {
+	return len(runCtx.GetKubeContexts()) > 1 && !runCtx.RenderOnly() && runCtx.Mode() != config.RunModes.Render
+}
+
+// getDeployerForContexts creates a deployer for each kube-context to deploy to.
+// With a single kube-context, this is the same as getDeployer.
+func getDeployerForContexts(runCtx *runcontext.RunContext, labels map[string]string) (deploy.Deployer, error) {
+	if !isMultiContext(runCtx) {
+		return getDeployer(runCtx, labels)
+	}
+
+	var deployers deploy.DeployerMux
+	for _, kubeContext := range runCtx.GetKubeContexts() {
+		deployer, err := getDeployer(kubeContextConfig{RunContext: runCtx, kubeContext: kubeContext}, labels)
+		if err != nil {
+			return nil, fmt.Errorf("kube-context %q: %w", kubeContext, err)
+		}
+		deployers = append(deployers, withKubeContext{Deployer: deployer, kubeContext: kubeContext})
+	}
+
+	return deployers, nil
+}
+
+// statusCheckConfigs returns one status check configuration per kube-context to deploy to.
+func statusCheckConfigs(runCtx *runcontext.RunContext) []status.Config {
+	if !isMultiContext(runCtx) {
+		return []status.Config{runCtx}
+	}
+
+	var configs []status.Config
+	for _, kubeContext := range runCtx.GetKubeContexts() {
+		configs = append(configs, kubeContextConfig{RunContext: runCtx, kubeContext: kubeContext})
+	}
+	return configs
+}
+
+// kubeContextCLI is the kubectl CLI of a kube-context the application is deployed to.
+type kubeContextCLI struct {
+	// kubeContext is empty for the current kube-context.
+	kubeContext string
+	cli         *kubectl.CLI
+}
+
+// kubeContextCLIs returns a kubectl CLI for each kube-context the application is deployed to,
+// starting with the current one, so that logs and ports can be followed on every cluster.
+func (r *SkaffoldRunner) kubeContextCLIs() []kubeContextCLI {
+	clis := []kubeContextCLI{{cli: r.kubectlCLI}}
+	if !isMultiContext(r.runCtx) {
+		return clis
+	}
+
+	for _, kubeContext := range r.runCtx.GetKubeContexts()[1:] {
+		clis = append(clis, kubeContextCLI{
+			kubeContext: kubeContext,
+			cli:         kubectl.NewCLI(kubeContextConfig{RunContext: r.runCtx, kubeContext: kubeContext}, ""),
+		})
+	}
+	return clis
+}
+
+// withKubeContext labels the output of a deployer with the kube-context it's using.
+type withKubeContext struct {
+	deploy.Deployer
+	kubeContext string
+}
+
+func (w withKubeContext) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
+	color.Default.Fprintf(out, "Deploying to kube-context %q...\n", w.kubeContext)
+	return w.Deployer.Deploy(ctx, out, builds)
+}
+
+func (w withKubeContext) Cleanup(ctx context.Context, out io.Writer) error {
+	color.Default.Fprintf(out, "Cleaning up kube-context %q...\n", w.kubeContext)
+	return w.Deployer.Cleanup(ctx, out)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGetDeployerForContexts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		runCtx := &runcontext.RunContext{
+			Cfg: latest.Pipeline{
+				Deploy: latest.DeployConfig{
+					DeployType: latest.DeployType{HelmDeploy: &latest.HelmDeploy{}},
+				},
+			},
+			KubeContext:  "staging",
+			KubeContexts: []string{"staging", "canary"},
+		}
+
+		deployer, err := getDeployerForContexts(runCtx, nil)
+		t.RequireNoError(err)
+
+		deployers := deployer.(deploy.DeployerMux)
+		t.CheckDeepEqual(2, len(deployers))
+		for i, kubeContext := range []string{"staging", "canary"} {
+			d := deployers[i].(withKubeContext)
+			t.CheckDeepEqual(kubeContext, d.kubeContext)
+			t.CheckTypeEquality(&helm.Deployer{}, d.Deployer)
+		}
+	})
+}
+
+func TestStatusCheckConfigs(t *testing.T) {
+	tests := []struct {
+		description  string
+		command      string
+		kubeContexts []string
+		expected     []string
+	}{
+		{
+			description:  "single kube-context",
+			command:      "dev",
+			kubeContexts: []string{"staging"},
+			expected:     []string{"staging"},
+		},
+		{
+			description:  "multiple kube-contexts",
+			command:      "run",
+			kubeContexts: []string{"staging", "canary"},
+			expected:     []string{"staging", "canary"},
+		},
+		{
+			description:  "render never targets multiple kube-contexts",
+			command:      "render",
+			kubeContexts: []string{"staging", "canary"},
+			expected:     []string{"staging"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{
+				Opts:         config.SkaffoldOptions{Command: test.command},
+				KubeContext:  test.kubeContexts[0],
+				KubeContexts: test.kubeContexts,
+			}
+
+			var kubeContexts []string
+			for _, cfg := range statusCheckConfigs(runCtx) {
+				kubeContexts = append(kubeContexts, cfg.GetKubeContext())
+			}
+
+			t.CheckDeepEqual(test.expected, kubeContexts)
+		})
+	}
+}
+
+func TestKubeContextCLIs(t *testing.T) {
+	tests := []struct {
+		description          string
+		kubeContexts         []string
+		expectedKubeContexts []string
+		expectedCLIContexts  []string
+	}{
+		{
+			description:          "single kube-context",
+			kubeContexts:         []string{"staging"},
+			expectedKubeContexts: []string{""},
+			expectedCLIContexts:  []string{"staging"},
+		},
+		{
+			description:          "multiple kube-contexts",
+			kubeContexts:         []string{"staging", "canary"},
+			expectedKubeContexts: []string{"", "canary"},
+			expectedCLIContexts:  []string{"staging", "canary"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{
+				Opts:         config.SkaffoldOptions{Command: "dev"},
+				KubeContext:  test.kubeContexts[0],
+				KubeContexts: test.kubeContexts,
+			}
+			r := &SkaffoldRunner{runCtx: runCtx, kubectlCLI: kubectl.NewCLI(runCtx, "")}
+
+			var kubeContexts, cliContexts []string
+			for _, kctx := range r.kubeContextCLIs() {
+				kubeContexts = append(kubeContexts, kctx.kubeContext)
+				cliContexts = append(cliContexts, kctx.cli.KubeContext)
+			}
+
+			t.CheckDeepEqual(test.expectedKubeContexts, kubeContexts)
+			t.CheckDeepEqual(test.expectedCLIContexts, cliContexts)
+		})
+	}
+}
+
+func TestCreateLoggerLabelsKubeContexts(t *testing.T) {
+	tests := []struct {
+		description          string
+		kubeContexts         []string
+		expectedKubeContexts []string
+	}{
+		{
+			description:          "single kube-context",
+			kubeContexts:         []string{"staging"},
+			expectedKubeContexts: []string{""},
+		},
+		{
+			description:          "multiple kube-contexts",
+			kubeContexts:         []string{"staging", "canary"},
+			expectedKubeContexts: []string{"staging", "canary"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{
+				Opts: config.SkaffoldOptions{Command: "dev", Tail: true},
+				Cfg: latest.Pipeline{
+					Deploy: latest.DeployConfig{
+						DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+					},
+				},
+				KubeContext:  test.kubeContexts[0],
+				KubeContexts: test.kubeContexts,
+			}
+			r := &SkaffoldRunner{runCtx: runCtx, kubectlCLI: kubectl.NewCLI(runCtx, ""), podSelector: kubernetes.NewImageList()}
+
+			var kubeContexts []string
+			for _, l := range r.createLogger(ioutil.Discard, nil) {
+				kubeContexts = append(kubeContexts, l.(*kubernetes.LogAggregator).KubeContext)
+			}
+
+			t.CheckDeepEqual(test.expectedKubeContexts, kubeContexts)
+		})
+	}
+}
@@ -20,33 +20,30 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os/exec"
 	"strings"
 	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
-// loadImagesInKindNodes loads artifact images into every node of a kind cluster.
-func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Writer, kindCluster string, artifacts []build.Artifact) error {
-	color.Default.Fprintln(out, "Loading images into kind cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
-		return exec.CommandContext(ctx, "kind", "load", "docker-image", "--name", kindCluster, tag)
+// loadImagesWith loads the artifact images into the nodes of a local cluster
+// with the load hook of the cluster's detector.
+func (r *SkaffoldRunner) loadImagesWith(ctx context.Context, out io.Writer, detector config.LocalClusterDetector, cluster string, artifacts []build.Artifact) error {
+	color.Default.Fprintln(out, "Loading images into the local cluster nodes...")
+	return r.loadImages(ctx, out, artifacts, func(tag string) ([]byte, error) {
+		return detector.LoadImage(ctx, config.ImageToLoad{
+			Tag:       tag,
+			Cluster:   cluster,
+			UsePodman: r.runCtx.UsePodman(),
+		})
 	})
 }
 
-// loadImagesInK3dNodes loads artifact images into every node of a k3s cluster.
-func (r *SkaffoldRunner) loadImagesInK3dNodes(ctx context.Context, out io.Writer, k3dCluster string, artifacts []build.Artifact) error {
-	color.Default.Fprintln(out, "Loading images into k3d cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
-		return exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", k3dCluster, tag)
-	})
-}
-
-func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, createCmd func(tag string) *exec.Cmd) error {
+func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, loadImage func(tag string) ([]byte, error)) error {
 	start := time.Now()
 
 	var knownImages []string
@@ -71,8 +68,7 @@ func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifact
 			continue
 		}
 
-		cmd := createCmd(artifact.Tag)
-		if output, err := util.RunCmdOut(cmd); err != nil {
+		if output, err := loadImage(artifact.Tag); err != nil {
 			color.Red.Fprintln(out, "Failed")
 			return fmt.Errorf("unable to load image %q into cluster: %w, %s", artifact.Tag, err, output)
 		}
@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -33,6 +34,7 @@ import (
 type ImageLoadingTest = struct {
 	description   string
 	cluster       string
+	podman        bool
 	built         []build.Artifact
 	deployed      []build.Artifact
 	commands      util.Command
@@ -101,7 +103,7 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 	}
 
 	runImageLoadingTests(t, tests, func(r *SkaffoldRunner, test ImageLoadingTest) error {
-		return r.loadImagesInKindNodes(context.Background(), ioutil.Discard, test.cluster, test.deployed)
+		return r.loadImagesWith(context.Background(), ioutil.Discard, config.DetectLocalCluster("kind-kind"), test.cluster, test.deployed)
 	})
 }
 
@@ -166,10 +168,87 @@ func TestLoadImagesInK3dNodes(t *testing.T) {
 	}
 
 	runImageLoadingTests(t, tests, func(r *SkaffoldRunner, test ImageLoadingTest) error {
-		return r.loadImagesInK3dNodes(context.Background(), ioutil.Discard, test.cluster, test.deployed)
+		return r.loadImagesWith(context.Background(), ioutil.Discard, config.DetectLocalCluster("k3d-k3s-default"), test.cluster, test.deployed)
 	})
 }
 
+func TestLoadImagesInMicrok8sNode(t *testing.T) {
+	tests := []ImageLoadingTest{
+		{
+			description: "load image",
+			built:       []build.Artifact{{Tag: "tag1"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				AndRunOut(`sh -c "$0" save "$1" | microk8s ctr image import - docker tag1`, "output: image loaded"),
+		},
+		{
+			description: "load image built with podman",
+			podman:      true,
+			built:       []build.Artifact{{Tag: "tag1"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				AndRunOut(`sh -c "$0" save "$1" | microk8s ctr image import - podman tag1`, "output: image loaded"),
+		},
+		{
+			description: "skip known image",
+			built:       []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "tag1").
+				AndRunOut(`sh -c "$0" save "$1" | microk8s ctr image import - docker tag2`, "output: image loaded"),
+		},
+		{
+			description: "load error",
+			built:       []build.Artifact{{Tag: "tag"}},
+			deployed:    []build.Artifact{{Tag: "tag"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				AndRunOutErr(`sh -c "$0" save "$1" | microk8s ctr image import - docker tag`, "output: error!", errors.New("BUG")),
+			shouldErr:     true,
+			expectedError: "output: error!",
+		},
+	}
+
+	runImageLoadingTests(t, tests, func(r *SkaffoldRunner, test ImageLoadingTest) error {
+		return r.loadImagesWith(context.Background(), ioutil.Discard, config.DetectLocalCluster("microk8s"), test.cluster, test.deployed)
+	})
+}
+
+type recordingDetector struct {
+	loaded []config.ImageToLoad
+}
+
+func (d *recordingDetector) Matches(string) bool { return true }
+
+func (d *recordingDetector) RequiresImageLoading() bool { return true }
+
+func (d *recordingDetector) LoadImage(_ context.Context, image config.ImageToLoad) ([]byte, error) {
+	d.loaded = append(d.loaded, image)
+	return nil, nil
+}
+
+func TestLoadImagesWithRegisteredDetector(t *testing.T) {
+	detector := &recordingDetector{}
+	tests := []ImageLoadingTest{
+		{
+			description: "load image with the detector hook",
+			cluster:     "dev-cluster",
+			podman:      true,
+			built:       []build.Artifact{{Tag: "tag1"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", ""),
+		},
+	}
+
+	runImageLoadingTests(t, tests, func(r *SkaffoldRunner, test ImageLoadingTest) error {
+		return r.loadImagesWith(context.Background(), ioutil.Discard, detector, test.cluster, test.deployed)
+	})
+	testutil.CheckDeepEqual(t, []config.ImageToLoad{{Tag: "tag1", Cluster: "dev-cluster", UsePodman: true}}, detector.loaded)
+}
+
 func runImageLoadingTests(t *testing.T, tests []ImageLoadingTest, loadingFunc func(r *SkaffoldRunner, test ImageLoadingTest) error) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -181,6 +260,9 @@ func runImageLoadingTests(t *testing.T, tests []ImageLoadingTest, loadingFunc fu
 				},
 				KubeContext: "kubecontext",
 			}
+			if test.podman {
+				runCtx.Cfg.Build.LocalBuild = &latest.LocalBuild{UsePodman: true}
+			}
 
 			r := &SkaffoldRunner{
 				runCtx:     runCtx,
@@ -17,21 +17,101 @@ limitations under the License.
 package runner
 
 import (
+	"context"
 	"io"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 )
 
-func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []build.Artifact) *kubernetes.LogAggregator {
+// logger tails the logs of the deployed applications.
+type logger interface {
+	Start(ctx context.Context) error
+	Stop()
+	Mute()
+	Unmute()
+	SetSince(t time.Time)
+	WatchNewNamespaces() error
+}
+
+// loggerMux forwards every call to all the loggers of a pipeline.
+type loggerMux []logger
+
+func (m loggerMux) Start(ctx context.Context) error {
+	for _, l := range m {
+		if err := l.Start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m loggerMux) Stop() {
+	for _, l := range m {
+		l.Stop()
+	}
+}
+
+func (m loggerMux) Mute() {
+	for _, l := range m {
+		l.Mute()
+	}
+}
+
+func (m loggerMux) Unmute() {
+	for _, l := range m {
+		l.Unmute()
+	}
+}
+
+func (m loggerMux) SetSince(t time.Time) {
+	for _, l := range m {
+		l.SetSince(t)
+	}
+}
+
+func (m loggerMux) WatchNewNamespaces() error {
+	for _, l := range m {
+		if err := l.WatchNewNamespaces(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []build.Artifact) loggerMux {
 	if !r.runCtx.Tail() {
 		return nil
 	}
 
+	var loggers loggerMux
+	if r.runCtx.Pipeline().Deploy.DockerDeploy != nil {
+		loggers = append(loggers, docker.NewLogAggregator(output.WithPhase(out, "Logs"), r.runCtx, r.labeller.RunIDSelector()))
+	}
+	if !r.runCtx.DeploysToKubernetes() {
+		return loggers
+	}
+
 	var imageNames []string
 	for _, artifact := range artifacts {
 		imageNames = append(imageNames, artifact.Tag)
 	}
 
-	return kubernetes.NewLogAggregator(out, r.kubectlCLI, imageNames, r.podSelector, r.runCtx.GetNamespaces(), r.runCtx.Pipeline().Deploy.Logs)
+	// Filters given on the command line are added to the configured ones.
+	logsConfig := r.runCtx.Pipeline().Deploy.Logs
+	logsConfig.Include = append(append([]string{}, logsConfig.Include...), r.runCtx.Opts.LogInclude...)
+	logsConfig.Exclude = append(append([]string{}, logsConfig.Exclude...), r.runCtx.Opts.LogExclude...)
+
+	multiContext := isMultiContext(r.runCtx)
+	for _, kctx := range r.kubeContextCLIs() {
+		aggregator := kubernetes.NewLogAggregator(output.WithPhase(out, "Logs"), kctx.cli, kctx.kubeContext, imageNames, r.podSelector, r.runCtx.GetNamespaces, logsConfig)
+		if multiContext {
+			aggregator.KubeContext = kctx.cli.KubeContext
+		}
+		loggers = append(loggers, aggregator)
+	}
+	return loggers
 }
@@ -23,12 +23,16 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/acr"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cluster"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/codebuild"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/gcb"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/local"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/cloudrun"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
@@ -43,6 +47,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trigger"
 )
 
@@ -50,11 +55,16 @@ import (
 func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	kubectlCLI := pkgkubectl.NewCLI(runCtx, "")
 
-	tagger, err := getTagger(runCtx)
+	tagger, err := getTagger(runCtx, runCtx.Pipeline().Build.TagPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("creating tagger: %w", err)
 	}
 
+	artifactTaggers, err := getArtifactTaggers(runCtx)
+	if err != nil {
+		return nil, err
+	}
+
 	builder, imagesAreLocal, err := getBuilder(runCtx)
 	if err != nil {
 		return nil, fmt.Errorf("creating builder: %w", err)
@@ -69,26 +79,12 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	tester := getTester(runCtx, imagesAreLocal)
 	syncer := getSyncer(runCtx)
 	var deployer deploy.Deployer
-	deployer, err = getDeployer(runCtx, labeller.Labels())
+	deployer, err = getDeployerForContexts(runCtx, labeller.Labels())
 	if err != nil {
 		return nil, fmt.Errorf("creating deployer: %w", err)
 	}
 
-	depLister := func(ctx context.Context, artifact *latest.Artifact) ([]string, error) {
-		buildDependencies, err := build.DependenciesForArtifact(ctx, artifact, runCtx)
-		if err != nil {
-			return nil, err
-		}
-
-		testDependencies, err := tester.TestDependencies()
-		if err != nil {
-			return nil, err
-		}
-
-		return append(buildDependencies, testDependencies...), nil
-	}
-
-	artifactCache, err := cache.NewCache(runCtx, imagesAreLocal, tryImportMissing, depLister)
+	artifactCache, err := cache.NewCache(runCtx, imagesAreLocal, tryImportMissing, artifactDependencies(runCtx, tester))
 	if err != nil {
 		return nil, fmt.Errorf("initializing cache: %w", err)
 	}
@@ -108,6 +104,11 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		return nil, fmt.Errorf("creating watch trigger: %w", err)
 	}
 
+	var timings *tracing.Recorder
+	if runCtx.Timings() {
+		timings = tracing.NewRecorder()
+	}
+
 	return &SkaffoldRunner{
 		builder:  builder,
 		tester:   tester,
@@ -120,13 +121,16 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 			Trigger:    trigger,
 			intentChan: intentChan,
 		},
-		kubectlCLI:     kubectlCLI,
-		labeller:       labeller,
-		podSelector:    kubernetes.NewImageList(),
-		cache:          artifactCache,
-		runCtx:         runCtx,
-		intents:        intents,
-		imagesAreLocal: imagesAreLocal,
+		artifactTaggers: artifactTaggers,
+		kubectlCLI:      kubectlCLI,
+		labeller:        labeller,
+		podSelector:     kubernetes.NewImageList(),
+		cache:           artifactCache,
+		runCtx:          runCtx,
+		intents:         intents,
+		intentChan:      intentChan,
+		imagesAreLocal:  imagesAreLocal,
+		timings:         timings,
 	}, nil
 }
 
@@ -138,6 +142,13 @@ func setupIntents(runCtx *runcontext.RunContext) (*intents, chan bool) {
 	setupTrigger("sync", intents.setSync, intents.setAutoSync, intents.getAutoSync, server.SetSyncCallback, server.SetAutoSyncCallback, intentChan)
 	setupTrigger("deploy", intents.setDeploy, intents.setAutoDeploy, intents.getAutoDeploy, server.SetDeployCallback, server.SetAutoDeployCallback, intentChan)
 
+	// give the server a callback to rebuild given artifacts, even when builds are automatic
+	server.SetBuildArtifactsCallback(func(imageNames []string) {
+		logrus.Debugf("build intent for %v received, calling back to runner", imageNames)
+		intents.addArtifacts(imageNames)
+		intentChan <- true
+	})
+
 	return intents, intentChan
 }
 
@@ -183,6 +194,14 @@ func getBuilder(runCtx *runcontext.RunContext) (build.Builder, bool, error) {
 		logrus.Debugln("Using builder: google cloud")
 		return gcb.NewBuilder(runCtx), false, nil
 
+	case b.AWSCodeBuild != nil:
+		logrus.Debugln("Using builder: aws codebuild")
+		return codebuild.NewBuilder(runCtx), false, nil
+
+	case b.AzureContainerRegistry != nil:
+		logrus.Debugln("Using builder: azure container registry")
+		return acr.NewBuilder(runCtx), false, nil
+
 	case b.Cluster != nil:
 		logrus.Debugln("Using builder: cluster")
 		builder, err := cluster.NewBuilder(runCtx)
@@ -197,15 +216,33 @@ func getTester(cfg test.Config, imagesAreLocal bool) test.Tester {
 	return test.NewTester(cfg, imagesAreLocal)
 }
 
-func getSyncer(cfg sync.Config) sync.Syncer {
-	return sync.NewSyncer(cfg)
+func getSyncer(runCtx *runcontext.RunContext) sync.Syncer {
+	// Images run as local Docker containers are synced through the Docker API.
+	if runCtx.Pipeline().Deploy.DockerDeploy != nil && !runCtx.DeploysToKubernetes() {
+		return sync.NewContainerSyncer(runCtx)
+	}
+	return sync.NewSyncer(runCtx)
+}
+
+// deployerConfig is the configuration needed by all the deployers.
+type deployerConfig interface {
+	kubectl.Config
+	DeleteNamespaces() bool
 }
 
-func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer, error) {
+func getDeployer(cfg deployerConfig, labels map[string]string) (deploy.Deployer, error) {
 	d := cfg.Pipeline().Deploy
 
 	var deployers deploy.DeployerMux
 
+	if d.CloudRunDeploy != nil {
+		deployers = append(deployers, cloudrun.NewDeployer(cfg))
+	}
+
+	if d.DockerDeploy != nil {
+		deployers = append(deployers, docker.NewDeployer(cfg, labels))
+	}
+
 	if d.HelmDeploy != nil {
 		deployers = append(deployers, helm.NewDeployer(cfg, labels))
 	}
@@ -238,9 +275,7 @@ func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer,
 	return deployers, nil
 }
 
-func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
-	t := runCtx.Pipeline().Build.TagPolicy
-
+func getTagger(runCtx *runcontext.RunContext, t latest.TagPolicy) (tag.Tagger, error) {
 	switch {
 	case runCtx.CustomTag() != "":
 		return &tag.CustomTag{
@@ -254,13 +289,16 @@ func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
 		return &tag.ChecksumTagger{}, nil
 
 	case t.GitTagger != nil:
-		return tag.NewGitCommit(t.GitTagger.Prefix, t.GitTagger.Variant)
+		return tag.NewGitCommit(t.GitTagger.Prefix, t.GitTagger.Variant, t.GitTagger.DirtySuffix)
 
 	case t.DateTimeTagger != nil:
 		return tag.NewDateTimeTagger(t.DateTimeTagger.Format, t.DateTimeTagger.TimeZone), nil
 
+	case t.InputDigest != nil:
+		return tag.NewInputDigestTagger(inputDigest(runCtx)), nil
+
 	case t.CustomTemplateTagger != nil:
-		components, err := CreateComponents(t.CustomTemplateTagger)
+		components, err := CreateComponents(runCtx, t.CustomTemplateTagger)
 
 		if err != nil {
 			return nil, fmt.Errorf("creating components: %w", err)
@@ -273,8 +311,77 @@ func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
 	}
 }
 
+// getArtifactTaggers creates the taggers of the artifacts that override the pipeline's tag policy.
+func getArtifactTaggers(runCtx *runcontext.RunContext) (map[string]tag.Tagger, error) {
+	taggers := map[string]tag.Tagger{}
+	for _, a := range runCtx.Pipeline().Build.Artifacts {
+		policy := artifactTagPolicy(runCtx, a)
+		if policy == nil {
+			continue
+		}
+
+		t, err := getTagger(runCtx, *policy)
+		if err != nil {
+			return nil, fmt.Errorf("creating tagger for artifact %q: %w", a.ImageName, err)
+		}
+		taggers[a.ImageName] = t
+	}
+	return taggers, nil
+}
+
+// TaggerForArtifact creates the tagger of an artifact, from its own tag policy or the pipeline's.
+func TaggerForArtifact(runCtx *runcontext.RunContext, a *latest.Artifact) (tag.Tagger, error) {
+	if policy := artifactTagPolicy(runCtx, a); policy != nil {
+		return getTagger(runCtx, *policy)
+	}
+	return getTagger(runCtx, runCtx.Pipeline().Build.TagPolicy)
+}
+
+// artifactTagPolicy returns the tag policy of an artifact if it overrides the pipeline's, or nil.
+// A custom tag given on the command line applies to all the artifacts.
+func artifactTagPolicy(runCtx *runcontext.RunContext, a *latest.Artifact) *latest.TagPolicy {
+	if runCtx.CustomTag() != "" {
+		return nil
+	}
+	return a.TagPolicy
+}
+
+// inputDigest returns a function that computes the digest of the build inputs of an artifact.
+func inputDigest(runCtx *runcontext.RunContext) func(imageName string) (string, error) {
+	return func(imageName string) (string, error) {
+		artifacts := runCtx.Pipeline().Build.Artifacts
+		for _, a := range artifacts {
+			if a.ImageName != imageName {
+				continue
+			}
+			// The tester only lists the test dependencies, which don't depend on where the images are.
+			depLister := artifactDependencies(runCtx, getTester(runCtx, true))
+			return cache.InputDigest(context.Background(), depLister, a, artifacts)
+		}
+		return "", fmt.Errorf("unknown artifact %q", imageName)
+	}
+}
+
+// artifactDependencies lists the build and test dependencies of an artifact.
+// The artifact cache and the inputDigest tagger both hash these files.
+func artifactDependencies(runCtx *runcontext.RunContext, tester test.Tester) cache.DependencyLister {
+	return func(ctx context.Context, artifact *latest.Artifact) ([]string, error) {
+		buildDependencies, err := build.DependenciesForArtifact(ctx, artifact, runCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		testDependencies, err := tester.TestDependencies(artifact)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(buildDependencies, testDependencies...), nil
+	}
+}
+
 // CreateComponents creates a map of taggers for CustomTemplateTagger
-func CreateComponents(t *latest.CustomTemplateTagger) (map[string]tag.Tagger, error) {
+func CreateComponents(runCtx *runcontext.RunContext, t *latest.CustomTemplateTagger) (map[string]tag.Tagger, error) {
 	components := map[string]tag.Tagger{}
 
 	for _, taggerComponent := range t.Components {
@@ -292,11 +399,14 @@ func CreateComponents(t *latest.CustomTemplateTagger) (map[string]tag.Tagger, er
 			components[name] = &tag.ChecksumTagger{}
 
 		case c.GitTagger != nil:
-			components[name], _ = tag.NewGitCommit(c.GitTagger.Prefix, c.GitTagger.Variant)
+			components[name], _ = tag.NewGitCommit(c.GitTagger.Prefix, c.GitTagger.Variant, c.GitTagger.DirtySuffix)
 
 		case c.DateTimeTagger != nil:
 			components[name] = tag.NewDateTimeTagger(c.DateTimeTagger.Format, c.DateTimeTagger.TimeZone)
 
+		case c.InputDigest != nil:
+			components[name] = tag.NewInputDigestTagger(inputDigest(runCtx))
+
 		case c.CustomTemplateTagger != nil:
 			return nil, fmt.Errorf("nested customTemplate components are not supported in skaffold (%s)", name)
 
@@ -121,7 +121,7 @@ func TestGetDeployer(tOuter *testing.T) {
 }
 
 func TestCreateComponents(t *testing.T) {
-	gitExample, _ := tag.NewGitCommit("", "")
+	gitExample, _ := tag.NewGitCommit("", "", "")
 	envExample, _ := tag.NewEnvTemplateTagger("test")
 
 	tests := []struct {
@@ -178,7 +178,7 @@ func TestCreateComponents(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			components, err := CreateComponents(test.customTemplateTagger)
+			components, err := CreateComponents(&runcontext.RunContext{}, test.customTemplateTagger)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, len(test.expected), len(components))
 			for k, v := range test.expected {
 				t.CheckTypeEquality(v, components[k])
@@ -186,3 +186,122 @@ func TestCreateComponents(t *testing.T) {
 		})
 	}
 }
+
+func TestInputDigest(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("Dockerfile", "FROM scratch\nCOPY app.txt .\n").
+			Write("app.txt", "v1")
+
+		runCtx := &runcontext.RunContext{}
+		runCtx.Cfg.Build.Artifacts = []*latest.Artifact{{
+			ImageName:    "image",
+			Workspace:    tmpDir.Root(),
+			ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}},
+		}}
+		digest := inputDigest(runCtx)
+
+		first, err := digest("image")
+		t.CheckNoError(err)
+		again, err := digest("image")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(first, again)
+
+		runCtx.Opts.Command = "dev"
+		inDev, err := digest("image")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(first, inDev)
+
+		tmpDir.Write("app.txt", "v2")
+		changed, err := digest("image")
+		t.CheckNoError(err)
+		t.CheckFalse(first == changed)
+
+		_, err = digest("unknown")
+		t.CheckError(true, err)
+	})
+}
+
+func TestGetArtifactTaggers(t *testing.T) {
+	tests := []struct {
+		description string
+		artifacts   []*latest.Artifact
+		customTag   string
+		expected    map[string]tag.Tagger
+		shouldErr   bool
+	}{
+		{
+			description: "no override",
+			artifacts:   []*latest.Artifact{{ImageName: "image"}},
+			expected:    map[string]tag.Tagger{},
+		},
+		{
+			description: "override",
+			artifacts: []*latest.Artifact{
+				{ImageName: "image1", TagPolicy: &latest.TagPolicy{ShaTagger: &latest.ShaTagger{}}},
+				{ImageName: "image2"},
+			},
+			expected: map[string]tag.Tagger{"image1": &tag.ChecksumTagger{}},
+		},
+		{
+			description: "custom tag takes precedence",
+			artifacts:   []*latest.Artifact{{ImageName: "image", TagPolicy: &latest.TagPolicy{ShaTagger: &latest.ShaTagger{}}}},
+			customTag:   "v1",
+			expected:    map[string]tag.Tagger{},
+		},
+		{
+			description: "invalid override",
+			artifacts:   []*latest.Artifact{{ImageName: "image", TagPolicy: &latest.TagPolicy{}}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{}
+			runCtx.Cfg.Build.Artifacts = test.artifacts
+			runCtx.Opts.CustomTag = test.customTag
+
+			taggers, err := getArtifactTaggers(runCtx)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, taggers)
+		})
+	}
+}
+
+func TestTaggerForArtifact(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latest.Artifact
+		customTag   string
+		expected    tag.Tagger
+		shouldErr   bool
+	}{
+		{
+			description: "pipeline's tag policy",
+			artifact:    &latest.Artifact{ImageName: "image"},
+			shouldErr:   true,
+		},
+		{
+			description: "artifact's tag policy",
+			artifact:    &latest.Artifact{ImageName: "image", TagPolicy: &latest.TagPolicy{ShaTagger: &latest.ShaTagger{}}},
+			expected:    &tag.ChecksumTagger{},
+		},
+		{
+			description: "custom tag takes precedence",
+			artifact:    &latest.Artifact{ImageName: "image", TagPolicy: &latest.TagPolicy{ShaTagger: &latest.ShaTagger{}}},
+			customTag:   "v1",
+			expected:    &tag.CustomTag{Tag: "v1"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// The pipeline's tag policy is invalid so that using it fails.
+			runCtx := &runcontext.RunContext{}
+			runCtx.Opts.CustomTag = test.customTag
+
+			tagger, err := TaggerForArtifact(runCtx, test.artifact)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tagger)
+		})
+	}
+}
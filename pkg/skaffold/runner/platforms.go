@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// withClusterPlatforms restricts, in dev mode, the target platforms of the Docker
+// artifacts to those of the cluster nodes. Artifacts without target platforms are
+// built for the nodes' platform when it differs from the local machine's, so that
+// images built on an arm64 laptop can run on an amd64 cluster, and vice versa.
+// The configuration is left untouched: modified artifacts are copies.
+func (r *SkaffoldRunner) withClusterPlatforms(ctx context.Context, artifacts []*latest.Artifact) []*latest.Artifact {
+	mode := r.runCtx.Mode()
+	if mode != config.RunModes.Dev && mode != config.RunModes.Debug {
+		return artifacts
+	}
+	if r.runCtx.Pipeline().Build.LocalBuild == nil || !r.runCtx.DeploysToKubernetes() {
+		return artifacts
+	}
+
+	nodePlatforms := r.findNodePlatforms(ctx)
+	if len(nodePlatforms) == 0 {
+		return artifacts
+	}
+
+	var targeted []*latest.Artifact
+	for _, a := range artifacts {
+		if a.DockerArtifact == nil {
+			targeted = append(targeted, a)
+			continue
+		}
+		platforms := targetPlatforms(a, nodePlatforms)
+		if platforms == nil {
+			targeted = append(targeted, a)
+			continue
+		}
+
+		logrus.Debugf("Building %s for platforms %v of the cluster nodes", a.ImageName, platforms)
+		copied := *a
+		copied.Platforms = platforms
+		targeted = append(targeted, &copied)
+	}
+	return targeted
+}
+
+// targetPlatforms returns the platforms to build the artifact for, given the
+// platforms of the cluster nodes, or nil to keep the artifact's configuration.
+func targetPlatforms(a *latest.Artifact, nodePlatforms []string) []string {
+	if len(a.Platforms) == 0 {
+		if len(nodePlatforms) == 1 && nodePlatforms[0] == docker.HostPlatform {
+			return nil
+		}
+		return nodePlatforms
+	}
+
+	var platforms []string
+	for _, p := range nodePlatforms {
+		if util.StrSliceContains(a.Platforms, p) {
+			platforms = append(platforms, p)
+		}
+	}
+	if len(platforms) == 0 {
+		logrus.Warnf("None of the platforms of %s match the cluster nodes %v", a.ImageName, nodePlatforms)
+		return nil
+	}
+	return platforms
+}
+
+// findNodePlatforms lists the distinct `os/arch` platforms of the cluster nodes.
+// The nodes are only inspected once. Failures are logged and ignored.
+func (r *SkaffoldRunner) findNodePlatforms(ctx context.Context) []string {
+	if r.nodePlatforms != nil {
+		return r.nodePlatforms
+	}
+
+	r.nodePlatforms = []string{}
+	out, err := r.kubectlCLI.RunOut(ctx, "get", "nodes", `-ojsonpath={range .items[*]}{.status.nodeInfo.operatingSystem}/{.status.nodeInfo.architecture}{" "}{end}`)
+	if err != nil {
+		logrus.Warnf("Unable to inspect the platforms of the cluster nodes: %v", err)
+		return r.nodePlatforms
+	}
+
+	for _, p := range strings.Fields(string(out)) {
+		if !util.StrSliceContains(r.nodePlatforms, p) {
+			r.nodePlatforms = append(r.nodePlatforms, p)
+		}
+	}
+	return r.nodePlatforms
+}
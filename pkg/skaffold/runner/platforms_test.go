@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestWithClusterPlatforms(t *testing.T) {
+	getNodes := "kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.nodeInfo.operatingSystem}/{.status.nodeInfo.architecture}{\" \"}{end}"
+
+	tests := []struct {
+		description string
+		command     string
+		platforms   []string
+		commands    util.Command
+		expected    []string
+	}{
+		{
+			description: "not in dev mode",
+			command:     "run",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			expected:    []string{"linux/amd64", "linux/arm64"},
+		},
+		{
+			description: "cluster on the local platform",
+			command:     "dev",
+			commands:    testutil.CmdRunOut(getNodes, "linux/arm64 linux/arm64 "),
+		},
+		{
+			description: "cluster on a different platform",
+			command:     "dev",
+			commands:    testutil.CmdRunOut(getNodes, "linux/amd64 "),
+			expected:    []string{"linux/amd64"},
+		},
+		{
+			description: "only build the platforms of the nodes",
+			command:     "debug",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			commands:    testutil.CmdRunOut(getNodes, "linux/arm64 "),
+			expected:    []string{"linux/arm64"},
+		},
+		{
+			description: "no platform matches the nodes",
+			command:     "dev",
+			platforms:   []string{"linux/s390x"},
+			commands:    testutil.CmdRunOut(getNodes, "linux/arm64 "),
+			expected:    []string{"linux/s390x"},
+		},
+		{
+			description: "nodes can't be inspected",
+			command:     "dev",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			commands:    testutil.CmdRunOutErr(getNodes, "", errors.New("BUG")),
+			expected:    []string{"linux/amd64", "linux/arm64"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.HostPlatform, "linux/arm64")
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			runCtx := &runcontext.RunContext{
+				Opts: config.SkaffoldOptions{
+					Command:   test.command,
+					Namespace: "namespace",
+				},
+				KubeContext: "kubecontext",
+			}
+			runCtx.Cfg.Build.LocalBuild = &latest.LocalBuild{}
+			runCtx.Cfg.Deploy.KubectlDeploy = &latest.KubectlDeploy{}
+
+			r := &SkaffoldRunner{
+				runCtx:     runCtx,
+				kubectlCLI: kubectl.NewCLI(runCtx, ""),
+			}
+			artifact := &latest.Artifact{
+				ImageName:    "image",
+				Platforms:    test.platforms,
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+			}
+			artifacts := r.withClusterPlatforms(context.Background(), []*latest.Artifact{artifact})
+
+			t.CheckDeepEqual(test.expected, artifacts[0].Platforms)
+			t.CheckDeepEqual(test.platforms, artifact.Platforms)
+		})
+	}
+}
@@ -17,21 +17,46 @@ limitations under the License.
 package runner
 
 import (
+	"context"
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 )
 
-func (r *SkaffoldRunner) createForwarder(out io.Writer) *portforward.ForwarderManager {
-	if !r.runCtx.PortForward() {
+// forwarderMux starts and stops the port forwarding of every kube-context.
+type forwarderMux []*portforward.ForwarderManager
+
+func (m forwarderMux) Start(ctx context.Context) error {
+	for _, f := range m {
+		if err := f.Start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m forwarderMux) Stop() {
+	for _, f := range m {
+		f.Stop()
+	}
+}
+
+func (r *SkaffoldRunner) createForwarder(out io.Writer) forwarderMux {
+	if !r.runCtx.PortForward() || !r.runCtx.DeploysToKubernetes() {
 		return nil
 	}
 
-	return portforward.NewForwarderManager(out,
-		r.kubectlCLI,
-		r.podSelector,
-		r.runCtx.GetNamespaces(),
-		r.labeller.RunIDSelector(),
-		r.runCtx.Opts.PortForward,
-		r.runCtx.Pipeline().PortForward)
+	var forwarders forwarderMux
+	for _, kctx := range r.kubeContextCLIs() {
+		forwarders = append(forwarders, portforward.NewForwarderManager(out,
+			kctx.cli,
+			kctx.kubeContext,
+			r.podSelector,
+			r.runCtx.GetNamespaces,
+			r.labeller.RunIDSelector(),
+			r.runCtx.Mode(),
+			r.runCtx.Opts.PortForward,
+			r.runCtx.Pipeline().PortForward))
+	}
+	return forwarders
 }
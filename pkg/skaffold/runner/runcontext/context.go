@@ -18,7 +18,7 @@ package runcontext
 
 import (
 	"fmt"
-	"os"
+	"path/filepath"
 	"sort"
 
 	"github.com/sirupsen/logrus"
@@ -27,6 +27,7 @@ import (
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	runnerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 const (
@@ -38,17 +39,25 @@ type RunContext struct {
 	Cfg  latest.Pipeline
 
 	KubeContext        string
+	KubeContexts       []string
 	Namespaces         []string
 	WorkingDir         string
 	InsecureRegistries map[string]bool
 }
 
 func (rc *RunContext) GetKubeContext() string                 { return rc.KubeContext }
+func (rc *RunContext) GetKubeContexts() []string              { return rc.KubeContexts }
 func (rc *RunContext) GetNamespaces() []string                { return rc.Namespaces }
 func (rc *RunContext) Pipeline() latest.Pipeline              { return rc.Cfg }
 func (rc *RunContext) GetInsecureRegistries() map[string]bool { return rc.InsecureRegistries }
 func (rc *RunContext) GetWorkingDir() string                  { return rc.WorkingDir }
 
+// UsePodman tells whether the images are built with Podman rather than Docker.
+func (rc *RunContext) UsePodman() bool {
+	local := rc.Cfg.Build.LocalBuild
+	return local != nil && local.UsePodman
+}
+
 func (rc *RunContext) AddSkaffoldLabels() bool                   { return rc.Opts.AddSkaffoldLabels }
 func (rc *RunContext) AutoBuild() bool                           { return rc.Opts.AutoBuild }
 func (rc *RunContext) AutoDeploy() bool                          { return rc.Opts.AutoDeploy }
@@ -65,13 +74,14 @@ func (rc *RunContext) DryRun() bool                              { return rc.Opt
 func (rc *RunContext) ForceDeploy() bool                         { return rc.Opts.Force }
 func (rc *RunContext) GetKubeConfig() string                     { return rc.Opts.KubeConfig }
 func (rc *RunContext) GetKubeNamespace() string                  { return rc.Opts.Namespace }
+func (rc *RunContext) Interactive() bool                         { return rc.Opts.Interactive }
 func (rc *RunContext) GlobalConfig() string                      { return rc.Opts.GlobalConfig }
 func (rc *RunContext) MinikubeProfile() string                   { return rc.Opts.MinikubeProfile }
 func (rc *RunContext) DetectMinikube() bool                      { return rc.Opts.DetectMinikube }
 func (rc *RunContext) Muted() config.Muted                       { return rc.Opts.Muted }
 func (rc *RunContext) NoPruneChildren() bool                     { return rc.Opts.NoPruneChildren }
 func (rc *RunContext) Notification() bool                        { return rc.Opts.Notification }
-func (rc *RunContext) PortForward() bool                         { return rc.Opts.PortForward.Enabled }
+func (rc *RunContext) PortForward() bool                         { return rc.Opts.PortForward.Enabled() }
 func (rc *RunContext) Prune() bool                               { return rc.Opts.Prune() }
 func (rc *RunContext) RenderOnly() bool                          { return rc.Opts.RenderOnly }
 func (rc *RunContext) RenderOutput() string                      { return rc.Opts.RenderOutput }
@@ -79,27 +89,38 @@ func (rc *RunContext) SkipRender() bool                          { return rc.Opt
 func (rc *RunContext) SkipTests() bool                           { return rc.Opts.SkipTests }
 func (rc *RunContext) StatusCheck() bool                         { return rc.Opts.StatusCheck }
 func (rc *RunContext) Tail() bool                                { return rc.Opts.Tail }
+func (rc *RunContext) DeleteNamespaces() bool                    { return rc.Opts.DeleteNamespaces }
+func (rc *RunContext) Timings() bool                             { return rc.Opts.Timings }
 func (rc *RunContext) Trigger() string                           { return rc.Opts.Trigger }
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                    { return rc.Opts.WatchPollInterval }
 
 func GetRunContext(opts config.SkaffoldOptions, cfg latest.Pipeline) (*RunContext, error) {
 	kubeConfig, err := kubectx.CurrentConfig()
-	if err != nil {
+	switch {
+	case err == nil:
+	case !requiresCluster(opts.Mode(), cfg.Deploy.DeployType):
+		logrus.Debugf("unable to read kube config, continuing without a kube context: %v", err)
+	default:
 		return nil, fmt.Errorf("getting current cluster context: %w", err)
 	}
 	kubeContext := kubeConfig.CurrentContext
 	logrus.Infof("Using kubectl context: %s", kubeContext)
 
-	// TODO(dgageot): this should be the folder containing skaffold.yaml. Should also be moved elsewhere.
-	cwd, err := os.Getwd()
+	configDir := configurationDir(opts.ConfigurationFile)
+	workingDir, err := filepath.Abs(configDir)
 	if err != nil {
-		return nil, fmt.Errorf("finding current directory: %w", err)
+		return nil, fmt.Errorf("finding working directory: %w", err)
 	}
+	resolveWorkspaces(configDir, cfg.Build.Artifacts)
+	resolveDeployPaths(configDir, cfg.Deploy.DeployType)
 
-	namespaces, err := runnerutil.GetAllPodNamespaces(opts.Namespace, cfg)
-	if err != nil {
-		return nil, fmt.Errorf("getting namespace list: %w", err)
+	var namespaces []string
+	if requiresCluster(opts.Mode(), cfg.Deploy.DeployType) {
+		namespaces, err = runnerutil.GetAllPodNamespaces(opts.Namespace, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("getting namespace list: %w", err)
+		}
 	}
 
 	// combine all provided lists of insecure registries into a map
@@ -117,13 +138,112 @@ func GetRunContext(opts config.SkaffoldOptions, cfg latest.Pipeline) (*RunContex
 	return &RunContext{
 		Opts:               opts,
 		Cfg:                cfg,
-		WorkingDir:         cwd,
+		WorkingDir:         workingDir,
 		KubeContext:        kubeContext,
+		KubeContexts:       deployKubeContexts(kubeContext, cfg.Deploy.KubeContexts),
 		Namespaces:         namespaces,
 		InsecureRegistries: insecureRegistries,
 	}, nil
 }
 
+// deployKubeContexts lists all the kube-contexts to deploy to,
+// starting with the current one and without duplicates.
+func deployKubeContexts(kubeContext string, additional []string) []string {
+	kubeContexts := []string{kubeContext}
+	seen := map[string]bool{kubeContext: true}
+	for _, kctx := range additional {
+		if !seen[kctx] {
+			seen[kctx] = true
+			kubeContexts = append(kubeContexts, kctx)
+		}
+	}
+	return kubeContexts
+}
+
+// requiresCluster returns false for the commands that never talk to
+// a cluster, so that they work on machines without any kube config.
+func requiresCluster(mode config.RunMode, d latest.DeployType) bool {
+	switch mode {
+	case config.RunModes.Build, config.RunModes.Render:
+		return false
+	default:
+		return deploysToKubernetes(d)
+	}
+}
+
+// DeploysToKubernetes checks if any of the configured deployers deploys to a Kubernetes cluster.
+func (rc *RunContext) DeploysToKubernetes() bool {
+	return deploysToKubernetes(rc.Cfg.Deploy.DeployType)
+}
+
+func deploysToKubernetes(d latest.DeployType) bool {
+	return d.HelmDeploy != nil || d.KptDeploy != nil || d.KubectlDeploy != nil || d.KustomizeDeploy != nil
+}
+
+// configurationDir returns the folder containing the skaffold config file.
+// Configs read from stdin or from a URL are relative to the current directory.
+func configurationDir(configFile string) string {
+	if configFile == "" || configFile == "-" || util.IsURL(configFile) {
+		return "."
+	}
+	return filepath.Dir(configFile)
+}
+
+// resolveWorkspaces makes the artifacts' workspaces relative to the folder containing
+// the skaffold config file rather than to the current directory.
+// The sync rules are relative to the workspaces so they follow them.
+func resolveWorkspaces(configDir string, artifacts []*latest.Artifact) {
+	for _, a := range artifacts {
+		a.Workspace = rebase(configDir, a.Workspace)
+	}
+}
+
+// resolveDeployPaths makes the manifests, the kustomizations, the local charts and values files and the kpt directory
+// relative to the folder containing the skaffold config file rather than to the current directory.
+func resolveDeployPaths(configDir string, d latest.DeployType) {
+	if d.KubectlDeploy != nil {
+		for i, m := range d.KubectlDeploy.Manifests {
+			d.KubectlDeploy.Manifests[i] = rebase(configDir, m)
+		}
+	}
+
+	if d.KustomizeDeploy != nil {
+		for i, p := range d.KustomizeDeploy.KustomizePaths {
+			d.KustomizeDeploy.KustomizePaths[i] = rebase(configDir, p)
+		}
+		for _, o := range d.KustomizeDeploy.Overlays {
+			for i, p := range o.Paths {
+				o.Paths[i] = rebase(configDir, p)
+			}
+		}
+	}
+
+	if d.HelmDeploy != nil {
+		for i := range d.HelmDeploy.Releases {
+			r := &d.HelmDeploy.Releases[i]
+			if !r.Remote && r.Repo == "" {
+				r.ChartPath = rebase(configDir, r.ChartPath)
+			}
+			for j, v := range r.ValuesFiles {
+				r.ValuesFiles[j] = rebase(configDir, v)
+			}
+		}
+	}
+
+	if d.KptDeploy != nil {
+		d.KptDeploy.Dir = rebase(configDir, d.KptDeploy.Dir)
+	}
+}
+
+// rebase joins a relative path to the folder containing the skaffold config file.
+// Absolute paths and URLs are left untouched.
+func rebase(configDir, path string) string {
+	if configDir == "." || path == "" || filepath.IsAbs(path) || util.IsURL(path) {
+		return path
+	}
+	return filepath.Join(configDir, path)
+}
+
 func (rc *RunContext) UpdateNamespaces(ns []string) {
 	if len(ns) == 0 {
 		return
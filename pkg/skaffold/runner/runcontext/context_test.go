@@ -17,8 +17,11 @@ limitations under the License.
 package runcontext
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -90,3 +93,159 @@ func TestRunContext_UpdateNamespaces(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigurationDir(t *testing.T) {
+	tests := []struct {
+		description string
+		configFile  string
+		expected    string
+	}{
+		{
+			description: "default config file",
+			configFile:  "skaffold.yaml",
+			expected:    ".",
+		},
+		{
+			description: "config file in sub folder",
+			configFile:  filepath.Join("path", "to", "skaffold.yaml"),
+			expected:    filepath.Join("path", "to"),
+		},
+		{
+			description: "config read from stdin",
+			configFile:  "-",
+			expected:    ".",
+		},
+		{
+			description: "remote config",
+			configFile:  "https://example.com/skaffold.yaml",
+			expected:    ".",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, configurationDir(test.configFile))
+		})
+	}
+}
+
+func TestResolveWorkspaces(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifacts := []*latest.Artifact{
+			{ImageName: "default", Workspace: "."},
+			{ImageName: "relative", Workspace: "app"},
+			{ImageName: "absolute", Workspace: "/abs/app"},
+		}
+
+		resolveWorkspaces(filepath.Join("path", "to"), artifacts)
+
+		t.CheckDeepEqual(filepath.Join("path", "to"), artifacts[0].Workspace)
+		t.CheckDeepEqual(filepath.Join("path", "to", "app"), artifacts[1].Workspace)
+		t.CheckDeepEqual("/abs/app", artifacts[2].Workspace)
+	})
+}
+
+func TestResolveDeployPaths(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		deploy := latest.DeployType{
+			KubectlDeploy: &latest.KubectlDeploy{
+				Manifests: []string{"k8s/*.yaml", "/abs/k8s.yaml", "https://example.com/k8s.yaml"},
+			},
+			KustomizeDeploy: &latest.KustomizeDeploy{
+				KustomizePaths: []string{"."},
+				Overlays:       []latest.KustomizeOverlay{{Profile: "staging", Paths: []string{"overlays/staging"}}},
+			},
+			HelmDeploy: &latest.HelmDeploy{
+				Releases: []latest.HelmRelease{
+					{Name: "local", ChartPath: "charts/app", ValuesFiles: []string{"values.yaml"}},
+					{Name: "remote", ChartPath: "stable/nginx", Remote: true, ValuesFiles: []string{"/abs/values.yaml"}},
+					{Name: "repo", ChartPath: "nginx", Repo: "https://charts.bitnami.com/bitnami"},
+				},
+			},
+			KptDeploy: &latest.KptDeploy{Dir: "kpt"},
+		}
+
+		resolveDeployPaths(filepath.Join("path", "to"), deploy)
+
+		t.CheckDeepEqual([]string{filepath.Join("path", "to", "k8s", "*.yaml"), "/abs/k8s.yaml", "https://example.com/k8s.yaml"}, deploy.KubectlDeploy.Manifests)
+		t.CheckDeepEqual([]string{filepath.Join("path", "to")}, deploy.KustomizeDeploy.KustomizePaths)
+		t.CheckDeepEqual([]string{filepath.Join("path", "to", "overlays", "staging")}, deploy.KustomizeDeploy.Overlays[0].Paths)
+		t.CheckDeepEqual(filepath.Join("path", "to", "charts", "app"), deploy.HelmDeploy.Releases[0].ChartPath)
+		t.CheckDeepEqual([]string{filepath.Join("path", "to", "values.yaml")}, deploy.HelmDeploy.Releases[0].ValuesFiles)
+		t.CheckDeepEqual("stable/nginx", deploy.HelmDeploy.Releases[1].ChartPath)
+		t.CheckDeepEqual([]string{"/abs/values.yaml"}, deploy.HelmDeploy.Releases[1].ValuesFiles)
+		t.CheckDeepEqual("nginx", deploy.HelmDeploy.Releases[2].ChartPath)
+		t.CheckDeepEqual(filepath.Join("path", "to", "kpt"), deploy.KptDeploy.Dir)
+	})
+}
+
+func TestRebaseFromCurrentDir(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.CheckDeepEqual("./k8s/*.yaml", rebase(".", "./k8s/*.yaml"))
+	})
+}
+
+func TestRequiresCluster(t *testing.T) {
+	kubectl := latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}}
+	docker := latest.DeployType{DockerDeploy: &latest.DockerDeploy{}}
+
+	tests := []struct {
+		description string
+		mode        config.RunMode
+		deploy      latest.DeployType
+		expected    bool
+	}{
+		{description: "build", mode: config.RunModes.Build, deploy: kubectl, expected: false},
+		{description: "render", mode: config.RunModes.Render, deploy: kubectl, expected: false},
+		{description: "dev", mode: config.RunModes.Dev, deploy: kubectl, expected: true},
+		{description: "run", mode: config.RunModes.Run, deploy: kubectl, expected: true},
+		{description: "deploy", mode: config.RunModes.Deploy, deploy: kubectl, expected: true},
+		{description: "debug", mode: config.RunModes.Debug, deploy: kubectl, expected: true},
+		{description: "dev with local docker", mode: config.RunModes.Dev, deploy: docker, expected: false},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, requiresCluster(test.mode, test.deploy))
+		})
+	}
+}
+
+func TestDeploysToKubernetes(t *testing.T) {
+	tests := []struct {
+		description string
+		deploy      latest.DeployType
+		expected    bool
+	}{
+		{
+			description: "kubectl",
+			deploy:      latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+			expected:    true,
+		},
+		{
+			description: "cloud run only",
+			deploy:      latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{}},
+		},
+		{
+			description: "local docker only",
+			deploy:      latest.DeployType{DockerDeploy: &latest.DockerDeploy{}},
+		},
+		{
+			description: "cloud run and helm",
+			deploy:      latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{}, HelmDeploy: &latest.HelmDeploy{}},
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &RunContext{Cfg: latest.Pipeline{Deploy: latest.DeployConfig{DeployType: test.deploy}}}
+
+			t.CheckDeepEqual(test.expected, runCtx.DeploysToKubernetes())
+		})
+	}
+}
+
+func TestDeployKubeContexts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.CheckDeepEqual([]string{"current"}, deployKubeContexts("current", nil))
+		t.CheckDeepEqual([]string{"current", "staging", "canary"}, deployKubeContexts("current", []string{"staging", "current", "canary", "staging"}))
+	})
+}
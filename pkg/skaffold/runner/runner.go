@@ -33,6 +33,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
 )
 
 const (
@@ -48,10 +49,12 @@ type Runner interface {
 	DeployAndLog(context.Context, io.Writer, []build.Artifact) error
 	GeneratePipeline(context.Context, io.Writer, *latest.SkaffoldConfig, []string, string) error
 	Render(context.Context, io.Writer, []build.Artifact, bool, string) error
+	Diff(context.Context, io.Writer, []build.Artifact) (bool, error)
 	Cleanup(context.Context, io.Writer) error
 	Prune(context.Context, io.Writer) error
 	HasDeployed() bool
 	HasBuilt() bool
+	RunContext() *runcontext.RunContext
 }
 
 // SkaffoldRunner is responsible for running the skaffold build, test and deploy config.
@@ -64,6 +67,9 @@ type SkaffoldRunner struct {
 	monitor  filemon.Monitor
 	listener Listener
 
+	// artifactTaggers are the taggers of the artifacts that override the pipeline's tag policy.
+	artifactTaggers map[string]tag.Tagger
+
 	kubectlCLI *kubectl.CLI
 	cache      cache.Cache
 	changeSet  changeSet
@@ -78,7 +84,13 @@ type SkaffoldRunner struct {
 	hasBuilt       bool
 	hasDeployed    bool
 	intents        *intents
+	intentChan     chan<- bool
+	keys           keyState
 	devIteration   int
+	nodePlatforms  []string
+
+	// timings records the phases of each iteration when a summary is requested.
+	timings *tracing.Recorder
 }
 
 // for testing
@@ -95,3 +107,8 @@ func (r *SkaffoldRunner) HasDeployed() bool {
 func (r *SkaffoldRunner) HasBuilt() bool {
 	return r.hasBuilt
 }
+
+// RunContext returns the run context this runner was created with.
+func (r *SkaffoldRunner) RunContext() *runcontext.RunContext {
+	return r.runCtx
+}
@@ -93,10 +93,10 @@ func (t *TestBench) WithTestErrors(testErrors []error) *TestBench {
 	return t
 }
 
-func (t *TestBench) TestDependencies() ([]string, error)              { return nil, nil }
-func (t *TestBench) Dependencies() ([]string, error)                  { return nil, nil }
-func (t *TestBench) Cleanup(ctx context.Context, out io.Writer) error { return nil }
-func (t *TestBench) Prune(ctx context.Context, out io.Writer) error   { return nil }
+func (t *TestBench) TestDependencies(*latest.Artifact) ([]string, error) { return nil, nil }
+func (t *TestBench) Dependencies() ([]string, error)                     { return nil, nil }
+func (t *TestBench) Cleanup(ctx context.Context, out io.Writer) error    { return nil }
+func (t *TestBench) Prune(ctx context.Context, out io.Writer) error      { return nil }
 
 func (t *TestBench) enterNewCycle() {
 	t.actions = append(t.actions, t.currentActions)
@@ -126,7 +126,7 @@ func (t *TestBench) Build(_ context.Context, _ io.Writer, _ tag.ImageTags, artif
 	return builds, nil
 }
 
-func (t *TestBench) Sync(_ context.Context, item *sync.Item) error {
+func (t *TestBench) Sync(_ context.Context, _ io.Writer, item *sync.Item) error {
 	if len(t.syncErrors) > 0 {
 		err := t.syncErrors[0]
 		t.syncErrors = t.syncErrors[1:]
@@ -148,7 +148,7 @@ func (t *TestBench) Test(_ context.Context, _ io.Writer, artifacts []build.Artif
 		}
 	}
 
-	t.currentActions.Tested = findTags(artifacts)
+	t.currentActions.Tested = append(t.currentActions.Tested, findTags(artifacts)...)
 	return nil
 }
 
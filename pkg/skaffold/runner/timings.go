@@ -18,6 +18,7 @@ package runner
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
@@ -27,11 +28,16 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/metrics"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
+	"github.com/GoogleContainerTools/skaffold/proto"
 )
 
-// WithTimings creates a deployer that logs the duration of each phase.
+// WithTimings creates a deployer that logs the duration of each phase,
+// records it in the metrics and traces it.
 func WithTimings(b build.Builder, t test.Tester, d deploy.Deployer, cacheArtifacts bool) (build.Builder, test.Tester, deploy.Deployer) {
 	w := withTimings{
 		Builder:        b,
@@ -56,7 +62,10 @@ func (w withTimings) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 	}
 	start := time.Now()
 
+	ctx, endTrace := tracing.StartSpan(ctx, "Build", nil)
 	bRes, err := w.Builder.Build(ctx, out, tags, artifacts)
+	endTrace(err)
+	metrics.Observe(metrics.Build, start, err)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +77,10 @@ func (w withTimings) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 func (w withTimings) Test(ctx context.Context, out io.Writer, builds []build.Artifact) error {
 	start := time.Now()
 
+	ctx, endTrace := tracing.StartSpan(ctx, "Test", nil)
 	err := w.Tester.Test(ctx, out, builds)
+	endTrace(err)
+	metrics.Observe(metrics.Test, start, err)
 	if err != nil {
 		return err
 	}
@@ -81,7 +93,10 @@ func (w withTimings) Deploy(ctx context.Context, out io.Writer, builds []build.A
 	start := time.Now()
 	color.Default.Fprintln(out, "Starting deploy...")
 
+	ctx, endTrace := tracing.StartSpan(ctx, "Deploy", nil)
 	ns, err := w.Deployer.Deploy(ctx, out, builds)
+	endTrace(err)
+	metrics.Observe(metrics.Deploy, start, err)
 	if err != nil {
 		return nil, err
 	}
@@ -115,3 +130,87 @@ func (w withTimings) Prune(ctx context.Context, out io.Writer) error {
 	logrus.Infoln("Image prune complete in", time.Since(start))
 	return nil
 }
+
+// For testing
+var timingsSummaryEvent = event.TimingsSummary
+
+// timedPhases are the spans reported in the timings summary, with their labels.
+var timedPhases = map[string]func(attributes map[string]string) string{
+	"Tag":           func(map[string]string) string { return "tag" },
+	"BuildArtifact": func(a map[string]string) string { return "build " + a["artifact"] },
+	"Push":          func(a map[string]string) string { return "push " + a["image"] },
+	"Test":          func(map[string]string) string { return "test" },
+	"Sync":          func(a map[string]string) string { return "sync " + a["image"] },
+	"Deploy":        func(map[string]string) string { return "deploy" },
+	"StatusCheck":   func(map[string]string) string { return "status check" },
+}
+
+// printTimings prints how long each phase took since the last summary
+// and publishes the same breakdown as an event.
+// Pushing is reported on its own, so it's left out of the build durations.
+func (r *SkaffoldRunner) printTimings(out io.Writer) {
+	if r.timings == nil {
+		return
+	}
+
+	spans := r.timings.Spans()
+	durations := map[string]time.Duration{}
+	for _, s := range spans {
+		durations[s.ID] = s.Duration
+	}
+	for _, s := range spans {
+		if s.Name != "Push" {
+			continue
+		}
+		if build := buildArtifactAncestor(spans, s); build != nil {
+			durations[build.ID] -= s.Duration
+		}
+	}
+
+	var phases []*proto.PhaseTiming
+	for _, s := range spans {
+		label, found := timedPhases[s.Name]
+		if !found {
+			continue
+		}
+
+		phases = append(phases, &proto.PhaseTiming{
+			Phase:    label(s.Attributes),
+			Duration: durations[s.ID].Round(time.Millisecond).String(),
+			Failed:   s.Failed,
+		})
+	}
+	if len(phases) == 0 {
+		return
+	}
+
+	color.Default.Fprintln(out, "Timings:")
+	for _, p := range phases {
+		line := fmt.Sprintf(" - %s: %s", p.Phase, p.Duration)
+		if p.Failed {
+			line += " (failed)"
+		}
+		color.Default.Fprintln(out, line)
+	}
+	timingsSummaryEvent(phases)
+}
+
+// buildArtifactAncestor finds the BuildArtifact span a span was started in, if any.
+func buildArtifactAncestor(spans []tracing.Span, s tracing.Span) *tracing.Span {
+	for parentID := s.ParentID; ; {
+		var parent *tracing.Span
+		for i := range spans {
+			if spans[i].ID == parentID {
+				parent = &spans[i]
+				break
+			}
+		}
+		if parent == nil {
+			return nil
+		}
+		if parent.Name == "BuildArtifact" {
+			return parent
+		}
+		parentID = parent.ParentID
+	}
+}
@@ -21,16 +21,21 @@ import (
 	"context"
 	"errors"
 	"io"
+	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"go.opencensus.io/trace"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tracing"
+	"github.com/GoogleContainerTools/skaffold/proto"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -272,3 +277,70 @@ func lastInfoEntry(hook *logrustest.Hook) string {
 	}
 	return ""
 }
+
+func TestPrintTimings(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var summary []*proto.PhaseTiming
+		t.Override(&timingsSummaryEvent, func(phases []*proto.PhaseTiming) { summary = phases })
+		r := &SkaffoldRunner{timings: tracing.NewRecorder()}
+		defer r.timings.Stop()
+
+		builder, _, deployer := WithTimings(&mockBuilder{}, nil, &mockDeployer{err: true}, false)
+		ctx, endBuild := tracing.StartSpan(context.Background(), "BuildArtifact", map[string]string{"artifact": "app"})
+		_, endPush := tracing.StartSpan(ctx, "Push", map[string]string{"image": "gcr.io/project/app:tag"})
+		endPush(nil)
+		endBuild(nil)
+		builder.Build(context.Background(), ioutil.Discard, nil, []*latest.Artifact{{ImageName: "app"}})
+		deployer.Deploy(context.Background(), ioutil.Discard, nil)
+
+		var out bytes.Buffer
+		r.printTimings(&out)
+
+		t.CheckMatches(`^Timings:
+ - push gcr.io/project/app:tag: \d+(\.\d+)?[µnm]?s
+ - build app: \d+(\.\d+)?[µnm]?s
+ - deploy: \d+(\.\d+)?[µnm]?s \(failed\)
+$`, out.String())
+
+		t.CheckDeepEqual(3, len(summary))
+		t.CheckDeepEqual("push gcr.io/project/app:tag", summary[0].Phase)
+		t.CheckDeepEqual("build app", summary[1].Phase)
+		t.CheckDeepEqual("deploy", summary[2].Phase)
+		t.CheckTrue(summary[2].Failed)
+	})
+}
+
+func TestPrintTimingsExcludesPushFromBuild(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var summary []*proto.PhaseTiming
+		t.Override(&timingsSummaryEvent, func(phases []*proto.PhaseTiming) { summary = phases })
+		r := &SkaffoldRunner{timings: &tracing.Recorder{}}
+
+		start := time.Now()
+		build := trace.SpanContext{SpanID: trace.SpanID{1}}
+		upload := trace.SpanContext{SpanID: trace.SpanID{2}}
+		r.timings.ExportSpan(&trace.SpanData{SpanContext: trace.SpanContext{SpanID: trace.SpanID{3}}, ParentSpanID: upload.SpanID, Name: "Push", Attributes: map[string]interface{}{"image": "app:v1"}, StartTime: start.Add(6 * time.Second), EndTime: start.Add(10 * time.Second)})
+		r.timings.ExportSpan(&trace.SpanData{SpanContext: upload, ParentSpanID: build.SpanID, Name: "Upload", StartTime: start.Add(6 * time.Second), EndTime: start.Add(10 * time.Second)})
+		r.timings.ExportSpan(&trace.SpanData{SpanContext: build, Name: "BuildArtifact", Attributes: map[string]interface{}{"artifact": "app"}, StartTime: start, EndTime: start.Add(10 * time.Second)})
+		r.timings.ExportSpan(&trace.SpanData{SpanContext: trace.SpanContext{SpanID: trace.SpanID{4}}, Name: "Push", Attributes: map[string]interface{}{"image": "other:v1"}, StartTime: start.Add(10 * time.Second), EndTime: start.Add(12 * time.Second)})
+
+		r.printTimings(ioutil.Discard)
+
+		t.CheckDeepEqual([]*proto.PhaseTiming{
+			{Phase: "push app:v1", Duration: "4s"},
+			{Phase: "build app", Duration: "6s"},
+			{Phase: "push other:v1", Duration: "2s"},
+		}, summary)
+	})
+}
+
+func TestPrintTimingsDisabled(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		r := &SkaffoldRunner{}
+
+		var out bytes.Buffer
+		r.printTimings(&out)
+
+		t.CheckEmpty(out.String())
+	})
+}
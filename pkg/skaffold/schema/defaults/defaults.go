@@ -35,6 +35,7 @@ const (
 	defaultCloudBuildGradleImage = "gcr.io/cloud-builders/gradle"
 	defaultCloudBuildKanikoImage = kaniko.DefaultImage
 	defaultCloudBuildPackImage   = "gcr.io/k8s-skaffold/pack"
+	defaultCodeBuildImage        = "aws/codebuild/standard:4.0"
 )
 
 // Set makes sure default values are set on a SkaffoldConfig.
@@ -50,7 +51,7 @@ func Set(c *latest.SkaffoldConfig) error {
 		setDefaultWorkspace(a)
 		setDefaultSync(a)
 
-		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil {
+		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil && a.KoArtifact == nil {
 			defaultToKanikoArtifact(a)
 		} else {
 			defaultToDockerArtifact(a)
@@ -68,6 +69,9 @@ func Set(c *latest.SkaffoldConfig) error {
 
 		case a.BuildpackArtifact != nil:
 			setBuildpackArtifactDefaults(a.BuildpackArtifact)
+
+		case a.KoArtifact != nil:
+			setKoArtifactDefaults(a.KoArtifact)
 		}
 
 		for _, d := range a.Dependencies {
@@ -87,6 +91,10 @@ func Set(c *latest.SkaffoldConfig) error {
 		setDefaultCloudBuildPackImage,
 	)
 
+	withCodeBuildConfig(c,
+		setDefaultCodeBuildImage,
+	)
+
 	if err := withClusterConfig(c,
 		setDefaultClusterNamespace,
 		setDefaultClusterTimeout,
@@ -145,6 +153,18 @@ func withCloudBuildConfig(c *latest.SkaffoldConfig, operations ...func(*latest.G
 	}
 }
 
+func withCodeBuildConfig(c *latest.SkaffoldConfig, operations ...func(*latest.AWSCodeBuild)) {
+	if cb := c.Build.AWSCodeBuild; cb != nil {
+		for _, operation := range operations {
+			operation(cb)
+		}
+	}
+}
+
+func setDefaultCodeBuildImage(cb *latest.AWSCodeBuild) {
+	cb.Image = valueOrDefault(cb.Image, defaultCodeBuildImage)
+}
+
 func setDefaultCloudBuildDockerImage(gcb *latest.GoogleCloudBuild) {
 	gcb.DockerImage = valueOrDefault(gcb.DockerImage, defaultCloudBuildDockerImage)
 }
@@ -222,6 +242,16 @@ func setBuildpackArtifactDefaults(a *latest.BuildpackArtifact) {
 	}
 }
 
+func setKoArtifactDefaults(a *latest.KoArtifact) {
+	a.FromImage = valueOrDefault(a.FromImage, constants.DefaultKoBaseImage)
+	a.Main = valueOrDefault(a.Main, ".")
+	if a.Dependencies == nil {
+		a.Dependencies = &latest.KoDependencies{
+			Paths: []string{"."},
+		}
+	}
+}
+
 func setDockerArtifactDefaults(a *latest.DockerArtifact) {
 	a.DockerfilePath = valueOrDefault(a.DockerfilePath, constants.DefaultDockerfilePath)
 }
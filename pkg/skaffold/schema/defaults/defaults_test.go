@@ -76,6 +76,12 @@ func TestSetDefaults(t *testing.T) {
 							BuildpackArtifact: &latest.BuildpackArtifact{},
 						},
 					},
+					{
+						ImageName: "seventh",
+						ArtifactType: latest.ArtifactType{
+							KoArtifact: &latest.KoArtifact{},
+						},
+					},
 				},
 			},
 		},
@@ -113,6 +119,11 @@ func TestSetDefaults(t *testing.T) {
 	testutil.CheckDeepEqual(t, []string(nil), cfg.Build.Artifacts[5].BuildpackArtifact.Dependencies.Ignore)
 	testutil.CheckDeepEqual(t, "project.toml", cfg.Build.Artifacts[5].BuildpackArtifact.ProjectDescriptor)
 	testutil.CheckDeepEqual(t, &latest.Auto{}, cfg.Build.Artifacts[5].Sync.Auto)
+
+	testutil.CheckDeepEqual(t, "seventh", cfg.Build.Artifacts[6].ImageName)
+	testutil.CheckDeepEqual(t, "gcr.io/distroless/static:nonroot", cfg.Build.Artifacts[6].KoArtifact.FromImage)
+	testutil.CheckDeepEqual(t, ".", cfg.Build.Artifacts[6].KoArtifact.Main)
+	testutil.CheckDeepEqual(t, []string{"."}, cfg.Build.Artifacts[6].KoArtifact.Dependencies.Paths)
 }
 
 func TestSetDefaultsOnCluster(t *testing.T) {
@@ -286,6 +297,27 @@ func TestSetDefaultsOnCloudBuild(t *testing.T) {
 	testutil.CheckDeepEqual(t, defaultCloudBuildPackImage, cfg.Build.GoogleCloudBuild.PackImage)
 }
 
+func TestSetDefaultsOnCodeBuild(t *testing.T) {
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{
+					{ImageName: "image"},
+				},
+				BuildType: latest.BuildType{
+					AWSCodeBuild: &latest.AWSCodeBuild{ProjectName: "project", Bucket: "bucket"},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, defaultCodeBuildImage, cfg.Build.AWSCodeBuild.Image)
+	testutil.CheckDeepEqual(t, "Dockerfile", cfg.Build.Artifacts[0].DockerArtifact.DockerfilePath)
+}
+
 func TestSetDefaultsOnLocalBuild(t *testing.T) {
 	cfg := &latest.SkaffoldConfig{}
 
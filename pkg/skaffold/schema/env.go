@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ExpandEnvTemplates expands the environment variables used in the image names
+// and in the deploy flags. The fields that are expanded later on, like build args,
+// Helm release names or namespaces, are only checked. An error lists all the
+// variables that are not set.
+func ExpandEnvTemplates(c *latest.SkaffoldConfig) error {
+	e := &envTemplates{}
+
+	for _, a := range c.Build.Artifacts {
+		e.expand(&a.ImageName)
+		for _, d := range a.Dependencies {
+			e.expand(&d.ImageName)
+		}
+		if a.DockerArtifact != nil {
+			e.checkMap(a.DockerArtifact.BuildArgs)
+		}
+		if a.KanikoArtifact != nil {
+			e.checkMap(a.KanikoArtifact.BuildArgs)
+		}
+	}
+
+	deploy := c.Deploy.DeployType
+	if deploy.KubectlDeploy != nil {
+		e.expandKubectlFlags(&deploy.KubectlDeploy.Flags)
+		e.checkPtr(deploy.KubectlDeploy.DefaultNamespace)
+	}
+	if deploy.KustomizeDeploy != nil {
+		e.expandKubectlFlags(&deploy.KustomizeDeploy.Flags)
+		e.checkPtr(deploy.KustomizeDeploy.DefaultNamespace)
+	}
+	if deploy.HelmDeploy != nil {
+		e.expandAll(deploy.HelmDeploy.Flags.Global)
+		e.expandAll(deploy.HelmDeploy.Flags.Install)
+		e.expandAll(deploy.HelmDeploy.Flags.Upgrade)
+		for _, r := range deploy.HelmDeploy.Releases {
+			for k, v := range r.ArtifactOverrides {
+				e.expand(&v)
+				r.ArtifactOverrides[k] = v
+			}
+			e.check(r.Name)
+			e.check(r.Namespace)
+			e.expandAll(r.Flags.Install)
+			e.expandAll(r.Flags.Upgrade)
+		}
+	}
+	if deploy.DockerDeploy != nil {
+		for i := range deploy.DockerDeploy.Containers {
+			e.expand(&deploy.DockerDeploy.Containers[i].Image)
+		}
+	}
+	if deploy.CloudRunDeploy != nil {
+		for i := range deploy.CloudRunDeploy.Services {
+			e.expand(&deploy.CloudRunDeploy.Services[i].Image)
+			e.expandAll(deploy.CloudRunDeploy.Services[i].Flags)
+		}
+	}
+
+	return e.err()
+}
+
+// envTemplates collects the environment variables that are not set
+// while going through the templated fields of a config.
+type envTemplates struct {
+	unresolved []string
+	errs       []string
+}
+
+func (e *envTemplates) expand(s *string) {
+	if !e.check(*s) {
+		return
+	}
+
+	expanded, err := util.ExpandEnvTemplate(*s, nil)
+	if err != nil {
+		e.errs = append(e.errs, err.Error())
+		return
+	}
+	*s = expanded
+}
+
+func (e *envTemplates) expandAll(values []string) {
+	for i := range values {
+		e.expand(&values[i])
+	}
+}
+
+func (e *envTemplates) expandKubectlFlags(flags *latest.KubectlFlags) {
+	e.expandAll(flags.Global)
+	e.expandAll(flags.Apply)
+	e.expandAll(flags.Delete)
+}
+
+// check tells if s is a template that only uses environment variables that are set.
+func (e *envTemplates) check(s string) bool {
+	if !strings.Contains(s, "{{") {
+		return false
+	}
+
+	unresolved, err := util.UnresolvedEnvVars(s, nil)
+	if err != nil {
+		e.errs = append(e.errs, err.Error())
+		return false
+	}
+	for _, name := range unresolved {
+		if !util.StrSliceContains(e.unresolved, name) {
+			e.unresolved = append(e.unresolved, name)
+		}
+	}
+	return len(unresolved) == 0
+}
+
+func (e *envTemplates) checkPtr(s *string) {
+	if s != nil {
+		e.check(*s)
+	}
+}
+
+func (e *envTemplates) checkMap(m map[string]*string) {
+	for _, v := range m {
+		e.checkPtr(v)
+	}
+}
+
+func (e *envTemplates) err() error {
+	if len(e.unresolved) > 0 {
+		e.errs = append(e.errs, fmt.Sprintf("environment variables not set: %s", strings.Join(e.unresolved, ", ")))
+	}
+	if len(e.errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(e.errs, " | "))
+}
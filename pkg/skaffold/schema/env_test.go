@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExpandEnvTemplates(t *testing.T) {
+	tests := []struct {
+		description string
+		config      latest.SkaffoldConfig
+		expected    latest.SkaffoldConfig
+		shouldErr   bool
+		expectedErr string
+	}{
+		{
+			description: "expand image names and deploy flags",
+			config: latest.SkaffoldConfig{Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{Artifacts: []*latest.Artifact{
+					{ImageName: "{{.REPO}}/app", Dependencies: []*latest.ArtifactDependency{{ImageName: "{{.REPO}}/base"}}},
+					{ImageName: "{{.REPO}}/base"},
+				}},
+				Deploy: latest.DeployConfig{DeployType: latest.DeployType{
+					HelmDeploy: &latest.HelmDeploy{
+						Flags:    latest.HelmDeployFlags{Install: []string{"--timeout={{.TIMEOUT}}"}},
+						Releases: []latest.HelmRelease{{Name: "{{.REPO}}", ArtifactOverrides: map[string]string{"image": "{{.REPO}}/app"}, Flags: latest.HelmReleaseFlags{Upgrade: []string{"--timeout={{.TIMEOUT}}"}}}},
+					},
+				}},
+			}},
+			expected: latest.SkaffoldConfig{Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{Artifacts: []*latest.Artifact{
+					{ImageName: "gcr.io/project/app", Dependencies: []*latest.ArtifactDependency{{ImageName: "gcr.io/project/base"}}},
+					{ImageName: "gcr.io/project/base"},
+				}},
+				Deploy: latest.DeployConfig{DeployType: latest.DeployType{
+					HelmDeploy: &latest.HelmDeploy{
+						Flags:    latest.HelmDeployFlags{Install: []string{"--timeout=5m"}},
+						Releases: []latest.HelmRelease{{Name: "{{.REPO}}", ArtifactOverrides: map[string]string{"image": "gcr.io/project/app"}, Flags: latest.HelmReleaseFlags{Upgrade: []string{"--timeout=5m"}}}},
+					},
+				}},
+			}},
+		},
+		{
+			description: "list unresolved variables",
+			config: latest.SkaffoldConfig{Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{
+					ImageName: "{{.MISSING}}/app",
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+						BuildArgs: map[string]*string{"arg": util.StringPtr("{{.OTHER}}")},
+					}},
+				}}},
+				Deploy: latest.DeployConfig{DeployType: latest.DeployType{
+					KubectlDeploy: &latest.KubectlDeploy{
+						Flags:            latest.KubectlFlags{Apply: []string{"--selector={{.MISSING}}"}},
+						DefaultNamespace: util.StringPtr("{{.NAMESPACE}}"),
+					},
+				}},
+			}},
+			shouldErr:   true,
+			expectedErr: "environment variables not set: MISSING, OTHER, NAMESPACE",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.OSEnviron, func() []string { return []string{"REPO=gcr.io/project", "TIMEOUT=5m"} })
+
+			err := ExpandEnvTemplates(&test.config)
+
+			if test.shouldErr {
+				t.CheckErrorContains(test.expectedErr, err)
+				return
+			}
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, test.config)
+		})
+	}
+}
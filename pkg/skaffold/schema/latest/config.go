@@ -44,10 +44,37 @@ type SkaffoldConfig struct {
 	// Pipeline defines the Build/Test/Deploy phases.
 	Pipeline `yaml:",inline"`
 
+	// Dependencies describes a list of other required configs for the current config.
+	Dependencies []ConfigDependency `yaml:"requires,omitempty"`
+
 	// Profiles *beta* can override be used to `build`, `test` or `deploy` configuration.
 	Profiles []Profile `yaml:"profiles,omitempty"`
 }
 
+// ConfigDependency describes a dependency on another skaffold configuration.
+type ConfigDependency struct {
+	// Names includes specific named configs within the file path. If empty, then the config is included regardless of its name.
+	Names []string `yaml:"configs,omitempty"`
+
+	// Path describes the path to the file containing the required configs.
+	// It can be the path to a directory containing a `skaffold.yaml` file.
+	// Relative paths are resolved against the directory of the current config.
+	Path string `yaml:"path,omitempty" yamltags:"required"`
+
+	// ActiveProfiles describes the list of profiles to activate when resolving the required configs.
+	ActiveProfiles []ProfileDependency `yaml:"activeProfiles,omitempty"`
+}
+
+// ProfileDependency describes a mapping from referenced config profiles to the current config profiles.
+// If the current config is activated with a profile in this mapping then the dependency configs are also activated with the corresponding mapped profiles.
+type ProfileDependency struct {
+	// Name describes name of the profile to activate in the dependency config. It should exist in the dependency config.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// ActivatedBy describes a list of profiles in the current config that when activated will also activate the named profile in the dependency config. If empty then the named profile is always activated.
+	ActivatedBy []string `yaml:"activatedBy,omitempty"`
+}
+
 // Metadata holds an optional name of the project.
 type Metadata struct {
 	// Name is an identifier for the project.
@@ -131,11 +158,19 @@ type TagPolicy struct {
 
 	// CustomTemplateTagger *beta* tags images with a configurable template string *composed of other taggers*.
 	CustomTemplateTagger *CustomTemplateTagger `yaml:"customTemplate,omitempty" yamltags:"oneOf=tag"`
+
+	// InputDigest *alpha* tags images with a digest of their build inputs.
+	InputDigest *InputDigest `yaml:"inputDigest,omitempty" yamltags:"oneOf=tag"`
 }
 
 // ShaTagger *beta* tags images with their sha256 digest.
 type ShaTagger struct{}
 
+// InputDigest *alpha* tags images with a digest of their build inputs:
+// the artifact's configuration, source files, build args and required artifacts.
+// The same sources always produce the same tag.
+type InputDigest struct{}
+
 // GitTagger *beta* tags images with the git tag or commit of the artifact's workspace.
 type GitTagger struct {
 	// Variant determines the behavior of the git tagger. Valid variants are:
@@ -148,6 +183,10 @@ type GitTagger struct {
 
 	// Prefix adds a fixed prefix to the tag.
 	Prefix string `yaml:"prefix,omitempty"`
+
+	// DirtySuffix is appended to the tag when the worktree has uncommitted changes.
+	// Defaults to `-dirty`.
+	DirtySuffix string `yaml:"dirtySuffix,omitempty"`
 }
 
 // EnvTemplateTagger *beta* tags images with a configurable template string.
@@ -177,7 +216,8 @@ type DateTimeTagger struct {
 type CustomTemplateTagger struct {
 	// Template used to produce the image name and tag.
 	// See golang [text/template](https://golang.org/pkg/text/template/).
-	// The template is executed against the provided components with those variables injected.
+	// The template is executed against the provided components with those variables injected,
+	// as well as `IMAGE_NAME` and the environment variables.
 	// For example: `{{.DATE}}` where DATE references a TaggerComponent.
 	Template string `yaml:"template,omitempty" yamltags:"required"`
 
@@ -205,6 +245,14 @@ type BuildType struct {
 	// [Google Cloud Build](https://cloud.google.com/cloud-build/).
 	GoogleCloudBuild *GoogleCloudBuild `yaml:"googleCloudBuild,omitempty" yamltags:"oneOf=build"`
 
+	// AWSCodeBuild *alpha* describes how to do a remote build on
+	// [AWS CodeBuild](https://aws.amazon.com/codebuild/).
+	AWSCodeBuild *AWSCodeBuild `yaml:"awsCodeBuild,omitempty" yamltags:"oneOf=build"`
+
+	// AzureContainerRegistry *alpha* describes how to do a remote build with
+	// [Azure Container Registry Tasks](https://docs.microsoft.com/en-us/azure/container-registry/container-registry-tasks-overview).
+	AzureContainerRegistry *AzureContainerRegistry `yaml:"azureContainerRegistry,omitempty" yamltags:"oneOf=build"`
+
 	// Cluster *beta* describes how to do an on-cluster build.
 	Cluster *ClusterDetails `yaml:"cluster,omitempty" yamltags:"oneOf=build"`
 }
@@ -219,6 +267,7 @@ type LocalBuild struct {
 
 	// TryImportMissing whether to attempt to import artifacts from
 	// Docker (either a local or remote registry) if not in the cache.
+	// Images that are pushed are looked up in the registry without being pulled.
 	TryImportMissing bool `yaml:"tryImportMissing,omitempty"`
 
 	// UseDockerCLI use `docker` command-line interface instead of Docker Engine APIs.
@@ -227,9 +276,18 @@ type LocalBuild struct {
 	// UseBuildkit use BuildKit to build Docker images.
 	UseBuildkit bool `yaml:"useBuildkit,omitempty"`
 
+	// UsePodman use `podman` command-line interface instead of Docker to build images.
+	// The images are then pushed and inspected through the Podman API service
+	// and loaded into local clusters with `podman save`.
+	UsePodman bool `yaml:"usePodman,omitempty"`
+
 	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
 	// Defaults to `1`.
 	Concurrency *int `yaml:"concurrency,omitempty"`
+
+	// InterleaveLogs prints the logs of concurrent builds as they are produced,
+	// each line prefixed with the name of the artifact, instead of one build after the other.
+	InterleaveLogs bool `yaml:"interleaveLogs,omitempty"`
 }
 
 // GoogleCloudBuild *beta* describes how to do a remote build on
@@ -305,6 +363,81 @@ type GoogleCloudBuild struct {
 	WorkerPool string `yaml:"workerPool,omitempty"`
 }
 
+// AWSCodeBuild *alpha* describes how to do a remote build on
+// [AWS CodeBuild](https://aws.amazon.com/codebuild/).
+// Docker artifacts can be built on CodeBuild and pushed to Amazon ECR.
+// The `aws` CLI needs to be installed and the current user should be given
+// permissions to upload to the `bucket` and to start builds of the `projectName`.
+type AWSCodeBuild struct {
+	// ProjectName is the name of the CodeBuild project that runs the builds.
+	// Its service role needs permissions to read from the `bucket` and to push to ECR.
+	ProjectName string `yaml:"projectName" yamltags:"required"`
+
+	// Bucket is the S3 bucket where the sources are uploaded before each build.
+	Bucket string `yaml:"bucket" yamltags:"required"`
+
+	// Region is the AWS region of the CodeBuild project.
+	// If it is not provided, the region configured for the `aws` CLI is used.
+	Region string `yaml:"region,omitempty"`
+
+	// Profile is the `aws` CLI profile used to run the builds.
+	// If it is not provided, the default profile is used.
+	Profile string `yaml:"profile,omitempty"`
+
+	// Image is the image of the build environment. It needs to have Docker and the `aws` CLI installed.
+	// See [CodeBuild images](https://docs.aws.amazon.com/codebuild/latest/userguide/build-env-ref-available.html).
+	// Defaults to `aws/codebuild/standard:4.0`.
+	Image string `yaml:"image,omitempty"`
+
+	// ComputeType is the type of the compute resources that run the build.
+	// For example, `BUILD_GENERAL1_SMALL` or `BUILD_GENERAL1_LARGE`.
+	// Defaults to the compute type of the project.
+	ComputeType string `yaml:"computeType,omitempty"`
+
+	// TimeoutMinutes is the number of minutes after which a build is stopped.
+	// Defaults to the timeout of the project.
+	TimeoutMinutes int `yaml:"timeoutMinutes,omitempty"`
+
+	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
+	// Defaults to `0`.
+	Concurrency int `yaml:"concurrency,omitempty"`
+}
+
+// AzureContainerRegistry *alpha* describes how to do a remote build with
+// [Azure Container Registry Tasks](https://docs.microsoft.com/en-us/azure/container-registry/container-registry-tasks-overview).
+// Docker artifacts can be built by ACR Tasks and pushed to the registry that runs the build.
+// The `az` CLI needs to be installed and the currently logged in user should be given
+// permissions to run builds on the registry.
+type AzureContainerRegistry struct {
+	// Registry is the name of the registry that runs the builds and where the images are pushed.
+	// If it is not provided, Skaffold will guess it from the image name.
+	// For example, given the artifact image name `myregistry.azurecr.io/image`, Skaffold
+	// will use the `myregistry` registry.
+	Registry string `yaml:"registry,omitempty"`
+
+	// ResourceGroup is the resource group of the registry.
+	ResourceGroup string `yaml:"resourceGroup,omitempty"`
+
+	// Subscription is the name or the ID of the subscription of the registry.
+	// If it is not provided, the current subscription of the `az` CLI is used.
+	Subscription string `yaml:"subscription,omitempty"`
+
+	// Platform is the platform of the images, for example `linux/arm64`.
+	// Defaults to the default platform of ACR Tasks.
+	Platform string `yaml:"platform,omitempty"`
+
+	// AgentPool is the name of a dedicated agent pool that runs the builds.
+	AgentPool string `yaml:"agentPool,omitempty"`
+
+	// Timeout is the amount of time (in seconds) that a build should be allowed to run.
+	// Defaults to the default timeout of ACR Tasks.
+	Timeout int `yaml:"timeout,omitempty"`
+
+	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
+	// Defaults to `0`.
+	Concurrency int `yaml:"concurrency,omitempty"`
+}
+
 // KanikoCache configures Kaniko caching. If a cache is specified, Kaniko will
 // use a remote cache which will speed up builds.
 type KanikoCache struct {
@@ -429,6 +562,22 @@ type TestCase struct {
 	// to run on that artifact.
 	// For example: `["./test/*"]`.
 	StructureTests []string `yaml:"structureTests,omitempty"`
+
+	// CustomTests lists the commands to run on that artifact.
+	CustomTests []CustomTest `yaml:"custom,omitempty"`
+}
+
+// CustomTest describes a command to run on a built image.
+type CustomTest struct {
+	// Command is the command to run, from the Skaffold root directory.
+	// The built image is available in the `$IMAGE` environment variable.
+	// For example: `./scripts/smoke-test.sh`.
+	Command string `yaml:"command" yamltags:"required"`
+
+	// Dependencies are the file patterns that the test depends on.
+	// The test is run again in dev mode when any of those files change.
+	// For example: `["./scripts/*", "./test/**"]`.
+	Dependencies []string `yaml:"dependencies,omitempty"`
 }
 
 // DeployConfig contains all the configuration needed by the deploy steps.
@@ -438,18 +587,128 @@ type DeployConfig struct {
 	// StatusCheckDeadlineSeconds *beta* is the deadline for deployments to stabilize in seconds.
 	StatusCheckDeadlineSeconds int `yaml:"statusCheckDeadlineSeconds,omitempty"`
 
+	// StatusCheckResources *beta* lists the kinds of custom resources that are checked with their status conditions,
+	// in addition to deployments, statefulsets, daemonsets and jobs.
+	StatusCheckResources []StatusCheckResource `yaml:"statusCheckResources,omitempty"`
+
+	// Strategy *alpha* describes how the new version of the Deployments replaces the running one.
+	// Only kubectl and kustomize deployments support strategies: other deployers are rejected.
+	// Defaults to the rolling update of each Deployment.
+	Strategy *DeployStrategy `yaml:"strategy,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after every deploy.
+	LifecycleHooks DeployHooks `yaml:"hooks,omitempty"`
+
 	// KubeContext is the Kubernetes context that Skaffold should deploy to.
 	// For example: `minikube`.
 	KubeContext string `yaml:"kubeContext,omitempty"`
 
+	// KubeContexts lists additional Kubernetes contexts that Skaffold should deploy to.
+	// Images are built for the main `kubeContext` and the same deployment is then applied to each of these contexts.
+	// For example: `["staging", "canary"]`.
+	KubeContexts []string `yaml:"kubeContexts,omitempty"`
+
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
 }
 
+// StatusCheckResource describes how to check the status of a kind of custom resources.
+type StatusCheckResource struct {
+	// Kind is the kind of the resources, as understood by `kubectl get` (Required).
+	// For example: `certificates.cert-manager.io`.
+	Kind string `yaml:"kind" yamltags:"required"`
+
+	// Condition is the type of the status condition that is `True` once a resource is ready.
+	// Defaults to `Ready`.
+	Condition string `yaml:"condition,omitempty"`
+}
+
+// DeployHooks describes the list of lifecycle hooks to execute before and after each deploy step.
+type DeployHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each deploy step.
+	PreHooks []DeployHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each deploy step,
+	// once the deployed resources are healthy.
+	PostHooks []DeployHookItem `yaml:"after,omitempty"`
+}
+
+// DeployHookItem describes a single lifecycle hook to execute before or after each deploy step.
+type DeployHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on a container.
+	ContainerHook *NamedContainerHook `yaml:"container,omitempty" yamltags:"oneOf=hook"`
+}
+
+// HostHook describes a lifecycle hook definition to execute on the host machine.
+type HostHook struct {
+	// Command is the command to execute (Required).
+	// Each argument is a template that can use the environment variables set for the hook.
+	Command []string `yaml:"command" yamltags:"required"`
+
+	// OS is an optional slice of operating system names, as known to Go. If set, the hook is skipped on other operating systems.
+	// For example: `[darwin, linux]`.
+	OS []string `yaml:"os,omitempty"`
+}
+
+// ContainerHook describes a lifecycle hook definition to execute on a container.
+// The container is inferred from the scope in which this hook is defined.
+type ContainerHook struct {
+	// Command is the command to execute (Required).
+	// Each argument is a template that can use the environment variables set for the hook.
+	Command []string `yaml:"command" yamltags:"required"`
+}
+
+// NamedContainerHook describes a lifecycle hook definition to execute on a named container.
+type NamedContainerHook struct {
+	// ContainerHook describes the command to execute.
+	ContainerHook `yaml:",inline"`
+
+	// PodName is the name of the pods to execute the command in (Required).
+	// It can be a glob pattern. For example: `web-*`.
+	PodName string `yaml:"podName" yamltags:"required"`
+
+	// ContainerName is the name of the container to execute the command in.
+	// Defaults to the default container of the pod.
+	ContainerName string `yaml:"containerName,omitempty"`
+}
+
+// DeployStrategy describes how the new version of the Deployments replaces the running one.
+type DeployStrategy struct {
+	// Canary first runs a few replicas of the new version next to the running one.
+	// Once they are healthy, the Deployments are updated and the canaries are deleted.
+	Canary *CanaryStrategy `yaml:"canary,omitempty" yamltags:"oneOf=strategy"`
+
+	// BlueGreen updates the Deployments with pods of a new color while copies of the running Deployments
+	// keep on serving the live color. Once the Deployments are healthy, the selectors of the Services
+	// are switched over to the new color and the copies are deleted.
+	BlueGreen *BlueGreenStrategy `yaml:"blueGreen,omitempty" yamltags:"oneOf=strategy"`
+}
+
+// CanaryStrategy describes a canary rollout.
+type CanaryStrategy struct {
+	// Replicas is the number of replicas of each canary Deployment. Defaults to `1`.
+	Replicas int `yaml:"replicas,omitempty"`
+}
+
+// BlueGreenStrategy describes a blue/green rollout.
+type BlueGreenStrategy struct {
+	// KeepPrevious keeps the copies of the previous version running after the switch, to allow for a quick rollback.
+	KeepPrevious bool `yaml:"keepPrevious,omitempty"`
+}
+
 // DeployType contains the specific implementation and parameters needed
 // for the deploy step. All three deployer types can be used at the same
 // time for hybrid workflows.
 type DeployType struct {
+	// CloudRunDeploy *alpha* uses the `gcloud` CLI to deploy services to fully managed Cloud Run.
+	CloudRunDeploy *CloudRunDeploy `yaml:"cloudrun,omitempty"`
+
+	// DockerDeploy *alpha* runs the built images as local Docker containers, without Kubernetes.
+	DockerDeploy *DockerDeploy `yaml:"docker,omitempty"`
+
 	// HelmDeploy *beta* uses the `helm` CLI to apply the charts to the cluster.
 	HelmDeploy *HelmDeploy `yaml:"helm,omitempty"`
 
@@ -522,12 +781,25 @@ type HelmDeployFlags struct {
 	Upgrade []string `yaml:"upgrade,omitempty"`
 }
 
+// HelmReleaseFlags are additional flags passed to Helm CLI for a single release.
+type HelmReleaseFlags struct {
+	// Install are additional flags passed to (`helm install`).
+	Install []string `yaml:"install,omitempty"`
+
+	// Upgrade are additional flags passed to (`helm upgrade`).
+	Upgrade []string `yaml:"upgrade,omitempty"`
+}
+
 // KustomizeDeploy *beta* uses the `kustomize` CLI to "patch" a deployment for a target environment.
 type KustomizeDeploy struct {
 	// KustomizePaths is the path to Kustomization files.
 	// Defaults to `["."]`.
 	KustomizePaths []string `yaml:"paths,omitempty"`
 
+	// Overlays are the Kustomization files deployed instead of `paths` when a given profile is activated.
+	// When several of these profiles are activated, the last one wins.
+	Overlays []KustomizeOverlay `yaml:"overlays,omitempty"`
+
 	// Flags are additional flags passed to `kubectl`.
 	Flags KubectlFlags `yaml:"flags,omitempty"`
 
@@ -538,6 +810,80 @@ type KustomizeDeploy struct {
 	DefaultNamespace *string `yaml:"defaultNamespace,omitempty"`
 }
 
+// KustomizeOverlay maps a profile to the Kustomization files it deploys.
+type KustomizeOverlay struct {
+	// Profile is the name of the profile that selects this overlay.
+	Profile string `yaml:"profile" yamltags:"required"`
+
+	// Paths are the paths to the Kustomization files of the overlay.
+	// For example: `["overlays/staging"]`.
+	Paths []string `yaml:"paths" yamltags:"required"`
+}
+
+// CloudRunDeploy *alpha* uses the `gcloud` CLI to deploy services to fully managed Cloud Run.
+type CloudRunDeploy struct {
+	// ProjectID is the GCP project the services are deployed to.
+	// Defaults to the project configured for `gcloud`.
+	ProjectID string `yaml:"projectId,omitempty"`
+
+	// Region is the Cloud Run region the services are deployed to (Required).
+	// For example: `us-central1`.
+	Region string `yaml:"region" yamltags:"required"`
+
+	// Services lists the Cloud Run services to deploy.
+	Services []CloudRunService `yaml:"services,omitempty"`
+}
+
+// CloudRunService describes a Cloud Run service.
+type CloudRunService struct {
+	// Name is the name of the service (Required).
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Image is the name of the image to deploy (Required).
+	// If it's the name of a built artifact, the built image is deployed.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Traffic splits the traffic between the revisions of the service.
+	// By default, all the traffic goes to the latest revision.
+	Traffic []CloudRunTrafficTarget `yaml:"traffic,omitempty"`
+
+	// Flags are additional flags passed to `gcloud run deploy`.
+	// For example: `["--allow-unauthenticated"]`.
+	Flags []string `yaml:"flags,omitempty"`
+}
+
+// CloudRunTrafficTarget assigns a percentage of the traffic to a revision.
+type CloudRunTrafficTarget struct {
+	// RevisionName is the name of the revision, or `LATEST` for the revision that was just deployed (Required).
+	RevisionName string `yaml:"revisionName" yamltags:"required"`
+
+	// Percent is the percentage of the traffic sent to the revision.
+	Percent int `yaml:"percent"`
+}
+
+// DockerDeploy *alpha* runs the built images as local Docker containers, without Kubernetes.
+type DockerDeploy struct {
+	// Containers lists the containers to run.
+	Containers []DockerContainer `yaml:"containers,omitempty"`
+}
+
+// DockerContainer describes a local Docker container.
+type DockerContainer struct {
+	// Name is the name of the container (Required).
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Image is the name of the image to run (Required).
+	// If it's the name of a built artifact, the built image is run.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Ports lists the ports published on the host, as `hostPort:containerPort`.
+	// For example: `["8080:80"]`.
+	Ports []string `yaml:"ports,omitempty"`
+
+	// Env lists the environment variables set in the container, as `KEY=VALUE`.
+	Env []string `yaml:"env,omitempty"`
+}
+
 // KptDeploy *alpha* uses the `kpt` CLI to manage and deploy manifests.
 type KptDeploy struct {
 	// Dir is the path to the config directory (Required).
@@ -643,6 +989,11 @@ type HelmRelease struct {
 	// Version is the version of the chart.
 	Version string `yaml:"version,omitempty"`
 
+	// Repo is the URL of the chart repository, for a remote chart named `chartPath` that's not
+	// in a repository added with `helm repo add`. Implies `remote: true`.
+	// For example: `https://charts.bitnami.com/bitnami`.
+	Repo string `yaml:"repo,omitempty"`
+
 	// SetValues are key-value pairs.
 	// If present, Skaffold will send `--set` flag to Helm CLI and append all pairs after the flag.
 	SetValues util.FlatMap `yaml:"setValues,omitempty"`
@@ -671,6 +1022,15 @@ type HelmRelease struct {
 	// Defaults to `false`.
 	RecreatePods bool `yaml:"recreatePods,omitempty"`
 
+	// Force if `true`, Skaffold will send `--force` flag to Helm CLI
+	// when upgrading the release, like `skaffold deploy --force` does for all the releases.
+	// Defaults to `false`.
+	Force bool `yaml:"force,omitempty"`
+
+	// Flags are additional flags passed to Helm CLI when installing or upgrading this release.
+	// They are passed after the flags of `deploy.helm.flags`.
+	Flags HelmReleaseFlags `yaml:"flags,omitempty"`
+
 	// SkipBuildDependencies should build dependencies be skipped.
 	// Ignored when `remote: true`.
 	SkipBuildDependencies bool `yaml:"skipBuildDependencies,omitempty"`
@@ -679,6 +1039,7 @@ type HelmRelease struct {
 	UseHelmSecrets bool `yaml:"useHelmSecrets,omitempty"`
 
 	// Remote specifies whether the chart path is remote, or exists on the host filesystem.
+	// A local chart can be a chart directory or a packaged `.tgz` chart.
 	Remote bool `yaml:"remote,omitempty"`
 
 	// UpgradeOnChange specifies whether to upgrade helm chart on code changes.
@@ -736,13 +1097,24 @@ type HelmConventionConfig struct {
 
 // LogsConfig configures how container logs are printed as a result of a deployment.
 type LogsConfig struct {
-	// Prefix defines the prefix shown on each log line. Valid values are
+	// Prefix defines the prefix shown on each log line. It's either a Go template,
+	// for example `[{{.Namespace}}/{{.PodName}} {{.ContainerName}}]`, or one of these values:
 	// `container`: prefix logs lines with the name of the container.
 	// `podAndContainer`: prefix logs lines with the names of the pod and of the container.
 	// `auto`: same as `podAndContainer` except that the pod name is skipped if it's the same as the container name.
 	// `none`: don't add a prefix.
 	// Defaults to `auto`.
 	Prefix string `yaml:"prefix,omitempty"`
+
+	// Include lists regular expressions matched against the `<pod name>/<container name>` of the Kubernetes containers.
+	// When set, only the logs of the matching containers are shown.
+	// For example: `["^frontend-"]`.
+	Include []string `yaml:"include,omitempty"`
+
+	// Exclude lists regular expressions matched against the `<pod name>/<container name>` of the Kubernetes containers.
+	// The logs of the matching containers are not shown.
+	// For example: `["/istio-proxy$"]`.
+	Exclude []string `yaml:"exclude,omitempty"`
 }
 
 // Artifact are the items that need to be built, along with the context in which
@@ -767,6 +1139,35 @@ type Artifact struct {
 
 	// Dependencies describes build artifacts that this artifact depends on.
 	Dependencies []*ArtifactDependency `yaml:"requires,omitempty"`
+
+	// TagPolicy overrides the pipeline's tag policy for this artifact.
+	TagPolicy *TagPolicy `yaml:"tagPolicy,omitempty"`
+
+	// Platforms *alpha* lists the target platforms of the image.
+	// When several platforms are given, the images are pushed as a manifest list.
+	// In dev mode, only the platforms of the cluster nodes are built, and images
+	// are built for the cluster nodes' platform when it differs from the local machine's.
+	// Only supported for Docker artifacts built with the local builder.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each build of the artifact.
+	LifecycleHooks BuildHooks `yaml:"hooks,omitempty"`
+
+	// Ignore lists patterns of files, relative to the artifact's context, that the file watcher ignores.
+	// Patterns listed in a `.skaffoldignore` file in the context are ignored too.
+	// They follow the `.dockerignore` syntax.
+	// For example: `["node_modules", "**/*.log"]`.
+	Ignore []string `yaml:"ignore,omitempty"`
+}
+
+// BuildHooks describes the list of lifecycle hooks to execute before and after each artifact build step.
+type BuildHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact build step.
+	PreHooks []HostHook `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact build step.
+	PostHooks []HostHook `yaml:"after,omitempty"`
 }
 
 // Sync *beta* specifies what files to sync into the container.
@@ -787,6 +1188,27 @@ type Sync struct {
 	// Auto delegates discovery of sync rules to the build system.
 	// Only available for jib and buildpacks.
 	Auto *Auto `yaml:"auto,omitempty" yamltags:"oneOf=sync"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each file sync of the artifact.
+	LifecycleHooks SyncHooks `yaml:"hooks,omitempty"`
+}
+
+// SyncHooks describes the list of lifecycle hooks to execute before and after each artifact sync step.
+type SyncHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact sync step.
+	PreHooks []SyncHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact sync step.
+	PostHooks []SyncHookItem `yaml:"after,omitempty"`
+}
+
+// SyncHookItem describes a single lifecycle hook to execute before or after each artifact sync step.
+type SyncHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on each container the files are synced to.
+	ContainerHook *ContainerHook `yaml:"container,omitempty" yamltags:"oneOf=hook"`
 }
 
 // SyncRule specifies which local files to sync to remote folders.
@@ -887,6 +1309,10 @@ type ArtifactType struct {
 
 	// CustomArtifact *beta* builds images using a custom build script written by the user.
 	CustomArtifact *CustomArtifact `yaml:"custom,omitempty" yamltags:"oneOf=artifact"`
+
+	// KoArtifact *alpha* builds images of Go applications by appending a statically linked binary to a base image,
+	// without a Docker daemon.
+	KoArtifact *KoArtifact `yaml:"ko,omitempty" yamltags:"oneOf=artifact"`
 }
 
 // ArtifactDependency describes a specific build dependency for an artifact.
@@ -939,6 +1365,46 @@ type BuildpackDependencies struct {
 	Ignore []string `yaml:"ignore,omitempty"`
 }
 
+// KoArtifact *alpha* describes an artifact built from Go sources, the same way [ko](https://github.com/google/ko) does.
+// The binary is built with `go build` and added as a layer on top of the base image.
+type KoArtifact struct {
+	// FromImage overrides the default base image.
+	// Defaults to `gcr.io/distroless/static:nonroot`.
+	FromImage string `yaml:"fromImage,omitempty"`
+
+	// Main is the package, relative to the workspace, of the `main` function to build.
+	// Defaults to `.`.
+	Main string `yaml:"main,omitempty"`
+
+	// Flags are additional build flags passed to `go build`.
+	// For example: `["-trimpath", "-v"]`.
+	Flags []string `yaml:"flags,omitempty"`
+
+	// Ldflags are linker flags passed to `go build`.
+	// For example: `["-s", "-w"]`.
+	Ldflags []string `yaml:"ldflags,omitempty"`
+
+	// Env are environment variables, in the `key=value` form, passed to `go build`.
+	// Values can use the go template syntax.
+	// For example: `["GOPRIVATE=source.developers.google.com", "GOFLAGS={{.FLAGS}}"]`.
+	Env []string `yaml:"env,omitempty"`
+
+	// Dependencies are the file dependencies that skaffold should watch for rebuilding this artifact.
+	// Defaults to all the files of the workspace.
+	Dependencies *KoDependencies `yaml:"dependencies,omitempty"`
+}
+
+// KoDependencies *alpha* is used to specify dependencies for an artifact built by ko.
+type KoDependencies struct {
+	// Paths should be set to the file dependencies for this artifact, so that the skaffold file watcher knows when to rebuild.
+	// For example: `["cmd", "pkg", "go.mod", "go.sum"]`.
+	Paths []string `yaml:"paths,omitempty"`
+
+	// Ignore specifies the paths that should be ignored by skaffold's file watcher. If a file exists in both `paths` and in `ignore`, it will be ignored, and will be excluded from rebuilds.
+	// Will only work in conjunction with `paths`.
+	Ignore []string `yaml:"ignore,omitempty"`
+}
+
 // CustomArtifact *beta* describes an artifact built from a custom build script
 // written by the user. It can be used to build images with builders that aren't directly integrated with skaffold.
 type CustomArtifact struct {
@@ -1125,6 +1591,14 @@ type DockerArtifact struct {
 	// Secret contains information about a local secret passed to `docker build`,
 	// along with optional destination information.
 	Secret *DockerSecret `yaml:"secret,omitempty"`
+
+	// SSH is used to pass in --ssh to docker build to use SSH agent. Requires BuildKit.
+	// Format is "default|<id>[=<socket>|<key>[,<key>]]".
+	SSH string `yaml:"ssh,omitempty"`
+
+	// InlineCache embeds the build cache metadata into the image so that it can
+	// be used in `cacheFrom` by later builds. Requires BuildKit.
+	InlineCache bool `yaml:"inlineCache,omitempty"`
 }
 
 // DockerSecret contains information about a local secret passed to `docker build`,
@@ -1149,6 +1623,12 @@ type BazelArtifact struct {
 	// BuildArgs are additional args to pass to `bazel build`.
 	// For example: `["-flag", "--otherflag"]`.
 	BuildArgs []string `yaml:"args,omitempty"`
+
+	// TarPath is the path, relative to `bazel-bin`, of the image tarball built by the target.
+	// It's required for targets that don't end with `.tar`.
+	// The tarball must contain a single image which is loaded or pushed with the tag of the artifact.
+	// For example: `app/image_bundle.tar`.
+	TarPath string `yaml:"tarPath,omitempty"`
 }
 
 // JibArtifact builds images using the
@@ -55,6 +55,10 @@ func ApplyProfiles(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions) error {
 		}
 	}
 
+	if err := applyKustomizeOverlays(c, profiles); err != nil {
+		return err
+	}
+
 	return checkKubeContextConsistency(contextSpecificProfiles, opts.KubeContext, c.Deploy.KubeContext)
 }
 
@@ -211,8 +215,7 @@ func applyProfile(config *latest.SkaffoldConfig, profile latest.Profile) error {
 		return err
 	}
 
-	var patches []yamlpatch.Operation
-	for _, patch := range profile.Patches {
+	for i, patch := range profile.Patches {
 		// Default patch operation to `replace`
 		op := patch.Op
 		if op == "" {
@@ -224,41 +227,65 @@ func applyProfile(config *latest.SkaffoldConfig, profile latest.Profile) error {
 			value = &v.Node
 		}
 
-		patch := yamlpatch.Operation{
+		// Patches are applied one after the other so that
+		// a patch can rely on the changes made by the previous ones.
+		patched, valid := tryPatch(yamlpatch.Operation{
 			Op:    yamlpatch.Op(op),
 			Path:  yamlpatch.OpPath(patch.Path),
 			From:  yamlpatch.OpPath(patch.From),
 			Value: value,
+		}, buf)
+		if !valid {
+			return fmt.Errorf("invalid patch at index %d (op: %s, path: %s)", i, op, patch.Path)
 		}
 
-		if !tryPatch(patch, buf) {
-			return fmt.Errorf("invalid path: %s", patch.Path)
-		}
+		buf = patched
+	}
 
-		patches = append(patches, patch)
+	*config = latest.SkaffoldConfig{}
+	return yaml.Unmarshal(buf, config)
+}
+
+// applyKustomizeOverlays replaces the kustomize paths with the overlay
+// of the last activated profile that has one.
+// Every overlay must name a profile of the config, so that a typo doesn't
+// silently deploy the default paths.
+func applyKustomizeOverlays(config *latest.SkaffoldConfig, profiles []string) error {
+	kustomize := config.Deploy.KustomizeDeploy
+	if kustomize == nil {
+		return nil
 	}
 
-	buf, err = yamlpatch.Patch(patches).Apply(buf)
-	if err != nil {
-		return err
+	byName := profilesByName(config.Profiles)
+	for _, overlay := range kustomize.Overlays {
+		if _, found := byName[overlay.Profile]; !found {
+			return fmt.Errorf("kustomize overlay %v refers to unknown profile %q", overlay.Paths, overlay.Profile)
+		}
 	}
 
-	*config = latest.SkaffoldConfig{}
-	return yaml.Unmarshal(buf, config)
+	for _, name := range profiles {
+		for _, overlay := range kustomize.Overlays {
+			if overlay.Profile == name {
+				logrus.Infof("using kustomize overlay %v for profile: %s", overlay.Paths, name)
+				kustomize.KustomizePaths = overlay.Paths
+			}
+		}
+	}
+	return nil
 }
 
-// tryPatch is here to verify patches one by one before we
-// apply them because yamlpatch.Patch is known to panic when a path
+// tryPatch applies a single patch. It recovers from panics
+// because yamlpatch.Patch is known to panic when a path
 // is not valid.
-func tryPatch(patch yamlpatch.Operation, buf []byte) (valid bool) {
+func tryPatch(patch yamlpatch.Operation, buf []byte) (patched []byte, valid bool) {
 	defer func() {
 		if errPanic := recover(); errPanic != nil {
 			valid = false
 		}
 	}()
 
-	_, err := yamlpatch.Patch([]yamlpatch.Operation{patch}).Apply(buf)
-	return err == nil
+	patched, err := yamlpatch.Patch([]yamlpatch.Operation{patch}).Apply(buf)
+	return patched, err == nil
 }
 
 func profilesByName(profiles []latest.Profile) map[string]latest.Profile {
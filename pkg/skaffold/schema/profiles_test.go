@@ -79,6 +79,40 @@ profiles:
 	})
 }
 
+func TestApplyPatchesInOrder(t *testing.T) {
+	config := `build:
+  artifacts:
+  - image: example
+profiles:
+- name: patches
+  patches:
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: second
+  - path: /build/artifacts/1/image
+    value: replacement
+`
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		setupFakeKubeConfig(t, api.Config{CurrentContext: "prod-context"})
+		tmp := t.NewTempDir().
+			Write("skaffold.yaml", addVersion(config))
+
+		parsed, err := ParseConfig(tmp.Path("skaffold.yaml"))
+		t.CheckNoError(err)
+
+		skaffoldConfig := parsed.(*latest.SkaffoldConfig)
+		err = ApplyProfiles(skaffoldConfig, cfg.SkaffoldOptions{
+			Profiles: []string{"patches"},
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("example", skaffoldConfig.Build.Artifacts[0].ImageName)
+		t.CheckDeepEqual("replacement", skaffoldConfig.Build.Artifacts[1].ImageName)
+	})
+}
+
 func TestApplyInvalidPatch(t *testing.T) {
 	config := `build:
   artifacts:
@@ -102,7 +136,7 @@ profiles:
 			Profiles: []string{"patches"},
 		})
 
-		t.CheckErrorAndDeepEqual(true, err, `applying profile "patches": invalid path: /build/artifacts/0/image/`, err.Error())
+		t.CheckErrorAndDeepEqual(true, err, `applying profile "patches": invalid patch at index 0 (op: replace, path: /build/artifacts/0/image/)`, err.Error())
 	})
 }
 
@@ -503,6 +537,83 @@ func TestApplyProfiles(t *testing.T) {
 	}
 }
 
+func TestApplyKustomizeOverlays(t *testing.T) {
+	overlays := []latest.KustomizeOverlay{
+		{Profile: "dev", Paths: []string{"overlays/dev"}},
+		{Profile: "staging", Paths: []string{"overlays/staging"}},
+		{Profile: "prod", Paths: []string{"overlays/prod", "overlays/monitoring"}},
+	}
+
+	tests := []struct {
+		description string
+		profiles    []string
+		expected    []string
+	}{
+		{
+			description: "no activated profile",
+			expected:    []string{"base"},
+		},
+		{
+			description: "profile without overlay",
+			profiles:    []string{"other"},
+			expected:    []string{"base"},
+		},
+		{
+			description: "profile with overlay",
+			profiles:    []string{"staging"},
+			expected:    []string{"overlays/staging"},
+		},
+		{
+			description: "last activated profile wins",
+			profiles:    []string{"prod", "other", "dev"},
+			expected:    []string{"overlays/dev"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config := &latest.SkaffoldConfig{
+				Profiles: []latest.Profile{{Name: "dev"}, {Name: "staging"}, {Name: "prod"}, {Name: "other"}},
+			}
+			config.Deploy.KustomizeDeploy = &latest.KustomizeDeploy{
+				KustomizePaths: []string{"base"},
+				Overlays:       overlays,
+			}
+
+			err := applyKustomizeOverlays(config, test.profiles)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, config.Deploy.KustomizeDeploy.KustomizePaths)
+		})
+	}
+}
+
+func TestApplyKustomizeOverlaysUnknownProfile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		config := &latest.SkaffoldConfig{
+			Profiles: []latest.Profile{{Name: "dev"}},
+		}
+		config.Deploy.KustomizeDeploy = &latest.KustomizeDeploy{
+			KustomizePaths: []string{"base"},
+			Overlays:       []latest.KustomizeOverlay{{Profile: "prdo", Paths: []string{"overlays/prod"}}},
+		}
+
+		err := applyKustomizeOverlays(config, []string{"dev"})
+
+		t.CheckErrorContains(`kustomize overlay [overlays/prod] refers to unknown profile "prdo"`, err)
+	})
+}
+
+func TestApplyKustomizeOverlaysWithoutKustomize(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		actual := config(withKubectlDeploy("k8s/*.yaml"))
+
+		err := applyKustomizeOverlays(actual, []string{"dev"})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(config(withKubectlDeploy("k8s/*.yaml")), actual)
+	})
+}
+
 func TestActivatedProfiles(t *testing.T) {
 	tests := []struct {
 		description string
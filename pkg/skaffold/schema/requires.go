@@ -0,0 +1,394 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	cfg "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ApplyRequires merges the configs listed in `requires` into the given config.
+// Required configs are resolved recursively, with their own profiles applied.
+// Relative paths found in a required config are rebased on the directory
+// of the config that requires it.
+func ApplyRequires(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions) error {
+	var chain []string
+	if opts.ConfigurationFile != "-" && !util.IsURL(opts.ConfigurationFile) {
+		root, err := filepath.Abs(opts.ConfigurationFile)
+		if err != nil {
+			return err
+		}
+		chain = append(chain, root)
+	}
+
+	return applyRequires(c, opts, chain, map[string]bool{})
+}
+
+// applyRequires merges the configs required by c into c.
+// visited records the configs already merged, by path and activated profiles,
+// so that a config required through several paths is only merged once.
+func applyRequires(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions, chain []string, visited map[string]bool) error {
+	// Defaults that depend on the deployed resources being empty must be
+	// set before the resources of the required configs are added.
+	setDeployDefaults(&c.Pipeline)
+
+	return forEachRequired(c, chain, func(d latest.ConfigDependency, required *latest.SkaffoldConfig, dir string, chain []string) error {
+		if !isModuleSelected(required.Metadata.Name, d.Names, opts.ConfigurationFilter) {
+			return nil
+		}
+
+		requiredOpts := opts
+		requiredOpts.ConfigurationFile = chain[len(chain)-1]
+		requiredOpts.Profiles = activatedDependencyProfiles(d.ActiveProfiles, opts.Profiles)
+
+		key := visitedKey(requiredOpts.ConfigurationFile, requiredOpts.Profiles)
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+
+		if err := ApplyProfiles(required, requiredOpts); err != nil {
+			return fmt.Errorf("applying profiles to required config %q: %w", d.Path, err)
+		}
+
+		if err := applyRequires(required, requiredOpts, chain, visited); err != nil {
+			return err
+		}
+
+		rebasePaths(&required.Pipeline, dir)
+		if err := mergePipeline(&c.Pipeline, required.Pipeline); err != nil {
+			return fmt.Errorf("merging required config %q: %w", d.Path, err)
+		}
+		return nil
+	})
+}
+
+// visitedKey identifies a required config by its path and the set of profiles activated on it.
+func visitedKey(path string, profiles []string) string {
+	sorted := append([]string(nil), profiles...)
+	sort.Strings(sorted)
+	return path + "|" + strings.Join(sorted, ",")
+}
+
+// AllConfigs parses the given config file and, recursively, the configs it requires.
+// The given config comes first. Profiles are not applied and required configs are
+// listed whatever their name.
+func AllConfigs(configFile string) ([]*latest.SkaffoldConfig, error) {
+	absPath, err := filepath.Abs(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := ParseConfigAndUpgrade(absPath, latest.Version)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config %q: %w", absPath, err)
+	}
+
+	return allConfigs(parsed.(*latest.SkaffoldConfig), []string{absPath}, map[string]bool{absPath: true})
+}
+
+func allConfigs(c *latest.SkaffoldConfig, chain []string, visited map[string]bool) ([]*latest.SkaffoldConfig, error) {
+	configs := []*latest.SkaffoldConfig{c}
+	err := forEachRequired(c, chain, func(_ latest.ConfigDependency, required *latest.SkaffoldConfig, _ string, chain []string) error {
+		path := chain[len(chain)-1]
+		if visited[path] {
+			return nil
+		}
+		visited[path] = true
+
+		requiredConfigs, err := allConfigs(required, chain, visited)
+		if err != nil {
+			return err
+		}
+		configs = append(configs, requiredConfigs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+// forEachRequired parses each config required by c and calls visit with it, the directory
+// to rebase its paths on and the chain of config files leading to it.
+// The chain lists the config files from the root config to c. It's used to find
+// the required configs relative to c and to detect cycles.
+func forEachRequired(c *latest.SkaffoldConfig, chain []string, visit func(d latest.ConfigDependency, required *latest.SkaffoldConfig, dir string, chain []string) error) error {
+	for _, d := range c.Dependencies {
+		path, dir := requiredConfigFile(configFileRelativeTo(chain, d.Path), d.Path)
+
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		if util.StrSliceContains(chain, absPath) {
+			return fmt.Errorf("cycle detected in required configs: %s", strings.Join(append(chain, absPath), " -> "))
+		}
+
+		parsed, err := ParseConfigAndUpgrade(absPath, latest.Version)
+		if err != nil {
+			return fmt.Errorf("parsing required config %q: %w", d.Path, err)
+		}
+
+		requiredChain := append(append([]string(nil), chain...), absPath)
+		if err := visit(d, parsed.(*latest.SkaffoldConfig), dir, requiredChain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// requiredConfigFile returns the path to the config file of a dependency
+// and the directory of that file, as written in the dependency.
+// A path to a directory is understood as the `skaffold.yaml` in that directory.
+func requiredConfigFile(path, dependencyPath string) (string, string) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return filepath.Join(path, "skaffold.yaml"), dependencyPath
+	}
+	return path, filepath.Dir(dependencyPath)
+}
+
+// configFileRelativeTo returns the path to a required config,
+// relative to the last config in the chain.
+func configFileRelativeTo(chain []string, path string) string {
+	if filepath.IsAbs(path) || len(chain) == 0 {
+		return path
+	}
+	return filepath.Join(filepath.Dir(chain[len(chain)-1]), path)
+}
+
+// isModuleSelected checks if a config is part of the configs listed
+// by the dependency and by the `--module` flag.
+func isModuleSelected(name string, names []string, modules []string) bool {
+	if len(names) > 0 && !util.StrSliceContains(names, name) {
+		return false
+	}
+	if len(modules) > 0 && !util.StrSliceContains(modules, name) {
+		return false
+	}
+	return true
+}
+
+// activatedDependencyProfiles lists the profiles to activate on a required config,
+// given the profiles activated on the current config.
+func activatedDependencyProfiles(profiles []latest.ProfileDependency, activated []string) []string {
+	var names []string
+	for _, p := range profiles {
+		if len(p.ActivatedBy) == 0 {
+			names = append(names, p.Name)
+			continue
+		}
+
+		for _, by := range p.ActivatedBy {
+			if util.StrSliceContains(activated, by) {
+				names = append(names, p.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+// setDeployDefaults sets the default manifests and kustomize paths of a pipeline
+// that doesn't list any.
+func setDeployDefaults(p *latest.Pipeline) {
+	if p.Deploy.KubectlDeploy != nil && len(p.Deploy.KubectlDeploy.Manifests) == 0 {
+		p.Deploy.KubectlDeploy.Manifests = append([]string(nil), constants.DefaultKubectlManifests...)
+	}
+	if p.Deploy.KustomizeDeploy != nil && len(p.Deploy.KustomizeDeploy.KustomizePaths) == 0 {
+		p.Deploy.KustomizeDeploy.KustomizePaths = []string{constants.DefaultKustomizationPath}
+	}
+}
+
+// rebasePaths makes the relative paths of a required config relative
+// to the config that requires it.
+func rebasePaths(p *latest.Pipeline, dir string) {
+	rebase := func(path string) string {
+		if filepath.IsAbs(path) || util.IsURL(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+
+	for _, a := range p.Build.Artifacts {
+		a.Workspace = rebase(a.Workspace)
+	}
+
+	for _, t := range p.Test {
+		for i, st := range t.StructureTests {
+			t.StructureTests[i] = rebase(st)
+		}
+	}
+
+	if p.Deploy.KubectlDeploy != nil {
+		for i, m := range p.Deploy.KubectlDeploy.Manifests {
+			p.Deploy.KubectlDeploy.Manifests[i] = rebase(m)
+		}
+	}
+
+	if p.Deploy.KustomizeDeploy != nil {
+		for i, k := range p.Deploy.KustomizeDeploy.KustomizePaths {
+			p.Deploy.KustomizeDeploy.KustomizePaths[i] = rebase(k)
+		}
+	}
+
+	if p.Deploy.HelmDeploy != nil {
+		for i := range p.Deploy.HelmDeploy.Releases {
+			r := &p.Deploy.HelmDeploy.Releases[i]
+			if !r.Remote && r.Repo == "" {
+				r.ChartPath = rebase(r.ChartPath)
+			}
+			for j, v := range r.ValuesFiles {
+				r.ValuesFiles[j] = rebase(v)
+			}
+		}
+	}
+
+	if p.Deploy.KptDeploy != nil {
+		p.Deploy.KptDeploy.Dir = rebase(p.Deploy.KptDeploy.Dir)
+	}
+}
+
+// mergePipeline adds the artifacts, tests, deployed resources and
+// port-forwards of a required config to the current pipeline.
+// The settings of a deployer, like its flags, are merged when only one of the
+// configs sets them and rejected when they conflict.
+// Other settings, like the tag policy or the build type,
+// are always taken from the current pipeline.
+func mergePipeline(p *latest.Pipeline, required latest.Pipeline) error {
+	p.Build.Artifacts = append(p.Build.Artifacts, required.Build.Artifacts...)
+	p.Test = append(p.Test, required.Test...)
+	p.PortForward = append(p.PortForward, required.PortForward...)
+
+	if k := required.Deploy.KubectlDeploy; k != nil {
+		if p.Deploy.KubectlDeploy == nil {
+			p.Deploy.KubectlDeploy = k
+		} else {
+			p.Deploy.KubectlDeploy.Manifests = append(p.Deploy.KubectlDeploy.Manifests, k.Manifests...)
+			p.Deploy.KubectlDeploy.RemoteManifests = append(p.Deploy.KubectlDeploy.RemoteManifests, k.RemoteManifests...)
+			if err := mergeSettings("kubectl",
+				setting{"flags", &p.Deploy.KubectlDeploy.Flags, k.Flags},
+				setting{"defaultNamespace", &p.Deploy.KubectlDeploy.DefaultNamespace, k.DefaultNamespace},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	if k := required.Deploy.KustomizeDeploy; k != nil {
+		if p.Deploy.KustomizeDeploy == nil {
+			p.Deploy.KustomizeDeploy = k
+		} else {
+			p.Deploy.KustomizeDeploy.KustomizePaths = append(p.Deploy.KustomizeDeploy.KustomizePaths, k.KustomizePaths...)
+			p.Deploy.KustomizeDeploy.Overlays = append(p.Deploy.KustomizeDeploy.Overlays, k.Overlays...)
+			if err := mergeSettings("kustomize",
+				setting{"flags", &p.Deploy.KustomizeDeploy.Flags, k.Flags},
+				setting{"buildArgs", &p.Deploy.KustomizeDeploy.BuildArgs, k.BuildArgs},
+				setting{"defaultNamespace", &p.Deploy.KustomizeDeploy.DefaultNamespace, k.DefaultNamespace},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	if h := required.Deploy.HelmDeploy; h != nil {
+		if p.Deploy.HelmDeploy == nil {
+			p.Deploy.HelmDeploy = h
+		} else {
+			p.Deploy.HelmDeploy.Releases = append(p.Deploy.HelmDeploy.Releases, h.Releases...)
+			if err := mergeSettings("helm",
+				setting{"flags", &p.Deploy.HelmDeploy.Flags, h.Flags},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	if k := required.Deploy.KptDeploy; k != nil {
+		if p.Deploy.KptDeploy != nil {
+			return fmt.Errorf("only one kpt deployer is supported, found %q and %q", p.Deploy.KptDeploy.Dir, k.Dir)
+		}
+		p.Deploy.KptDeploy = k
+	}
+
+	if c := required.Deploy.CloudRunDeploy; c != nil {
+		if p.Deploy.CloudRunDeploy == nil {
+			p.Deploy.CloudRunDeploy = c
+		} else {
+			p.Deploy.CloudRunDeploy.Services = append(p.Deploy.CloudRunDeploy.Services, c.Services...)
+			if err := mergeSettings("cloudrun",
+				setting{"projectId", &p.Deploy.CloudRunDeploy.ProjectID, c.ProjectID},
+				setting{"region", &p.Deploy.CloudRunDeploy.Region, c.Region},
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d := required.Deploy.DockerDeploy; d != nil {
+		if p.Deploy.DockerDeploy == nil {
+			p.Deploy.DockerDeploy = d
+		} else {
+			p.Deploy.DockerDeploy.Containers = append(p.Deploy.DockerDeploy.Containers, d.Containers...)
+		}
+	}
+
+	return nil
+}
+
+// setting is a deployer setting that applies to all the configs, like flags.
+// current points to the value of the current pipeline and required is the value of the required config.
+type setting struct {
+	name     string
+	current  interface{}
+	required interface{}
+}
+
+// mergeSettings takes the value of each setting from the required config when the current pipeline
+// doesn't set it. It fails when both set different values since they can't be applied together.
+func mergeSettings(deployer string, settings ...setting) error {
+	for _, s := range settings {
+		current := reflect.ValueOf(s.current).Elem()
+		required := reflect.ValueOf(s.required)
+
+		switch {
+		case required.IsZero() || reflect.DeepEqual(current.Interface(), s.required):
+		case current.IsZero():
+			current.Set(required)
+		default:
+			return fmt.Errorf("conflicting %s %s: %s and %s", deployer, s.name, formatSetting(current), formatSetting(required))
+		}
+	}
+	return nil
+}
+
+func formatSetting(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	return fmt.Sprintf("%+v", v.Interface())
+}
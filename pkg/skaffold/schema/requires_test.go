@@ -0,0 +1,551 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	cfg "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestApplyRequires(t *testing.T) {
+	tests := []struct {
+		description       string
+		files             map[string]string
+		profiles          []string
+		modules           []string
+		expectedImages    []string
+		expectedWorkspace []string
+		expectedManifests []string
+		expectedCharts    []string
+		shouldErr         bool
+	}{
+		{
+			description: "no requires",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: root
+`),
+			},
+			expectedImages:    []string{"root"},
+			expectedWorkspace: []string{""},
+		},
+		{
+			description: "required configs are merged",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: root
+requires:
+- path: app1
+- path: app2/skaffold.yaml
+deploy:
+  kubectl:
+    manifests:
+    - k8s/root.yaml
+`),
+				"app1/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: app1
+deploy:
+  kubectl: {}
+`),
+				"app2/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: app2
+    context: src
+deploy:
+  kubectl:
+    manifests:
+    - app2.yaml
+`),
+			},
+			expectedImages:    []string{"root", "app1", "app2"},
+			expectedWorkspace: []string{"", "app1", filepath.Join("app2", "src")},
+			expectedManifests: []string{"k8s/root.yaml", filepath.Join("app1", "k8s", "*.yaml"), filepath.Join("app2", "app2.yaml")},
+		},
+		{
+			description: "nested requires",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: a
+`),
+				"a/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: a
+requires:
+- path: ../b
+`),
+				"b/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: b
+`),
+			},
+			expectedImages:    []string{"a", "b"},
+			expectedWorkspace: []string{"a", "b"},
+		},
+		{
+			description: "filter by config names",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: a
+  configs: [app-a]
+- path: b
+  configs: [other]
+`),
+				"a/skaffold.yaml": addVersion(`metadata:
+  name: app-a
+build:
+  artifacts:
+  - image: a
+`),
+				"b/skaffold.yaml": addVersion(`metadata:
+  name: app-b
+build:
+  artifacts:
+  - image: b
+`),
+			},
+			expectedImages:    []string{"a"},
+			expectedWorkspace: []string{"a"},
+		},
+		{
+			description: "filter by modules",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: a
+- path: b
+`),
+				"a/skaffold.yaml": addVersion(`metadata:
+  name: app-a
+build:
+  artifacts:
+  - image: a
+`),
+				"b/skaffold.yaml": addVersion(`metadata:
+  name: app-b
+build:
+  artifacts:
+  - image: b
+`),
+			},
+			modules:           []string{"app-b"},
+			expectedImages:    []string{"b"},
+			expectedWorkspace: []string{"b"},
+		},
+		{
+			description: "activated profiles",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: a
+  activeProfiles:
+  - name: always
+  - name: prod
+    activatedBy: [release]
+  - name: dev
+    activatedBy: [local]
+`),
+				"a/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: a
+profiles:
+- name: always
+  patches:
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: always
+- name: prod
+  patches:
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: prod
+- name: dev
+  patches:
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: dev
+`),
+			},
+			profiles:          []string{"release"},
+			expectedImages:    []string{"a", "always", "prod"},
+			expectedWorkspace: []string{"a", "a", "a"},
+		},
+		{
+			description: "diamond requires are merged once",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: b
+- path: c
+`),
+				"b/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: b
+requires:
+- path: ../d
+`),
+				"c/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: c
+requires:
+- path: ../d
+`),
+				"d/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: d
+deploy:
+  kubectl:
+    manifests:
+    - d.yaml
+`),
+			},
+			expectedImages:    []string{"b", "d", "c"},
+			expectedWorkspace: []string{"b", "d", "c"},
+			expectedManifests: []string{filepath.Join("d", "d.yaml")},
+		},
+		{
+			description: "a config required with different profiles is merged for each",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: d
+- path: d
+  activeProfiles:
+  - name: extra
+`),
+				"d/skaffold.yaml": addVersion(`build:
+  artifacts:
+  - image: d
+profiles:
+- name: extra
+  patches:
+  - op: replace
+    path: /build/artifacts/0/image
+    value: extra
+`),
+			},
+			expectedImages:    []string{"d", "extra"},
+			expectedWorkspace: []string{"d", "d"},
+		},
+		{
+			description: "root default manifests are kept",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: app
+deploy:
+  kubectl: {}
+`),
+				"app/skaffold.yaml": addVersion(`deploy:
+  kubectl:
+    manifests:
+    - app.yaml
+`),
+			},
+			expectedManifests: []string{"k8s/*.yaml", filepath.Join("app", "app.yaml")},
+		},
+		{
+			description: "repo charts are not rebased",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: app
+`),
+				"app/skaffold.yaml": addVersion(`deploy:
+  helm:
+    releases:
+    - name: local
+      chartPath: charts/local
+    - name: nginx
+      chartPath: stable/nginx
+      repo: https://charts.helm.sh/stable
+    - name: remote
+      chartPath: stable/redis
+      remote: true
+`),
+			},
+			expectedCharts: []string{filepath.Join("app", "charts", "local"), "stable/nginx", "stable/redis"},
+		},
+		{
+			description: "cycle",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: a
+`),
+				"a/skaffold.yaml": addVersion(`requires:
+- path: ../b
+`),
+				"b/skaffold.yaml": addVersion(`requires:
+- path: ../a
+`),
+			},
+			shouldErr: true,
+		},
+		{
+			description: "missing required config",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: missing
+`),
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			setupFakeKubeConfig(t, api.Config{CurrentContext: "cluster1"})
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+
+			opts := cfg.SkaffoldOptions{
+				ConfigurationFile:   "skaffold.yaml",
+				Profiles:            test.profiles,
+				ConfigurationFilter: test.modules,
+			}
+			parsed, err := ParseConfigAndUpgrade(opts.ConfigurationFile, latest.Version)
+			t.CheckNoError(err)
+			config := parsed.(*latest.SkaffoldConfig)
+
+			err = ApplyRequires(config, opts)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			var images, workspaces []string
+			for _, a := range config.Build.Artifacts {
+				images = append(images, a.ImageName)
+				workspaces = append(workspaces, a.Workspace)
+			}
+			t.CheckDeepEqual(test.expectedImages, images)
+			t.CheckDeepEqual(test.expectedWorkspace, workspaces)
+			if test.expectedManifests != nil {
+				t.CheckDeepEqual(test.expectedManifests, config.Deploy.KubectlDeploy.Manifests)
+			}
+			if test.expectedCharts != nil {
+				var charts []string
+				for _, r := range config.Deploy.HelmDeploy.Releases {
+					charts = append(charts, r.ChartPath)
+				}
+				t.CheckDeepEqual(test.expectedCharts, charts)
+			}
+		})
+	}
+}
+
+func TestAllConfigs(t *testing.T) {
+	tests := []struct {
+		description   string
+		files         map[string]string
+		expectedNames []string
+		shouldErr     bool
+	}{
+		{
+			description: "required configs are listed recursively",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`metadata:
+  name: root
+requires:
+- path: app1
+- path: app2/skaffold.yaml
+  configs: [other]
+`),
+				"app1/skaffold.yaml": addVersion(`metadata:
+  name: app1
+requires:
+- path: ../lib
+`),
+				"app2/skaffold.yaml": addVersion(`metadata:
+  name: app2
+`),
+				"lib/skaffold.yaml": addVersion(`metadata:
+  name: lib
+`),
+			},
+			expectedNames: []string{"root", "app1", "lib", "app2"},
+		},
+		{
+			description: "configs required several times are listed once",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`metadata:
+  name: root
+requires:
+- path: a
+- path: b
+`),
+				"a/skaffold.yaml": addVersion(`metadata:
+  name: a
+requires:
+- path: ../lib
+`),
+				"b/skaffold.yaml": addVersion(`metadata:
+  name: b
+requires:
+- path: ../lib
+`),
+				"lib/skaffold.yaml": addVersion(`metadata:
+  name: lib
+`),
+			},
+			expectedNames: []string{"root", "a", "lib", "b"},
+		},
+		{
+			description: "cycle",
+			files: map[string]string{
+				"skaffold.yaml": addVersion(`requires:
+- path: a
+`),
+				"a/skaffold.yaml": addVersion(`requires:
+- path: ..
+`),
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+
+			configs, err := AllConfigs("skaffold.yaml")
+
+			t.CheckError(test.shouldErr, err)
+			var names []string
+			for _, c := range configs {
+				names = append(names, c.Metadata.Name)
+			}
+			t.CheckDeepEqual(test.expectedNames, names)
+		})
+	}
+}
+
+func TestMergePipeline(t *testing.T) {
+	ns := func(namespace string) *string { return &namespace }
+
+	tests := []struct {
+		description   string
+		current       latest.DeployType
+		required      latest.DeployType
+		expected      latest.DeployType
+		shouldErr     bool
+		expectedError string
+	}{
+		{
+			description: "kubectl",
+			current:     latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"a.yaml"}, Flags: latest.KubectlFlags{Apply: []string{"--force"}}}},
+			required:    latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"b.yaml"}, RemoteManifests: []string{"pod/b"}, DefaultNamespace: ns("b")}},
+			expected:    latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"a.yaml", "b.yaml"}, RemoteManifests: []string{"pod/b"}, Flags: latest.KubectlFlags{Apply: []string{"--force"}}, DefaultNamespace: ns("b")}},
+		},
+		{
+			description:   "conflicting kubectl flags",
+			current:       latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Flags: latest.KubectlFlags{Apply: []string{"--force"}}}},
+			required:      latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Flags: latest.KubectlFlags{Global: []string{"-v=2"}}}},
+			shouldErr:     true,
+			expectedError: "conflicting kubectl flags",
+		},
+		{
+			description: "kustomize",
+			current:     latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{KustomizePaths: []string{"a"}}},
+			required:    latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{KustomizePaths: []string{"b"}, BuildArgs: []string{"--load_restrictor=none"}}},
+			expected:    latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{KustomizePaths: []string{"a", "b"}, BuildArgs: []string{"--load_restrictor=none"}}},
+		},
+		{
+			description: "same kustomize build args",
+			current:     latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{KustomizePaths: []string{"a"}, BuildArgs: []string{"--load_restrictor=none"}}},
+			required:    latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{KustomizePaths: []string{"b"}, BuildArgs: []string{"--load_restrictor=none"}}},
+			expected:    latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{KustomizePaths: []string{"a", "b"}, BuildArgs: []string{"--load_restrictor=none"}}},
+		},
+		{
+			description:   "conflicting kustomize namespaces",
+			current:       latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{DefaultNamespace: ns("a")}},
+			required:      latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{DefaultNamespace: ns("b")}},
+			shouldErr:     true,
+			expectedError: "conflicting kustomize defaultNamespace: a and b",
+		},
+		{
+			description: "helm",
+			current:     latest.DeployType{HelmDeploy: &latest.HelmDeploy{Releases: []latest.HelmRelease{{Name: "a"}}}},
+			required:    latest.DeployType{HelmDeploy: &latest.HelmDeploy{Releases: []latest.HelmRelease{{Name: "b"}}, Flags: latest.HelmDeployFlags{Install: []string{"--atomic"}}}},
+			expected:    latest.DeployType{HelmDeploy: &latest.HelmDeploy{Releases: []latest.HelmRelease{{Name: "a"}, {Name: "b"}}, Flags: latest.HelmDeployFlags{Install: []string{"--atomic"}}}},
+		},
+		{
+			description:   "conflicting helm flags",
+			current:       latest.DeployType{HelmDeploy: &latest.HelmDeploy{Flags: latest.HelmDeployFlags{Install: []string{"--wait"}}}},
+			required:      latest.DeployType{HelmDeploy: &latest.HelmDeploy{Flags: latest.HelmDeployFlags{Install: []string{"--atomic"}}}},
+			shouldErr:     true,
+			expectedError: "conflicting helm flags",
+		},
+		{
+			description: "kpt",
+			required:    latest.DeployType{KptDeploy: &latest.KptDeploy{Dir: "b"}},
+			expected:    latest.DeployType{KptDeploy: &latest.KptDeploy{Dir: "b"}},
+		},
+		{
+			description:   "two kpt deployers",
+			current:       latest.DeployType{KptDeploy: &latest.KptDeploy{Dir: "a"}},
+			required:      latest.DeployType{KptDeploy: &latest.KptDeploy{Dir: "b"}},
+			shouldErr:     true,
+			expectedError: "only one kpt deployer is supported",
+		},
+		{
+			description: "cloud run",
+			current:     latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{Region: "us-central1", Services: []latest.CloudRunService{{Name: "a"}}}},
+			required:    latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{ProjectID: "project", Region: "us-central1", Services: []latest.CloudRunService{{Name: "b"}}}},
+			expected:    latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{ProjectID: "project", Region: "us-central1", Services: []latest.CloudRunService{{Name: "a"}, {Name: "b"}}}},
+		},
+		{
+			description:   "conflicting cloud run regions",
+			current:       latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{Region: "us-central1"}},
+			required:      latest.DeployType{CloudRunDeploy: &latest.CloudRunDeploy{Region: "europe-west1"}},
+			shouldErr:     true,
+			expectedError: "conflicting cloudrun region: us-central1 and europe-west1",
+		},
+		{
+			description: "docker",
+			current:     latest.DeployType{DockerDeploy: &latest.DockerDeploy{Containers: []latest.DockerContainer{{Name: "a"}}}},
+			required:    latest.DeployType{DockerDeploy: &latest.DockerDeploy{Containers: []latest.DockerContainer{{Name: "b"}}}},
+			expected:    latest.DeployType{DockerDeploy: &latest.DockerDeploy{Containers: []latest.DockerContainer{{Name: "a"}, {Name: "b"}}}},
+		},
+		{
+			description: "different deployers",
+			current:     latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"a.yaml"}}},
+			required:    latest.DeployType{DockerDeploy: &latest.DockerDeploy{Containers: []latest.DockerContainer{{Name: "b"}}}},
+			expected: latest.DeployType{
+				KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"a.yaml"}},
+				DockerDeploy:  &latest.DockerDeploy{Containers: []latest.DockerContainer{{Name: "b"}}},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			p := latest.Pipeline{Deploy: latest.DeployConfig{DeployType: test.current}}
+
+			err := mergePipeline(&p, latest.Pipeline{Deploy: latest.DeployConfig{DeployType: test.required}})
+
+			if test.shouldErr {
+				t.CheckErrorContains(test.expectedError, err)
+			} else {
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.expected, p.Deploy.DeployType)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"text/template"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -33,6 +34,7 @@ var (
 	// for testing
 	validateYamltags       = yamltags.ValidateStruct
 	dependencyAliasPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	platformRegex          = regexp.MustCompile(`^[a-z0-9]+/[a-z0-9_]+(/[a-z0-9]+)?$`)
 )
 
 // Process checks if the Skaffold pipeline is valid and returns all encountered errors as a concatenated string
@@ -46,9 +48,23 @@ func Process(config *latest.SkaffoldConfig) error {
 	errs = append(errs, validatePortForwardResources(config.PortForward)...)
 	errs = append(errs, validateJibPluginTypes(config.Build.Artifacts)...)
 	errs = append(errs, validateLogPrefix(config.Deploy.Logs)...)
+	errs = append(errs, validateLogFilters(config.Deploy.Logs)...)
 	errs = append(errs, validateArtifactTypes(config.Build)...)
 	errs = append(errs, validateTaggingPolicy(config.Build)...)
+	errs = append(errs, validatePlatforms(config.Build)...)
+	errs = append(errs, validateDeployStrategy(config.Deploy)...)
 
+	return joinErrors(errs)
+}
+
+// ValidateLogFilterFlags checks that the log filters given with `--log-include` and `--log-exclude`
+// are valid regular expressions.
+func ValidateLogFilterFlags(include, exclude []string) error {
+	return joinErrors(logFilterErrors(append(append([]string{}, include...), exclude...)))
+}
+
+// joinErrors returns all the errors as a concatenated string, or nil when there are none.
+func joinErrors(errs []error) error {
 	if len(errs) == 0 {
 		return nil
 	}
@@ -71,6 +87,50 @@ func validateTaggingPolicy(bc latest.BuildConfig) (errs []error) {
 	return
 }
 
+// validatePlatforms makes sure that the target platforms are well formed
+// and only set on Docker artifacts built with the local builder.
+func validatePlatforms(bc latest.BuildConfig) (errs []error) {
+	for _, a := range bc.Artifacts {
+		if len(a.Platforms) == 0 {
+			continue
+		}
+		if bc.LocalBuild == nil || a.DockerArtifact == nil {
+			errs = append(errs, fmt.Errorf("artifact %q: target platforms are only supported for docker artifacts built with the 'local' builder", a.ImageName))
+			continue
+		}
+		for _, p := range a.Platforms {
+			if !platformRegex.MatchString(p) {
+				errs = append(errs, fmt.Errorf("artifact %q: invalid platform %q, expected format is 'os/arch[/variant]'", a.ImageName, p))
+			}
+		}
+	}
+	return
+}
+
+// validateDeployStrategy makes sure that a deployment strategy is only used
+// with the deployers that apply it: kubectl and kustomize.
+func validateDeployStrategy(dc latest.DeployConfig) (errs []error) {
+	if dc.Strategy == nil || (dc.Strategy.Canary == nil && dc.Strategy.BlueGreen == nil) {
+		return
+	}
+
+	unsupported := []struct {
+		name string
+		used bool
+	}{
+		{"cloudrun", dc.CloudRunDeploy != nil},
+		{"docker", dc.DockerDeploy != nil},
+		{"helm", dc.HelmDeploy != nil},
+		{"kpt", dc.KptDeploy != nil},
+	}
+	for _, deployer := range unsupported {
+		if deployer.used {
+			errs = append(errs, fmt.Errorf("deploy strategies are only supported by the 'kubectl' and 'kustomize' deployers, not by the '%s' deployer", deployer.name))
+		}
+	}
+	return
+}
+
 // validateImageNames makes sure the artifact image names are valid base names,
 // without tags nor digests.
 func validateImageNames(artifacts []*latest.Artifact) (errs []error) {
@@ -326,6 +386,18 @@ func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 				errs = append(errs, fmt.Errorf("found a '%s' artifact, which is incompatible with the 'gcb' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'googleCloudBuild' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)))
 			}
 		}
+	case bc.AWSCodeBuild != nil:
+		for _, a := range bc.Artifacts {
+			if misc.ArtifactType(a) != misc.Docker {
+				errs = append(errs, fmt.Errorf("found a '%s' artifact, which is incompatible with the 'codebuild' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'awsCodeBuild' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)))
+			}
+		}
+	case bc.AzureContainerRegistry != nil:
+		for _, a := range bc.Artifacts {
+			if misc.ArtifactType(a) != misc.Docker {
+				errs = append(errs, fmt.Errorf("found a '%s' artifact, which is incompatible with the 'acr' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'azureContainerRegistry' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)))
+			}
+		}
 	case bc.Cluster != nil:
 		for _, a := range bc.Artifacts {
 			if misc.ArtifactType(a) != misc.Kaniko && misc.ArtifactType(a) != misc.Custom {
@@ -336,12 +408,34 @@ func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 	return
 }
 
+// validateLogFilters checks that the log filters are valid regular expressions.
+func validateLogFilters(lc latest.LogsConfig) []error {
+	return logFilterErrors(append(append([]string{}, lc.Include...), lc.Exclude...))
+}
+
+func logFilterErrors(filters []string) []error {
+	var errs []error
+	for _, p := range filters {
+		if _, err := regexp.Compile(p); err != nil {
+			errs = append(errs, fmt.Errorf("invalid log filter '%s': %w", p, err))
+		}
+	}
+	return errs
+}
+
 // validateLogPrefix checks that logs are configured with a valid prefix.
 func validateLogPrefix(lc latest.LogsConfig) []error {
 	validPrefixes := []string{"", "auto", "container", "podAndContainer", "none"}
 
+	if strings.Contains(lc.Prefix, "{{") {
+		if _, err := template.New("prefix").Parse(lc.Prefix); err != nil {
+			return []error{fmt.Errorf("invalid log prefix template '%s': %w", lc.Prefix, err)}
+		}
+		return nil
+	}
+
 	if !util.StrSliceContains(validPrefixes, lc.Prefix) {
-		return []error{fmt.Errorf("invalid log prefix '%s'. Valid values are 'auto', 'container', 'podAndContainer' or 'none'", lc.Prefix)}
+		return []error{fmt.Errorf("invalid log prefix '%s'. Valid values are 'auto', 'container', 'podAndContainer', 'none' or a template", lc.Prefix)}
 	}
 
 	return nil
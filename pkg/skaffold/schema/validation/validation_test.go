@@ -730,7 +730,9 @@ func TestValidateLogsConfig(t *testing.T) {
 		{prefix: "podAndContainer", shouldErr: false},
 		{prefix: "none", shouldErr: false},
 		{prefix: "", shouldErr: false},
+		{prefix: "[{{.Namespace}}/{{.PodName}}]", shouldErr: false},
 		{prefix: "unknown", shouldErr: true},
+		{prefix: "{{.PodName", shouldErr: true},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.prefix, func(t *testutil.T) {
@@ -753,6 +755,57 @@ func TestValidateLogsConfig(t *testing.T) {
 	}
 }
 
+func TestValidateLogFilters(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.LogsConfig
+		shouldErr   bool
+	}{
+		{description: "no filter", cfg: latest.LogsConfig{}},
+		{description: "valid filters", cfg: latest.LogsConfig{Include: []string{"^app-"}, Exclude: []string{"/istio-proxy$"}}},
+		{description: "invalid include", cfg: latest.LogsConfig{Include: []string{"["}}, shouldErr: true},
+		{description: "invalid exclude", cfg: latest.LogsConfig{Exclude: []string{"("}}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				&latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							Logs: test.cfg,
+						},
+					},
+				})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateLogFilterFlags(t *testing.T) {
+	tests := []struct {
+		description string
+		include     []string
+		exclude     []string
+		shouldErr   bool
+	}{
+		{description: "no filter"},
+		{description: "valid filters", include: []string{"^app-"}, exclude: []string{"/istio-proxy$"}},
+		{description: "invalid include", include: []string{"["}, shouldErr: true},
+		{description: "invalid exclude", exclude: []string{"("}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := ValidateLogFilterFlags(test.include, test.exclude)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateAcyclicDependencies(t *testing.T) {
 	tests := []struct {
 		description string
@@ -989,3 +1042,111 @@ func TestValidateTaggingPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.BuildConfig
+		shouldErr   bool
+	}{
+		{
+			description: "docker artifact with local builder",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64", "linux/arm64", "linux/arm/v7"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "invalid platform",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"amd64"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "jib artifact",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64"},
+					ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "gcb builder",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{GoogleCloudBuild: &latest.GoogleCloudBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validatePlatforms(test.cfg)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateDeployStrategy(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.DeployConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no strategy",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{HelmDeploy: &latest.HelmDeploy{}},
+			},
+		},
+		{
+			description: "kubectl and kustomize",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}, KustomizeDeploy: &latest.KustomizeDeploy{}},
+				Strategy:   &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			},
+		},
+		{
+			description: "helm",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{HelmDeploy: &latest.HelmDeploy{}},
+				Strategy:   &latest.DeployStrategy{Canary: &latest.CanaryStrategy{}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "helm next to kubectl",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{HelmDeploy: &latest.HelmDeploy{}, KubectlDeploy: &latest.KubectlDeploy{}},
+				Strategy:   &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateDeployStrategy(test.cfg)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
@@ -147,6 +147,11 @@ func ParseConfig(filename string) (util.VersionedConfig, error) {
 		return nil, fmt.Errorf("read skaffold config: %w", err)
 	}
 
+	return ParseConfigContent(buf)
+}
+
+// ParseConfigContent parses the content of a configuration file, in any schema version.
+func ParseConfigContent(buf []byte) (util.VersionedConfig, error) {
 	// This is to quickly check that it's possibly a skaffold.yaml,
 	// without parsing the whole file.
 	if !bytes.Contains(buf, []byte("apiVersion")) {
@@ -173,7 +178,7 @@ func ParseConfig(filename string) (util.VersionedConfig, error) {
 			delete(parsed, field)
 		}
 	}
-	buf, err = yaml.Marshal(parsed)
+	buf, err := yaml.Marshal(parsed)
 	if err != nil {
 		return nil, fmt.Errorf("unable to re-marshal YAML without dotted keys: %w", err)
 	}
@@ -193,6 +198,11 @@ func ParseConfigAndUpgrade(filename, toVersion string) (util.VersionedConfig, er
 		return nil, err
 	}
 
+	return UpgradeTo(cfg, toVersion)
+}
+
+// UpgradeTo upgrades a configuration to a given version.
+func UpgradeTo(cfg util.VersionedConfig, toVersion string) (util.VersionedConfig, error) {
 	// Check that the target version exists
 	if _, present := SchemaVersions.Find(toVersion); !present {
 		return nil, fmt.Errorf("unknown api version: %q", toVersion)
@@ -52,6 +52,13 @@ func (s *server) Execute(ctx context.Context, intent *proto.UserIntentRequest) (
 		}()
 	}
 
+	if artifacts := intent.GetIntent().GetArtifacts(); len(artifacts) > 0 {
+		event.ResetStateOnBuild()
+		go func() {
+			s.buildArtifactsCallback(artifacts)
+		}()
+	}
+
 	if intent.GetIntent().GetDeploy() {
 		event.ResetStateOnDeploy()
 		go func() {
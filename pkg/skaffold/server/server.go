@@ -31,6 +31,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/metrics"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/proto"
 )
@@ -45,12 +47,13 @@ var (
 )
 
 type server struct {
-	buildIntentCallback  func()
-	syncIntentCallback   func()
-	deployIntentCallback func()
-	autoBuildCallback    func(bool)
-	autoSyncCallback     func(bool)
-	autoDeployCallback   func(bool)
+	buildIntentCallback    func()
+	buildArtifactsCallback func([]string)
+	syncIntentCallback     func()
+	deployIntentCallback   func()
+	autoBuildCallback      func(bool)
+	autoSyncCallback       func(bool)
+	autoDeployCallback     func(bool)
 }
 
 func SetBuildCallback(callback func()) {
@@ -59,6 +62,13 @@ func SetBuildCallback(callback func()) {
 	}
 }
 
+// SetBuildArtifactsCallback sets the callback run when given artifacts are to be rebuilt.
+func SetBuildArtifactsCallback(callback func([]string)) {
+	if srv != nil {
+		srv.buildArtifactsCallback = callback
+	}
+}
+
 func SetDeployCallback(callback func()) {
 	if srv != nil {
 		srv.deployIntentCallback = callback
@@ -104,7 +114,7 @@ func Initialize(opts config.SkaffoldOptions) (func() error, error) {
 		return grpcCallback, fmt.Errorf("starting gRPC server: %w", err)
 	}
 
-	httpCallback, err := newHTTPServer(opts.RPCHTTPPort, rpcPort, &usedPorts)
+	httpCallback, httpPort, err := newHTTPServer(opts.RPCHTTPPort, rpcPort, &usedPorts)
 	callback := func() error {
 		httpErr := httpCallback()
 		grpcErr := grpcCallback()
@@ -121,6 +131,9 @@ func Initialize(opts config.SkaffoldOptions) (func() error, error) {
 		return callback, fmt.Errorf("starting HTTP server: %w", err)
 	}
 
+	// The ports might not be the requested ones if those were already taken.
+	event.APIServerAvailable(rpcPort, httpPort)
+
 	return callback, nil
 }
 
@@ -138,12 +151,13 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 
 	s := grpc.NewServer()
 	srv = &server{
-		buildIntentCallback:  func() {},
-		deployIntentCallback: func() {},
-		syncIntentCallback:   func() {},
-		autoBuildCallback:    func(bool) {},
-		autoSyncCallback:     func(bool) {},
-		autoDeployCallback:   func(bool) {},
+		buildIntentCallback:    func() {},
+		buildArtifactsCallback: func([]string) {},
+		deployIntentCallback:   func() {},
+		syncIntentCallback:     func() {},
+		autoBuildCallback:      func(bool) {},
+		autoSyncCallback:       func(bool) {},
+		autoDeployCallback:     func(bool) {},
 	}
 	proto.RegisterSkaffoldServiceServer(s, srv)
 
@@ -171,17 +185,17 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 	}, port, nil
 }
 
-func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet) (func() error, error) {
+func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet) (func() error, int, error) {
 	mux := runtime.NewServeMux(runtime.WithProtoErrorHandler(errorHandler))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 	err := proto.RegisterSkaffoldServiceHandlerFromEndpoint(context.Background(), mux, fmt.Sprintf("%s:%d", util.Loopback, proxyPort), opts)
 	if err != nil {
-		return func() error { return nil }, err
+		return func() error { return nil }, 0, err
 	}
 
 	l, port, err := listenOnAvailablePort(preferredPort, usedPorts)
 	if err != nil {
-		return func() error { return nil }, fmt.Errorf("creating listener: %w", err)
+		return func() error { return nil }, 0, fmt.Errorf("creating listener: %w", err)
 	}
 
 	if port != preferredPort {
@@ -190,8 +204,13 @@ func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet) (func(
 		logrus.Infof("starting gRPC HTTP server on port %d", port)
 	}
 
+	// Prometheus metrics are served next to the event REST API.
+	handler := http.NewServeMux()
+	handler.Handle("/metrics", metrics.Handler())
+	handler.Handle("/", mux)
+
 	server := &http.Server{
-		Handler: mux,
+		Handler: handler,
 	}
 
 	go server.Serve(l)
@@ -200,7 +219,7 @@ func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet) (func(
 		ctx, cancel := context.WithTimeout(context.Background(), forceShutdownTimeout)
 		defer cancel()
 		return server.Shutdown(ctx)
-	}, nil
+	}, port, nil
 }
 
 type errResponse struct {
@@ -17,13 +17,18 @@ limitations under the License.
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/proto"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -65,4 +70,50 @@ func TestServerStartup(t *testing.T) {
 	} else {
 		httpConn.Close()
 	}
+
+	// make sure the Prometheus metrics are served
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/metrics", httpAddr))
+	if err != nil {
+		t.Errorf("unable to get metrics: %v", err)
+	} else {
+		resp.Body.Close()
+		testutil.CheckDeepEqual(t, http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestServerStartupOnTakenPort(t *testing.T) {
+	// take the requested HTTP port
+	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", util.Loopback, httpAddr+1))
+	testutil.CheckError(t, false, err)
+	defer l.Close()
+
+	shutdown, err := Initialize(config.SkaffoldOptions{
+		EnableRPC:   true,
+		RPCPort:     rpcAddr + 1,
+		RPCHTTPPort: httpAddr + 1,
+	})
+	defer shutdown()
+	testutil.CheckError(t, false, err)
+
+	// the actual ports are reported through the event API
+	errFound := errors.New("found")
+	found := make(chan error, 1)
+	go func() {
+		found <- event.ForEachEvent(func(entry *proto.LogEntry) error {
+			ae := entry.GetEvent().GetApiServerEvent()
+			if ae.GetGrpcPort() == int32(rpcAddr+1) && ae.GetHttpPort() == int32(httpAddr+2) {
+				return errFound
+			}
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-found:
+		if err != errFound {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("no event reporting gRPC port %d and HTTP port %d", rpcAddr+1, httpAddr+2)
+	}
 }
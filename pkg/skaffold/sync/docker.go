@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// containerSyncer syncs files to the local Docker containers
+// running a given image.
+type containerSyncer struct {
+	cfg docker.Config
+}
+
+// NewContainerSyncer returns a syncer for images run as local Docker containers.
+// It talks to the same Docker daemon as the docker deployer.
+func NewContainerSyncer(cfg docker.Config) Syncer {
+	return &containerSyncer{
+		cfg: cfg,
+	}
+}
+
+func (s *containerSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
+	if len(item.Copy) == 0 && len(item.Delete) == 0 {
+		return nil
+	}
+
+	localDocker, err := docker.NewAPIClient(s.cfg)
+	if err != nil {
+		return fmt.Errorf("getting docker client: %w", err)
+	}
+	apiClient := localDocker.RawClient()
+
+	containers, err := apiClient.ContainerList(ctx, types.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("ancestor", item.Image)),
+	})
+	if err != nil {
+		return fmt.Errorf("listing containers: %w", err)
+	}
+	if len(containers) == 0 {
+		return errors.New("didn't sync any files")
+	}
+
+	var ids []string
+	for _, c := range containers {
+		ids = append(ids, c.ID)
+	}
+
+	syncHooks := hooks.NewSyncRunner(item.Artifact, item.Image, localFiles(item.Copy), localFiles(item.Delete))
+	if err := syncHooks.RunPreHooks(ctx, out, execInContainers(apiClient, ids)); err != nil {
+		return fmt.Errorf("running pre-sync hooks: %w", err)
+	}
+
+	for _, id := range ids {
+		if len(item.Copy) > 0 {
+			logrus.Infoln("Copying files:", item.Copy, "to", id)
+
+			if err := copyToContainer(ctx, apiClient, id, item.Copy); err != nil {
+				return fmt.Errorf("copying files: %w", err)
+			}
+		}
+
+		if len(item.Delete) > 0 {
+			logrus.Infoln("Deleting files:", item.Delete, "from", id)
+
+			args := []string{"rm", "-rf", "--"}
+			for _, dsts := range item.Delete {
+				args = append(args, dsts...)
+			}
+			if err := execInContainer(ctx, apiClient, id, ioutil.Discard, args); err != nil {
+				return fmt.Errorf("deleting files: %w", err)
+			}
+		}
+	}
+
+	if err := syncHooks.RunPostHooks(ctx, out, execInContainers(apiClient, ids)); err != nil {
+		return fmt.Errorf("running post-sync hooks: %w", err)
+	}
+
+	return nil
+}
+
+// execInContainers runs the container hooks in each of the given containers, one after the other.
+func execInContainers(apiClient client.CommonAPIClient, ids []string) hooks.ContainerExec {
+	return func(ctx context.Context, out io.Writer, command []string) error {
+		for _, id := range ids {
+			if err := execInContainer(ctx, apiClient, id, out, command); err != nil {
+				return fmt.Errorf("in container %q: %w", id, err)
+			}
+		}
+		return nil
+	}
+}
+
+// execInContainer runs a command in a container and fails if it exits with a non zero code.
+func execInContainer(ctx context.Context, apiClient client.CommonAPIClient, id string, out io.Writer, command []string) error {
+	created, err := apiClient.ContainerExecCreate(ctx, id, types.ExecConfig{
+		Cmd:          command,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	attached, err := apiClient.ContainerExecAttach(ctx, created.ID, types.ExecStartCheck{})
+	if err != nil {
+		return err
+	}
+	defer attached.Close()
+
+	if _, err := stdcopy.StdCopy(out, out, attached.Reader); err != nil {
+		return err
+	}
+
+	inspect, err := apiClient.ContainerExecInspect(ctx, created.ID)
+	if err != nil {
+		return err
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("%q exited with code %d", strings.Join(command, " "), inspect.ExitCode)
+	}
+	return nil
+}
+
+func copyToContainer(ctx context.Context, apiClient client.CommonAPIClient, id string, files syncMap) error {
+	reader, writer := io.Pipe()
+	go func() {
+		if err := util.CreateMappedTar(writer, "/", files); err != nil {
+			writer.CloseWithError(err)
+		} else {
+			writer.Close()
+		}
+	}()
+
+	return apiClient.CopyToContainer(ctx, id, "/", reader, types.CopyToContainerOptions{})
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import "sync"
+
+// inferredSyncMaps remembers the last syncMap inferred for each artifact
+// so that the destinations of deleted files can still be found.
+type inferredSyncMaps struct {
+	lock    sync.Mutex
+	byImage map[string]map[string][]string
+}
+
+// swap records the syncMap inferred for an image and returns the previous one.
+func (m *inferredSyncMaps) swap(image string, syncMap map[string][]string) map[string][]string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.byImage == nil {
+		m.byImage = map[string]map[string][]string{}
+	}
+	previous := m.byImage[image]
+	m.byImage[image] = syncMap
+	return previous
+}
@@ -20,9 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/bmatcuk/doublestar"
@@ -36,6 +38,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -48,6 +51,8 @@ var (
 	SyncMap    = syncMapForArtifact
 )
 
+var inferredMaps = &inferredSyncMaps{}
+
 func NewItem(ctx context.Context, a *latest.Artifact, e filemon.Events, builds []build.Artifact, cfg docker.Config, dependentArtifactsCount int) (*Item, error) {
 	if !e.HasChanged() || a.Sync == nil {
 		return nil, nil
@@ -63,19 +68,23 @@ func NewItem(ctx context.Context, a *latest.Artifact, e filemon.Events, builds [
 		return nil, fmt.Errorf("could not find latest tag for image %s in builds: %v", a.ImageName, builds)
 	}
 
+	var item *Item
+	var err error
 	switch {
 	case len(a.Sync.Manual) > 0:
-		return syncItem(a, tag, e, a.Sync.Manual, cfg)
+		item, err = syncItem(a, tag, e, a.Sync.Manual, cfg)
 
 	case a.Sync.Auto != nil:
-		return autoSyncItem(ctx, a, tag, e, cfg)
+		item, err = autoSyncItem(ctx, a, tag, e, cfg)
 
 	case len(a.Sync.Infer) > 0:
-		return inferredSyncItem(a, tag, e, cfg)
+		item, err = inferredSyncItem(a, tag, e, cfg)
+	}
 
-	default:
-		return nil, nil
+	if item != nil {
+		item.Artifact = a
 	}
+	return item, err
 }
 
 func syncItem(a *latest.Artifact, tag string, e filemon.Events, syncRules []*latest.SyncRule, cfg docker.Config) (*Item, error) {
@@ -99,22 +108,41 @@ func syncItem(a *latest.Artifact, tag string, e filemon.Events, syncRules []*lat
 		return nil, nil
 	}
 
-	return &Item{Image: tag, Copy: toCopy, Delete: toDelete}, nil
+	return &Item{Image: tag, Copy: toCopy, Delete: withDeletedDirs(a.Workspace, toDelete)}, nil
 }
 
 func inferredSyncItem(a *latest.Artifact, tag string, e filemon.Events, cfg docker.Config) (*Item, error) {
-	// deleted files are no longer contained in the syncMap, so we need to rebuild
-	if len(e.Deleted) > 0 {
-		return nil, nil
-	}
-
 	syncMap, err := SyncMap(a, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("inferring syncmap for image %q: %w", a.ImageName, err)
 	}
 
-	toCopy := make(map[string][]string)
-	for _, f := range append(e.Modified, e.Added...) {
+	// Deleted files are no longer contained in the syncMap, so their destinations are looked up
+	// in the syncMap that was inferred for the previous changes, or when the sync state was initialized.
+	previousSyncMap := inferredMaps.swap(a.ImageName, syncMap)
+
+	toCopy, err := inferredDestinations(a, syncMap, append(e.Modified, e.Added...))
+	if err != nil || toCopy == nil {
+		return nil, err
+	}
+
+	if len(e.Deleted) == 0 {
+		return &Item{Image: tag, Copy: toCopy}, nil
+	}
+
+	toDelete, err := inferredDestinations(a, previousSyncMap, e.Deleted)
+	if err != nil || toDelete == nil {
+		return nil, err
+	}
+
+	return &Item{Image: tag, Copy: toCopy, Delete: withDeletedDirs(a.Workspace, toDelete)}, nil
+}
+
+// inferredDestinations maps the given files to their destinations in the container.
+// It returns nil if any of the files can't be synced.
+func inferredDestinations(a *latest.Artifact, inferred map[string][]string, files []string) (syncMap, error) {
+	ret := make(syncMap)
+	for _, f := range files {
 		relPath, err := filepath.Rel(a.Workspace, f)
 		if err != nil {
 			return nil, fmt.Errorf("finding changed file %s relative to context %q: %w", f, a.Workspace, err)
@@ -135,15 +163,40 @@ func inferredSyncItem(a *latest.Artifact, tag string, e filemon.Events, cfg dock
 			return nil, nil
 		}
 
-		if dsts, ok := syncMap[relPath]; ok {
-			toCopy[f] = dsts
+		if dsts, ok := inferred[relPath]; ok {
+			ret[f] = dsts
 		} else {
 			logrus.Infof("Changed file %s is not syncable. Skipping sync", relPath)
 			return nil, nil
 		}
 	}
+	return ret, nil
+}
 
-	return &Item{Image: tag, Copy: toCopy}, nil
+// withDeletedDirs adds the directories that no longer exist locally to the files
+// to delete so that deleting or renaming a directory doesn't leave it behind in the container.
+func withDeletedDirs(workspace string, toDelete syncMap) syncMap {
+	deletedDirs := make(syncMap)
+	for src, dsts := range toDelete {
+		for _, dst := range dsts {
+			dir, dstDir := filepath.Dir(src), path.Dir(dst)
+
+			for filepath.Base(dir) == path.Base(dstDir) && !util.IsDir(dir) {
+				if rel, err := filepath.Rel(workspace, dir); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+					break
+				}
+				if !util.StrSliceContains(deletedDirs[dir], dstDir) {
+					deletedDirs[dir] = append(deletedDirs[dir], dstDir)
+				}
+				dir, dstDir = filepath.Dir(dir), path.Dir(dstDir)
+			}
+		}
+	}
+
+	for dir, dsts := range deletedDirs {
+		toDelete[dir] = dsts
+	}
+	return toDelete
 }
 
 func syncMapForArtifact(a *latest.Artifact, cfg docker.Config) (map[string][]string, error) {
@@ -251,11 +304,16 @@ func matchSyncRules(syncRules []*latest.SyncRule, relPath, containerWd string) (
 	return dsts, nil
 }
 
-func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
+func (s *podSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
+	syncHooks := hooks.NewSyncRunner(item.Artifact, item.Image, localFiles(item.Copy), localFiles(item.Delete))
+	if err := syncHooks.RunPreHooks(ctx, out, s.execFn(item.Image)); err != nil {
+		return fmt.Errorf("running pre-sync hooks: %w", err)
+	}
+
 	if len(item.Copy) > 0 {
 		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Copy, s.copyFileFn, s.namespaces); err != nil {
+		if err := Perform(ctx, item.Image, item.Copy, s.copyFileFn, s.config.GetNamespaces()); err != nil {
 			return fmt.Errorf("copying files: %w", err)
 		}
 	}
@@ -263,31 +321,77 @@ func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
 	if len(item.Delete) > 0 {
 		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Delete, s.deleteFileFn, s.namespaces); err != nil {
+		if err := Perform(ctx, item.Image, item.Delete, s.deleteFileFn, s.config.GetNamespaces()); err != nil {
 			return fmt.Errorf("deleting files: %w", err)
 		}
 	}
 
+	if err := syncHooks.RunPostHooks(ctx, out, s.execFn(item.Image)); err != nil {
+		return fmt.Errorf("running post-sync hooks: %w", err)
+	}
+
 	return nil
 }
 
+// execFn runs the container hooks in each running container of the given image, one after the other.
+func (s *podSyncer) execFn(image string) hooks.ContainerExec {
+	return func(ctx context.Context, out io.Writer, command []string) error {
+		containers, err := runningPodContainers(image, s.config.GetNamespaces())
+		if err != nil {
+			return err
+		}
+
+		for _, c := range containers {
+			args := append([]string{c.pod.Name, "--namespace", c.pod.Namespace, "-c", c.container.Name, "--"}, command...)
+			if err := s.kubectl.Run(ctx, nil, out, "exec", args...); err != nil {
+				return fmt.Errorf("in pod %q: %w", c.pod.Name, err)
+			}
+		}
+		return nil
+	}
+}
+
 func Perform(ctx context.Context, image string, files syncMap, cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd, namespaces []string) error {
 	if len(files) == 0 {
 		return nil
 	}
 
+	containers, err := runningPodContainers(image, namespaces)
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return errors.New("didn't sync any files")
+	}
+
 	errs, ctx := errgroup.WithContext(ctx)
+	for _, c := range containers {
+		cmd := cmdFn(ctx, c.pod, c.container, files)
+		errs.Go(func() error {
+			_, err := util.RunCmdOut(cmd)
+			return err
+		})
+	}
+	return errs.Wait()
+}
+
+type podContainer struct {
+	pod       v1.Pod
+	container v1.Container
+}
 
+// runningPodContainers lists the containers of the running pods that run the given image.
+func runningPodContainers(image string, namespaces []string) ([]podContainer, error) {
 	client, err := kubernetesclient.Client()
 	if err != nil {
-		return fmt.Errorf("getting Kubernetes client: %w", err)
+		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
-	numSynced := 0
+	var containers []podContainer
 	for _, ns := range namespaces {
 		pods, err := client.CoreV1().Pods(ns).List(metav1.ListOptions{})
 		if err != nil {
-			return fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+			return nil, fmt.Errorf("getting pods for namespace %q: %w", ns, err)
 		}
 
 		for _, p := range pods.Items {
@@ -296,31 +400,26 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 			}
 
 			for _, c := range p.Spec.Containers {
-				if c.Image != image {
-					continue
+				if c.Image == image {
+					containers = append(containers, podContainer{pod: p, container: c})
 				}
-
-				cmd := cmdFn(ctx, p, c, files)
-				errs.Go(func() error {
-					_, err := util.RunCmdOut(cmd)
-					return err
-				})
-				numSynced++
 			}
 		}
 	}
+	return containers, nil
+}
 
-	if err := errs.Wait(); err != nil {
-		return err
+// localFiles returns the sorted list of local files of a sync map.
+func localFiles(files syncMap) []string {
+	var local []string
+	for src := range files {
+		local = append(local, src)
 	}
-
-	if numSynced == 0 {
-		return errors.New("didn't sync any files")
-	}
-	return nil
+	sort.Strings(local)
+	return local
 }
 
-func Init(ctx context.Context, artifacts []*latest.Artifact) error {
+func Init(ctx context.Context, artifacts []*latest.Artifact, cfg docker.Config) error {
 	for _, a := range artifacts {
 		if a.Sync == nil {
 			continue
@@ -332,6 +431,17 @@ func Init(ctx context.Context, artifacts []*latest.Artifact) error {
 				return fmt.Errorf("failed to initialize sync state for %q: %w", a.ImageName, err)
 			}
 		}
+
+		if len(a.Sync.Infer) > 0 {
+			// Files deleted before any other change are looked up in this first syncMap.
+			// Without it, their deletion causes a rebuild.
+			syncMap, err := SyncMap(a, cfg)
+			if err != nil {
+				logrus.Warnf("unable to infer the sync state for %q: %v", a.ImageName, err)
+				continue
+			}
+			inferredMaps.swap(a.ImageName, syncMap)
+		}
 	}
 	return nil
 }
@@ -16,14 +16,20 @@ limitations under the License.
 package sync
 
 import (
+	"archive/tar"
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/docker/docker/api/types"
 	registryv1 "github.com/google/go-containerregistry/pkg/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -753,10 +759,15 @@ func TestNewSyncItem(t *testing.T) {
 			t.Override(&WorkingDir, func(string, docker.Config) (string, error) { return test.workingDir, nil })
 			t.Override(&SyncMap, func(*latest.Artifact, docker.Config) (map[string][]string, error) { return test.dependencies, nil })
 			t.Override(&Labels, func(string, docker.Config) (map[string]string, error) { return test.labels, nil })
+			t.Override(&inferredMaps, &inferredSyncMaps{})
 			t.Override(&jib.GetSyncDiff, func(context.Context, string, *latest.JibArtifact, filemon.Events) (map[string][]string, map[string][]string, error) {
 				return map[string][]string{"file.class": {"/some/file.class"}}, nil, nil
 			})
 
+			if test.expected != nil {
+				test.expected.Artifact = test.artifact
+			}
+
 			actual, err := NewItem(ctx, test.artifact, test.evt, test.builds, &mockConfig{}, 0)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, actual)
@@ -764,6 +775,83 @@ func TestNewSyncItem(t *testing.T) {
 	}
 }
 
+func TestInferredSyncDeletions(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifact := &latest.Artifact{
+			ImageName: "test",
+			Sync:      &latest.Sync{Infer: []string{"**/*.html"}},
+			Workspace: ".",
+		}
+		builds := []build.Artifact{{ImageName: "test", Tag: "test:123"}}
+		dependencies := map[string][]string{
+			"index.html":                       {"/app/index.html"},
+			filepath.Join("pages", "old.html"): {"/app/pages/old.html"},
+		}
+		t.Override(&SyncMap, func(*latest.Artifact, docker.Config) (map[string][]string, error) { return dependencies, nil })
+		t.Override(&inferredMaps, &inferredSyncMaps{})
+
+		// The first deletion is synced with the syncMap inferred at initialization.
+		t.CheckNoError(Init(context.Background(), []*latest.Artifact{artifact}, &mockConfig{}))
+		dependencies = map[string][]string{
+			filepath.Join("pages", "old.html"): {"/app/pages/old.html"},
+		}
+		item, err := NewItem(context.Background(), artifact, filemon.Events{Deleted: []string{"index.html"}}, builds, &mockConfig{}, 0)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(&Item{
+			Image:    "test:123",
+			Artifact: artifact,
+			Copy:     syncMap{},
+			Delete:   syncMap{"index.html": {"/app/index.html"}},
+		}, item)
+
+		// Files that were never inferred can't be deleted.
+		item, err = NewItem(context.Background(), artifact, filemon.Events{Deleted: []string{"unknown.html"}}, builds, &mockConfig{}, 0)
+		t.CheckNoError(err)
+		t.CheckNil(item)
+
+		dependencies = map[string][]string{
+			"index.html":                       {"/app/index.html"},
+			filepath.Join("pages", "old.html"): {"/app/pages/old.html"},
+		}
+		item, err = NewItem(context.Background(), artifact, filemon.Events{Modified: []string{"index.html"}}, builds, &mockConfig{}, 0)
+		t.CheckNoError(err)
+		t.CheckNotNil(item)
+
+		dependencies = map[string][]string{"index.html": {"/app/index.html"}}
+		item, err = NewItem(context.Background(), artifact, filemon.Events{Modified: []string{"index.html"}, Deleted: []string{filepath.Join("pages", "old.html")}}, builds, &mockConfig{}, 0)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(&Item{
+			Image:    "test:123",
+			Artifact: artifact,
+			Copy:     syncMap{"index.html": {"/app/index.html"}},
+			Delete: syncMap{
+				filepath.Join("pages", "old.html"): {"/app/pages/old.html"},
+				"pages":                            {"/app/pages"},
+			},
+		}, item)
+	})
+}
+
+func TestWithDeletedDirs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("web/kept/index.html")
+
+		toDelete := withDeletedDirs(tmpDir.Path("web"), syncMap{
+			tmpDir.Path("web/kept/old.html"):         {"/app/kept/old.html"},
+			tmpDir.Path("web/renamed/sub/page.html"): {"/app/renamed/sub/page.html"},
+			tmpDir.Path("web/stripped/style.css"):    {"/static/style.css"},
+		})
+
+		t.CheckDeepEqual(syncMap{
+			tmpDir.Path("web/kept/old.html"):         {"/app/kept/old.html"},
+			tmpDir.Path("web/renamed/sub/page.html"): {"/app/renamed/sub/page.html"},
+			tmpDir.Path("web/renamed/sub"):           {"/app/renamed/sub"},
+			tmpDir.Path("web/renamed"):               {"/app/renamed"},
+			tmpDir.Path("web/stripped/style.css"):    {"/static/style.css"},
+		}, toDelete)
+	})
+}
+
 func TestIntersect(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1101,7 +1189,7 @@ func TestInit(t *testing.T) {
 			})
 
 			artifacts := []*latest.Artifact{test.artifact}
-			err := Init(ctx, artifacts)
+			err := Init(ctx, artifacts, &mockConfig{})
 			t.CheckDeepEqual(test.shouldInit, isCalled)
 			t.CheckError(test.initErrors, err)
 		})
@@ -1113,3 +1201,134 @@ type mockConfig struct {
 }
 
 func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+func (c *mockConfig) UsePodman() bool                        { return false }
+
+type fakeContainerClient struct {
+	testutil.FakeAPIClient
+
+	containers []string
+	failing    string
+	execs      map[string]string
+	ran        []string
+	copied     []string
+}
+
+func (f *fakeContainerClient) ContainerList(_ context.Context, options types.ContainerListOptions) ([]types.Container, error) {
+	var containers []types.Container
+	if options.Filters.ExactMatch("ancestor", "app:tag") {
+		for _, id := range f.containers {
+			containers = append(containers, types.Container{ID: id})
+		}
+	}
+	return containers, nil
+}
+
+func (f *fakeContainerClient) ContainerExecCreate(_ context.Context, container string, config types.ExecConfig) (types.IDResponse, error) {
+	execID := fmt.Sprintf("exec-%d", len(f.execs))
+	f.execs[execID] = strings.Join(config.Cmd, " ")
+	f.ran = append(f.ran, container+": "+f.execs[execID])
+	return types.IDResponse{ID: execID}, nil
+}
+
+func (f *fakeContainerClient) ContainerExecAttach(context.Context, string, types.ExecStartCheck) (types.HijackedResponse, error) {
+	conn, _ := net.Pipe()
+	return types.HijackedResponse{Conn: conn, Reader: bufio.NewReader(strings.NewReader(""))}, nil
+}
+
+func (f *fakeContainerClient) ContainerExecInspect(_ context.Context, execID string) (types.ContainerExecInspect, error) {
+	if f.execs[execID] == f.failing {
+		return types.ContainerExecInspect{ExitCode: 1}, nil
+	}
+	return types.ContainerExecInspect{}, nil
+}
+
+func (f *fakeContainerClient) CopyToContainer(_ context.Context, container, dstPath string, content io.Reader, _ types.CopyToContainerOptions) error {
+	tr := tar.NewReader(content)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f.copied = append(f.copied, container+": "+filepath.Join(dstPath, hdr.Name))
+	}
+}
+
+func TestContainerSyncer(t *testing.T) {
+	tests := []struct {
+		description    string
+		item           *Item
+		containers     []string
+		failing        string
+		commands       util.Command
+		expectedRan    []string
+		expectedCopied []string
+		shouldErr      bool
+	}{
+		{
+			description: "delete files in all the containers",
+			item:        &Item{Image: "app:tag", Delete: map[string][]string{"file": {"/app/file"}}},
+			containers:  []string{"app1", "app2"},
+			expectedRan: []string{"app1: rm -rf -- /app/file", "app2: rm -rf -- /app/file"},
+		},
+		{
+			description:    "copy files",
+			item:           &Item{Image: "app:tag", Copy: map[string][]string{"file": {"/app/file"}}},
+			containers:     []string{"app1"},
+			expectedCopied: []string{"app1: /app/file"},
+		},
+		{
+			description: "run sync hooks",
+			item: &Item{
+				Image: "app:tag",
+				Artifact: &latest.Artifact{ImageName: "app", Sync: &latest.Sync{LifecycleHooks: latest.SyncHooks{
+					PreHooks:  []latest.SyncHookItem{{HostHook: &latest.HostHook{Command: []string{"echo", "{{.SKAFFOLD_FILES_DELETED}}"}}}},
+					PostHooks: []latest.SyncHookItem{{ContainerHook: &latest.ContainerHook{Command: []string{"nginx", "-s", "reload"}}}},
+				}}},
+				Delete: map[string][]string{"file": {"/app/file"}},
+			},
+			containers:  []string{"app1"},
+			commands:    testutil.CmdRun("echo file"),
+			expectedRan: []string{"app1: rm -rf -- /app/file", "app1: nginx -s reload"},
+		},
+		{
+			description: "failing container hook",
+			item: &Item{
+				Image: "app:tag",
+				Artifact: &latest.Artifact{ImageName: "app", Sync: &latest.Sync{LifecycleHooks: latest.SyncHooks{
+					PreHooks: []latest.SyncHookItem{{ContainerHook: &latest.ContainerHook{Command: []string{"bundle", "install"}}}},
+				}}},
+				Delete: map[string][]string{"file": {"/app/file"}},
+			},
+			containers:  []string{"app1"},
+			failing:     "bundle install",
+			expectedRan: []string{"app1: bundle install"},
+			shouldErr:   true,
+		},
+		{
+			description: "no running container",
+			item:        &Item{Image: "app:tag", Delete: map[string][]string{"file": {"/app/file"}}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("file").Chdir()
+			fakeClient := &fakeContainerClient{containers: test.containers, failing: test.failing, execs: map[string]string{}}
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return docker.NewLocalDaemon(fakeClient, nil, false, nil), nil
+			})
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			err := NewContainerSyncer(&mockConfig{}).Sync(context.Background(), ioutil.Discard, test.item)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedRan, fakeClient.ran)
+			t.CheckDeepEqual(test.expectedCopied, fakeClient.copied)
+		})
+	}
+}
@@ -18,26 +18,29 @@ package sync
 
 import (
 	"context"
+	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 type syncMap map[string][]string
 
 type Item struct {
-	Image  string
-	Copy   map[string][]string
-	Delete map[string][]string
+	Image    string
+	Artifact *latest.Artifact
+	Copy     map[string][]string
+	Delete   map[string][]string
 }
 
 type Syncer interface {
-	Sync(context.Context, *Item) error
+	Sync(context.Context, io.Writer, *Item) error
 }
 
 type podSyncer struct {
-	kubectl    *pkgkubectl.CLI
-	namespaces []string
+	kubectl *pkgkubectl.CLI
+	config  Config
 }
 
 type Config interface {
@@ -48,7 +51,7 @@ type Config interface {
 
 func NewSyncer(cfg Config) Syncer {
 	return &podSyncer{
-		kubectl:    pkgkubectl.NewCLI(cfg, ""),
-		namespaces: cfg.GetNamespaces(),
+		kubectl: pkgkubectl.NewCLI(cfg, ""),
+		config:  cfg,
 	}
 }
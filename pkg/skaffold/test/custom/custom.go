@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Runner runs a custom test command on a built image.
+type Runner struct {
+	customTest latest.CustomTest
+	workingDir string
+	extraEnv   []string
+}
+
+// NewRunner creates a new custom.Runner.
+func NewRunner(ct latest.CustomTest, workingDir string, extraEnv []string) *Runner {
+	return &Runner{
+		customTest: ct,
+		workingDir: workingDir,
+		extraEnv:   extraEnv,
+	}
+}
+
+// Test runs the custom command with the image in the `IMAGE` environment variable.
+func (tr *Runner) Test(ctx context.Context, out io.Writer, image string) error {
+	logrus.Infof("Running custom test command %q", tr.customTest.Command)
+
+	var cmd *exec.Cmd
+	// We evaluate the command with a shell so that it can contain
+	// env variables.
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", tr.customTest.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", tr.customTest.Command)
+	}
+	cmd.Dir = tr.workingDir
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.Env = append(append(util.OSEnviron(), tr.extraEnv...), "IMAGE="+image)
+
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("running custom test %q: %w", tr.customTest.Command, err)
+	}
+
+	return nil
+}
+
+// TestDependencies returns the files the custom test depends on.
+func (tr *Runner) TestDependencies() ([]string, error) {
+	files, err := util.ExpandPathsGlob(tr.workingDir, tr.customTest.Dependencies)
+	if err != nil {
+		return nil, fmt.Errorf("expanding test dependencies: %w", err)
+	}
+	return files, nil
+}
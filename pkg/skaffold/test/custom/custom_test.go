@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"runtime"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCustomTest(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.OSEnviron, func() []string { return []string{"KEY=VALUE"} })
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunEnv(shell("./test.sh"), []string{"KEY=VALUE", "DOCKER_HOST=host", "IMAGE=image:tag"}))
+
+		runner := NewRunner(latest.CustomTest{Command: "./test.sh"}, ".", []string{"DOCKER_HOST=host"})
+		err := runner.Test(context.Background(), ioutil.Discard, "image:tag")
+
+		t.CheckNoError(err)
+	})
+}
+
+func TestCustomTestFailure(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunErr(shell("./test.sh"), errors.New("exit status 1")))
+
+		runner := NewRunner(latest.CustomTest{Command: "./test.sh"}, ".", nil)
+		err := runner.Test(context.Background(), ioutil.Discard, "image:tag")
+
+		t.CheckErrorContains(`running custom test "./test.sh"`, err)
+	})
+}
+
+func TestCustomTestDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("scripts/test.sh", "scripts/helper.sh", "other.txt")
+
+		runner := NewRunner(latest.CustomTest{Command: "./scripts/test.sh", Dependencies: []string{"scripts/*"}}, tmpDir.Root(), nil)
+		deps, err := runner.TestDependencies()
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(tmpDir.Paths("scripts/helper.sh", "scripts/test.sh"), deps)
+	})
+}
+
+func shell(command string) string {
+	if runtime.GOOS == "windows" {
+		return "cmd.exe /C " + command
+	}
+	return "sh -c " + command
+}
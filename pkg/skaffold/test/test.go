@@ -30,6 +30,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/structure"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
@@ -60,17 +61,28 @@ func NewTester(cfg Config, imagesAreLocal bool) Tester {
 	}
 }
 
-// TestDependencies returns the watch dependencies to the runner.
-func (t FullTester) TestDependencies() ([]string, error) {
+// TestDependencies returns the watch dependencies of the tests of an artifact to the runner.
+func (t FullTester) TestDependencies(artifact *latest.Artifact) ([]string, error) {
 	var deps []string
 
 	for _, test := range t.testCases {
+		if test.ImageName != artifact.ImageName {
+			continue
+		}
+
 		files, err := util.ExpandPathsGlob(t.workingDir, test.StructureTests)
 		if err != nil {
 			return nil, fmt.Errorf("expanding test file paths: %w", err)
 		}
-
 		deps = append(deps, files...)
+
+		for _, ct := range test.CustomTests {
+			files, err := custom.NewRunner(ct, t.workingDir, nil).TestDependencies()
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, files...)
+		}
 	}
 
 	return deps, nil
@@ -116,6 +128,10 @@ func (t FullTester) runTests(ctx context.Context, out io.Writer, bRes []build.Ar
 		if err := t.runStructureTests(ctx, out, bRes, test); err != nil {
 			return fmt.Errorf("running structure tests: %w", err)
 		}
+
+		if err := t.runCustomTests(ctx, out, bRes, test); err != nil {
+			return fmt.Errorf("running custom tests: %w", err)
+		}
 	}
 
 	return nil
@@ -151,6 +167,27 @@ func (t FullTester) runStructureTests(ctx context.Context, out io.Writer, bRes [
 	return runner.Test(ctx, out, fqn)
 }
 
+func (t FullTester) runCustomTests(ctx context.Context, out io.Writer, bRes []build.Artifact, tc *latest.TestCase) error {
+	if len(tc.CustomTests) == 0 {
+		return nil
+	}
+
+	fqn, found := resolveArtifactImageTag(tc.ImageName, bRes)
+	if !found {
+		logrus.Debugln("Skipping custom tests for", tc.ImageName, "since it wasn't built")
+		return nil
+	}
+
+	for _, ct := range tc.CustomTests {
+		runner := custom.NewRunner(ct, t.workingDir, t.localDaemon.ExtraEnv())
+		if err := runner.Test(ctx, out, fqn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resolveArtifactImageTag(imageName string, bRes []build.Artifact) (string, bool) {
 	for _, res := range bRes {
 		if imageName == res.ImageName {
@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/docker/docker/client"
@@ -41,7 +42,7 @@ func TestNoTestDependencies(t *testing.T) {
 		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) { return nil, nil })
 
 		cfg := &mockConfig{}
-		deps, err := NewTester(cfg, true).TestDependencies()
+		deps, err := NewTester(cfg, true).TestDependencies(&latest.Artifact{ImageName: "image"})
 
 		t.CheckNoError(err)
 		t.CheckEmpty(deps)
@@ -50,19 +51,21 @@ func TestNoTestDependencies(t *testing.T) {
 
 func TestTestDependencies(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
-		tmpDir := t.NewTempDir().Touch("tests/test1.yaml", "tests/test2.yaml", "test3.yaml")
+		tmpDir := t.NewTempDir().Touch("tests/test1.yaml", "tests/test2.yaml", "test3.yaml", "scripts/test.sh", "other.yaml")
 
 		cfg := &mockConfig{
 			workingDir: tmpDir.Root(),
 			tests: []*latest.TestCase{
-				{StructureTests: []string{"./tests/*"}},
+				{ImageName: "image", StructureTests: []string{"./tests/*"}},
 				{},
-				{StructureTests: []string{"test3.yaml"}},
+				{ImageName: "image", StructureTests: []string{"test3.yaml"}},
+				{ImageName: "image", CustomTests: []latest.CustomTest{{Command: "./test.sh", Dependencies: []string{"scripts/*"}}}},
+				{ImageName: "other", StructureTests: []string{"other.yaml"}},
 			},
 		}
-		deps, err := NewTester(cfg, true).TestDependencies()
+		deps, err := NewTester(cfg, true).TestDependencies(&latest.Artifact{ImageName: "image"})
 
-		expectedDeps := tmpDir.Paths("tests/test1.yaml", "tests/test2.yaml", "test3.yaml")
+		expectedDeps := tmpDir.Paths("tests/test1.yaml", "tests/test2.yaml", "test3.yaml", "scripts/test.sh")
 		t.CheckNoError(err)
 		t.CheckDeepEqual(expectedDeps, deps)
 	})
@@ -79,7 +82,7 @@ func TestWrongPattern(t *testing.T) {
 
 		tester := NewTester(cfg, true)
 
-		_, err := tester.TestDependencies()
+		_, err := tester.TestDependencies(&latest.Artifact{ImageName: "image"})
 		t.CheckError(true, err)
 
 		err = tester.Test(context.Background(), ioutil.Discard, []build.Artifact{{
@@ -151,6 +154,57 @@ func TestTestSuccess(t *testing.T) {
 	})
 }
 
+func TestCustomTestSuccess(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunEnv(shell("./test1.sh"), []string{"IMAGE=image:tag"}).
+			AndRunEnv(shell("./test2.sh"), []string{"IMAGE=image:tag"}))
+
+		cfg := &mockConfig{
+			workingDir: tmpDir.Root(),
+			tests: []*latest.TestCase{
+				{
+					ImageName:   "image",
+					CustomTests: []latest.CustomTest{{Command: "./test1.sh"}, {Command: "./test2.sh"}},
+				},
+				{
+					// This is image is not built so it won't be tested.
+					ImageName:   "not-built",
+					CustomTests: []latest.CustomTest{{Command: "./test1.sh"}},
+				},
+			},
+		}
+
+		err := NewTester(cfg, true).Test(context.Background(), ioutil.Discard, []build.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}})
+
+		t.CheckNoError(err)
+	})
+}
+
+func TestCustomTestFailure(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunErr(shell("./test.sh"), errors.New("FAIL")))
+
+		cfg := &mockConfig{
+			tests: []*latest.TestCase{{
+				ImageName:   "image",
+				CustomTests: []latest.CustomTest{{Command: "./test.sh"}},
+			}},
+		}
+
+		err := NewTester(cfg, true).Test(context.Background(), ioutil.Discard, []build.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}})
+
+		t.CheckErrorContains("running custom tests", err)
+	})
+}
+
 func TestTestSuccessRemoteImage(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		t.NewTempDir().Touch("test.yaml").Chdir()
@@ -254,6 +308,13 @@ func TestTestMuted(t *testing.T) {
 	})
 }
 
+func shell(command string) string {
+	if runtime.GOOS == "windows" {
+		return "cmd.exe /C " + command
+	}
+	return "sh -c " + command
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
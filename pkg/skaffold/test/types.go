@@ -32,7 +32,7 @@ import (
 type Tester interface {
 	Test(context.Context, io.Writer, []build.Artifact) error
 
-	TestDependencies() ([]string, error)
+	TestDependencies(artifact *latest.Artifact) ([]string, error)
 }
 
 type Muted interface {
@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opencensus.io/trace"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+)
+
+// maxBufferedSpans bounds the number of spans kept while the endpoint can't be reached.
+const maxBufferedSpans = 2048
+
+// otlpExporter buffers the spans and sends them to an OTLP/HTTP endpoint, JSON encoded.
+type otlpExporter struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+
+	mu    sync.Mutex
+	spans []*trace.SpanData
+}
+
+func newOTLPExporter(endpoint string, headers map[string]string) *otlpExporter {
+	return &otlpExporter{
+		endpoint: endpoint,
+		headers:  headers,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (e *otlpExporter) ExportSpan(s *trace.SpanData) {
+	e.buffer([]*trace.SpanData{s})
+}
+
+// buffer keeps the given spans for the next flush, dropping the oldest ones
+// when there are too many.
+func (e *otlpExporter) buffer(spans []*trace.SpanData) {
+	e.mu.Lock()
+	e.spans = append(e.spans, spans...)
+	if dropped := len(e.spans) - maxBufferedSpans; dropped > 0 {
+		e.spans = e.spans[dropped:]
+	}
+	e.mu.Unlock()
+}
+
+// Flush sends the buffered spans. When they can't be sent,
+// they are kept and sent again with the next flush.
+func (e *otlpExporter) Flush() error {
+	e.mu.Lock()
+	spans := e.spans
+	e.spans = nil
+	e.mu.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	if err := e.send(spans); err != nil {
+		// Spans that ended during the export are kept after the ones that failed.
+		e.mu.Lock()
+		pending := e.spans
+		e.spans = nil
+		e.mu.Unlock()
+		e.buffer(append(spans, pending...))
+		return err
+	}
+	return nil
+}
+
+func (e *otlpExporter) send(spans []*trace.SpanData) error {
+	body, err := json.Marshal(exportRequest(spans))
+	if err != nil {
+		return fmt.Errorf("encoding spans: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending spans to %s: %w", e.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sending spans to %s: %s", e.endpoint, resp.Status)
+	}
+	return nil
+}
+
+// The types below follow the JSON encoding of the OTLP ExportTraceServiceRequest.
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+
+	otlpStatusCodeError = 2
+)
+
+func exportRequest(spans []*trace.SpanData) otlpRequest {
+	var otlpSpans []otlpSpan
+	for _, s := range spans {
+		otlpSpans = append(otlpSpans, toOTLPSpan(s))
+	}
+
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpAttribute{attribute("service.name", "skaffold")},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "skaffold", Version: version.Get().Version},
+				Spans: otlpSpans,
+			}},
+		}},
+	}
+}
+
+func toOTLPSpan(s *trace.SpanData) otlpSpan {
+	span := otlpSpan{
+		TraceID:           hex.EncodeToString(s.TraceID[:]),
+		SpanID:            hex.EncodeToString(s.SpanID[:]),
+		Name:              s.Name,
+		Kind:              spanKind(s.SpanKind),
+		StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.EndTime.UnixNano(), 10),
+	}
+	if s.ParentSpanID != (trace.SpanID{}) {
+		span.ParentSpanID = hex.EncodeToString(s.ParentSpanID[:])
+	}
+	var keys []string
+	for k := range s.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		span.Attributes = append(span.Attributes, attribute(k, s.Attributes[k]))
+	}
+	if s.Code != trace.StatusCodeOK {
+		span.Status = otlpStatus{Code: otlpStatusCodeError, Message: s.Message}
+	}
+	return span
+}
+
+func spanKind(kind int) int {
+	switch kind {
+	case trace.SpanKindServer:
+		return otlpSpanKindServer
+	case trace.SpanKindClient:
+		return otlpSpanKindClient
+	default:
+		return otlpSpanKindInternal
+	}
+}
+
+func attribute(key string, value interface{}) otlpAttribute {
+	var v otlpValue
+	switch value := value.(type) {
+	case bool:
+		v.BoolValue = &value
+	case int64:
+		i := strconv.FormatInt(value, 10)
+		v.IntValue = &i
+	case float64:
+		v.DoubleValue = &value
+	default:
+		s := fmt.Sprint(value)
+		v.StringValue = &s
+	}
+	return otlpAttribute{Key: key, Value: v}
+}
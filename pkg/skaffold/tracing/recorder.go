@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opencensus.io/trace"
+)
+
+// Span is a span that ended, as kept by a Recorder.
+type Span struct {
+	ID         string
+	ParentID   string
+	Name       string
+	Attributes map[string]string
+	Duration   time.Duration
+	Failed     bool
+}
+
+// Recorder keeps the spans that ended, so that the timings can
+// be reported without an external tracing backend.
+type Recorder struct {
+	mu    sync.Mutex
+	spans []Span
+}
+
+// NewRecorder creates a Recorder that receives every span.
+func NewRecorder() *Recorder {
+	r := &Recorder{}
+	trace.RegisterExporter(r)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+	return r
+}
+
+func (r *Recorder) ExportSpan(s *trace.SpanData) {
+	attributes := map[string]string{}
+	for k, v := range s.Attributes {
+		attributes[k] = fmt.Sprint(v)
+	}
+
+	r.mu.Lock()
+	r.spans = append(r.spans, Span{
+		ID:         s.SpanID.String(),
+		ParentID:   s.ParentSpanID.String(),
+		Name:       s.Name,
+		Attributes: attributes,
+		Duration:   s.EndTime.Sub(s.StartTime),
+		Failed:     s.Code != trace.StatusCodeOK,
+	})
+	r.mu.Unlock()
+}
+
+// Spans returns the spans that ended since the last call, in the order they ended.
+func (r *Recorder) Spans() []Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	spans := r.spans
+	r.spans = nil
+	return spans
+}
+
+// Stop stops receiving the spans.
+func (r *Recorder) Stop() {
+	trace.UnregisterExporter(r)
+}
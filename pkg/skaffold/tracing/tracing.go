@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const (
+	tracesEndpointEnv = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	endpointEnv       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	headersEnv        = "OTEL_EXPORTER_OTLP_HEADERS"
+)
+
+var (
+	// For testing
+	flushInterval = 5 * time.Second
+)
+
+// Initialize exports the spans to the OTLP endpoint configured with the standard
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT or OTEL_EXPORTER_OTLP_ENDPOINT environment variables.
+// Spans are exported periodically, so that long-running dev sessions can be followed.
+// It returns a callback that exports the remaining spans, which the caller is responsible for calling.
+func Initialize() func() error {
+	endpoint := tracesEndpoint()
+	if endpoint == "" {
+		return func() error { return nil }
+	}
+
+	logrus.Infof("exporting traces to %s", endpoint)
+	exporter := newOTLPExporter(endpoint, headers())
+	trace.RegisterExporter(exporter)
+	trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := exporter.Flush(); err != nil {
+					logrus.Debugln("exporting traces:", err)
+				}
+			}
+		}
+	}()
+
+	return func() error {
+		close(done)
+		trace.UnregisterExporter(exporter)
+		return exporter.Flush()
+	}
+}
+
+// StartSpan starts a span with the given name and attributes, as a child of the span found in ctx, if any.
+// The returned function ends the span, marking it as failed if the given error is not nil.
+func StartSpan(ctx context.Context, name string, attributes map[string]string) (context.Context, func(error)) {
+	ctx, span := trace.StartSpan(ctx, name)
+	for k, v := range attributes {
+		span.AddAttributes(trace.StringAttribute(k, v))
+	}
+
+	return ctx, func(err error) {
+		if err != nil {
+			span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+		}
+		span.End()
+	}
+}
+
+// tracesEndpoint returns the URL the traces are sent to. As specified by OpenTelemetry,
+// the generic endpoint is a base URL that's suffixed with the path of the traces signal.
+func tracesEndpoint() string {
+	if endpoint := os.Getenv(tracesEndpointEnv); endpoint != "" {
+		return endpoint
+	}
+	if endpoint := os.Getenv(endpointEnv); endpoint != "" {
+		return strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+	}
+	return ""
+}
+
+// headers parses the `key1=value1,key2=value2` list of headers sent with each export.
+func headers() map[string]string {
+	headers := map[string]string{}
+	for _, kv := range strings.Split(os.Getenv(headersEnv), ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return headers
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"go.opencensus.io/trace"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExportSpans(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var requests []otlpRequest
+		var authHeader string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req otlpRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			requests = append(requests, req)
+			authHeader = r.Header.Get("Authorization")
+		}))
+		defer server.Close()
+		t.SetEnvs(map[string]string{
+			tracesEndpointEnv: server.URL,
+			headersEnv:        "Authorization=Bearer TOKEN",
+		})
+
+		shutdown := Initialize()
+		ctx, endBuild := StartSpan(context.Background(), "Build", nil)
+		_, endArtifact := StartSpan(ctx, "BuildArtifact", map[string]string{"artifact": "app"})
+		endArtifact(errors.New("BUG"))
+		endBuild(nil)
+		t.CheckNoError(shutdown())
+
+		t.CheckDeepEqual(1, len(requests))
+		t.CheckDeepEqual("Bearer TOKEN", authHeader)
+		resourceSpans := requests[0].ResourceSpans
+		t.CheckDeepEqual(1, len(resourceSpans))
+		t.CheckDeepEqual([]otlpAttribute{attribute("service.name", "skaffold")}, resourceSpans[0].Resource.Attributes)
+		spans := resourceSpans[0].ScopeSpans[0].Spans
+		t.CheckDeepEqual(2, len(spans))
+
+		artifact, build := spans[0], spans[1]
+		t.CheckDeepEqual("BuildArtifact", artifact.Name)
+		t.CheckDeepEqual(build.TraceID, artifact.TraceID)
+		t.CheckDeepEqual(build.SpanID, artifact.ParentSpanID)
+		t.CheckDeepEqual([]otlpAttribute{attribute("artifact", "app")}, artifact.Attributes)
+		t.CheckDeepEqual(otlpStatus{Code: otlpStatusCodeError, Message: "BUG"}, artifact.Status)
+		t.CheckDeepEqual("Build", build.Name)
+		t.CheckDeepEqual("", build.ParentSpanID)
+		t.CheckDeepEqual(otlpStatus{}, build.Status)
+		t.CheckDeepEqual(otlpSpanKindInternal, build.Kind)
+	})
+}
+
+func TestFlushError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		available := false
+		var received []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !available {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			var req otlpRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			for _, s := range req.ResourceSpans[0].ScopeSpans[0].Spans {
+				received = append(received, s.Name)
+			}
+		}))
+		defer server.Close()
+
+		exporter := newOTLPExporter(server.URL, nil)
+		t.CheckNoError(exporter.Flush())
+
+		exporter.ExportSpan(&trace.SpanData{Name: "Build"})
+		t.CheckErrorContains("500 Internal Server Error", exporter.Flush())
+
+		available = true
+		exporter.ExportSpan(&trace.SpanData{Name: "Deploy"})
+		t.CheckNoError(exporter.Flush())
+		t.CheckDeepEqual([]string{"Build", "Deploy"}, received)
+		t.CheckNoError(exporter.Flush())
+		t.CheckDeepEqual([]string{"Build", "Deploy"}, received)
+	})
+}
+
+func TestBufferedSpansAreBounded(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		exporter := newOTLPExporter("http://unused", nil)
+		for i := 0; i < maxBufferedSpans+10; i++ {
+			exporter.ExportSpan(&trace.SpanData{Name: strconv.Itoa(i)})
+		}
+
+		t.CheckDeepEqual(maxBufferedSpans, len(exporter.spans))
+		t.CheckDeepEqual("10", exporter.spans[0].Name)
+	})
+}
+
+func TestTracesEndpoint(t *testing.T) {
+	tests := []struct {
+		description string
+		env         map[string]string
+		expected    string
+	}{
+		{
+			description: "not configured",
+		},
+		{
+			description: "generic endpoint",
+			env:         map[string]string{endpointEnv: "http://localhost:4318/"},
+			expected:    "http://localhost:4318/v1/traces",
+		},
+		{
+			description: "traces endpoint takes precedence",
+			env:         map[string]string{endpointEnv: "http://localhost:4318", tracesEndpointEnv: "http://collector/traces"},
+			expected:    "http://collector/traces",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{endpointEnv: "", tracesEndpointEnv: ""})
+			t.SetEnvs(test.env)
+
+			t.CheckDeepEqual(test.expected, tracesEndpoint())
+		})
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.SetEnvs(map[string]string{headersEnv: "api-key=secret, x-team = dev,invalid"})
+
+		t.CheckDeepEqual(map[string]string{"api-key": "secret", "x-team": "dev"}, headers())
+	})
+}
+
+func TestRecorder(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		recorder := NewRecorder()
+		defer recorder.Stop()
+
+		ctx, endDeploy := StartSpan(context.Background(), "Deploy", nil)
+		_, endApply := StartSpan(ctx, "Apply", map[string]string{"release": "app"})
+		endApply(errors.New("BUG"))
+		endDeploy(nil)
+
+		spans := recorder.Spans()
+		t.CheckDeepEqual(2, len(spans))
+		t.CheckDeepEqual("Apply", spans[0].Name)
+		t.CheckDeepEqual(map[string]string{"release": "app"}, spans[0].Attributes)
+		t.CheckTrue(spans[0].Failed)
+		t.CheckDeepEqual("Deploy", spans[1].Name)
+		t.CheckFalse(spans[1].Failed)
+		t.CheckDeepEqual(spans[1].ID, spans[0].ParentID)
+		t.CheckTrue(spans[1].Duration >= spans[0].Duration)
+
+		t.CheckEmpty(recorder.Spans())
+	})
+}
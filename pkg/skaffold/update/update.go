@@ -17,6 +17,7 @@ limitations under the License.
 package update
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -29,13 +30,29 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 )
 
-// EnableCheck enabled the check for a more recent version of Skaffold.
-var EnableCheck bool
+// Release channels of the update check.
+const (
+	// StableChannel only notifies about stable releases.
+	StableChannel = "stable"
+	// LatestChannel also notifies about pre-releases.
+	LatestChannel = "latest"
+)
+
+var (
+	// EnableCheck enabled the check for a more recent version of Skaffold.
+	EnableCheck bool
+
+	// Channel is the release channel of the update check. When empty, the channel
+	// set in the global config is used, or the stable channel.
+	Channel string
+)
 
 // For testing
 var (
 	GetLatestAndCurrentVersion = getLatestAndCurrentVersion
 	isConfigUpdateCheckEnabled = config.IsUpdateCheckEnabled
+	getConfigUpdateChannel     = config.GetUpdateChannel
+	latestReleasesURL          = "https://api.github.com/repos/GoogleContainerTools/skaffold/releases?per_page=1"
 )
 
 const LatestVersionURL = "https://storage.googleapis.com/skaffold/releases/latest/VERSION"
@@ -55,7 +72,11 @@ func checkVersion(config string, onError bool) (string, error) {
 		logrus.Debugf("Update check not enabled, skipping.")
 		return "", nil
 	}
-	latest, current, err := GetLatestAndCurrentVersion()
+	channel, err := updateChannel(config)
+	if err != nil {
+		return "", err
+	}
+	latest, current, err := GetLatestAndCurrentVersion(channel)
 	if err != nil {
 		return "", fmt.Errorf("getting latest and current skaffold versions: %w", err)
 	}
@@ -79,18 +100,41 @@ func isUpdateCheckEnabled(configfile string) bool {
 	return EnableCheck && isConfigUpdateCheckEnabled(configfile)
 }
 
-// getLatestAndCurrentVersion uses a VERSION file stored on GCS to determine the latest released version
-// and returns it with the current version of Skaffold
-func getLatestAndCurrentVersion() (semver.Version, semver.Version, error) {
+// updateChannel returns the release channel set with the `--update-channel` flag or in the global config.
+func updateChannel(configfile string) (string, error) {
+	channel := Channel
+	if channel == "" {
+		channel = getConfigUpdateChannel(configfile)
+	}
+
+	switch channel {
+	case "", StableChannel:
+		return StableChannel, nil
+	case LatestChannel:
+		return LatestChannel, nil
+	default:
+		return "", fmt.Errorf("invalid update channel %q. Valid values are %q or %q", channel, StableChannel, LatestChannel)
+	}
+}
+
+// getLatestAndCurrentVersion determines the latest version released on the given channel
+// and returns it with the current version of Skaffold.
+// The stable channel uses a VERSION file stored on GCS. The latest channel uses the most
+// recent GitHub release, that can be a pre-release.
+func getLatestAndCurrentVersion(channel string) (semver.Version, semver.Version, error) {
 	none := semver.Version{}
-	versionString, err := DownloadLatestVersion()
+	download := DownloadLatestVersion
+	if channel == LatestChannel {
+		download = downloadLatestRelease
+	}
+	versionString, err := download()
 	if err != nil {
 		return none, none, err
 	}
-	logrus.Tracef("latest skaffold version: %s", versionString)
+	logrus.Tracef("latest skaffold version on the %s channel: %s", channel, versionString)
 	latest, err := version.ParseVersion(versionString)
 	if err != nil {
-		return none, none, fmt.Errorf("parsing latest version from GCS: %w", err)
+		return none, none, fmt.Errorf("parsing latest version: %w", err)
 	}
 	current, err := version.ParseVersion(version.Get().Version)
 	if err != nil {
@@ -115,6 +159,29 @@ func DownloadLatestVersion() (string, error) {
 	return strings.TrimSuffix(string(versionBytes), "\n"), nil
 }
 
+// downloadLatestRelease returns the tag of the most recent GitHub release, including pre-releases.
+func downloadLatestRelease() (string, error) {
+	resp, err := http.Get(latestReleasesURL)
+	if err != nil {
+		return "", fmt.Errorf("getting latest release info from GitHub: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("http %d, error %q", resp.StatusCode, resp.Status)
+	}
+
+	var releases []struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return "", fmt.Errorf("reading release info from GitHub: %w", err)
+	}
+	if len(releases) == 0 {
+		return "", fmt.Errorf("no release found on GitHub")
+	}
+	return releases[0].TagName, nil
+}
+
 func releaseURL(v semver.Version) string {
 	return fmt.Sprintf("https://github.com/GoogleContainerTools/skaffold/releases/tag/v" + v.String())
 }
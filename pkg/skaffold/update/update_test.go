@@ -18,6 +18,8 @@ package update
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/blang/semver"
@@ -28,7 +30,7 @@ import (
 func TestCheckVersions(t *testing.T) {
 	tests := []struct {
 		description       string
-		checkVersionsFunc func() (semver.Version, semver.Version, error)
+		checkVersionsFunc func(string) (semver.Version, semver.Version, error)
 		expected          []string
 		enabled           bool
 		configCheck       bool
@@ -82,6 +84,7 @@ func TestCheckVersions(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&EnableCheck, test.enabled)
 			t.Override(&isConfigUpdateCheckEnabled, func(string) bool { return test.configCheck })
+			t.Override(&getConfigUpdateChannel, func(string) string { return "" })
 			t.Override(&GetLatestAndCurrentVersion, test.checkVersionsFunc)
 
 			msg, err := CheckVersion("foo")
@@ -93,7 +96,108 @@ func TestCheckVersions(t *testing.T) {
 	}
 }
 
-func latestGreaterThanCurrent() (semver.Version, semver.Version, error) {
+func TestUpdateChannel(t *testing.T) {
+	tests := []struct {
+		description   string
+		flag          string
+		configChannel string
+		expected      string
+		shouldErr     bool
+	}{
+		{
+			description: "defaults to stable",
+			expected:    StableChannel,
+		},
+		{
+			description:   "from config",
+			configChannel: "latest",
+			expected:      LatestChannel,
+		},
+		{
+			description:   "flag wins over config",
+			flag:          "stable",
+			configChannel: "latest",
+			expected:      StableChannel,
+		},
+		{
+			description: "invalid channel",
+			flag:        "nightly",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&Channel, test.flag)
+			t.Override(&getConfigUpdateChannel, func(string) string { return test.configChannel })
+
+			channel, err := updateChannel("foo")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, channel)
+		})
+	}
+}
+
+func TestCheckVersionChannel(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&EnableCheck, true)
+		t.Override(&isConfigUpdateCheckEnabled, func(string) bool { return true })
+		t.Override(&getConfigUpdateChannel, func(string) string { return "latest" })
+		var channel string
+		t.Override(&GetLatestAndCurrentVersion, func(c string) (semver.Version, semver.Version, error) {
+			channel = c
+			return currentEqualsLatest(c)
+		})
+
+		_, err := CheckVersion("foo")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(LatestChannel, channel)
+	})
+}
+
+func TestDownloadLatestRelease(t *testing.T) {
+	tests := []struct {
+		description string
+		body        string
+		status      int
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "pre-release",
+			body:        `[{"tag_name":"v1.17.0-rc.1","prerelease":true}]`,
+			status:      http.StatusOK,
+			expected:    "v1.17.0-rc.1",
+		},
+		{
+			description: "no release",
+			body:        `[]`,
+			status:      http.StatusOK,
+			shouldErr:   true,
+		},
+		{
+			description: "http error",
+			status:      http.StatusForbidden,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+			t.Override(&latestReleasesURL, server.URL)
+
+			tag, err := downloadLatestRelease()
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
+
+func latestGreaterThanCurrent(string) (semver.Version, semver.Version, error) {
 	return semver.Version{
 			Major: 1,
 			Minor: 1,
@@ -105,7 +209,7 @@ func latestGreaterThanCurrent() (semver.Version, semver.Version, error) {
 		}, nil
 }
 
-func currentEqualsLatest() (semver.Version, semver.Version, error) {
+func currentEqualsLatest(string) (semver.Version, semver.Version, error) {
 	return semver.Version{
 			Major: 1,
 			Minor: 0,
@@ -117,6 +221,6 @@ func currentEqualsLatest() (semver.Version, semver.Version, error) {
 		}, nil
 }
 
-func errorGettingVersions() (semver.Version, semver.Version, error) {
+func errorGettingVersions(string) (semver.Version, semver.Version, error) {
 	return semver.Version{}, semver.Version{}, fmt.Errorf("error getting versions")
 }
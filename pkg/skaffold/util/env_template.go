@@ -23,6 +23,7 @@ import (
 	"sort"
 	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/sirupsen/logrus"
 )
@@ -49,6 +50,59 @@ func ParseEnvTemplate(t string) (*template.Template, error) {
 
 // ExecuteEnvTemplate executes an envTemplate based on OS environment variables and a custom map
 func ExecuteEnvTemplate(envTemplate *template.Template, customMap map[string]string) (string, error) {
+	envMap := environment(customMap)
+
+	var buf bytes.Buffer
+	logrus.Debugf("Executing template %v with environment %v", envTemplate, envMap)
+	if err := envTemplate.Execute(&buf, envMap); err != nil {
+		return "", fmt.Errorf("executing template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// UnresolvedEnvVars returns the names of the variables printed by template s
+// that are neither set in the environment nor in the optional custom map.
+// Variables only used in conditions, like `{{if .VAR}}`, are allowed to be unset.
+func UnresolvedEnvVars(s string, customMap map[string]string) ([]string, error) {
+	tmpl, err := ParseEnvTemplate(s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse template: %q: %w", s, err)
+	}
+
+	envMap := environment(customMap)
+	var unresolved []string
+	printedFields(tmpl.Root, func(name string) {
+		if _, found := envMap[name]; !found && !StrSliceContains(unresolved, name) {
+			unresolved = append(unresolved, name)
+		}
+	})
+	return unresolved, nil
+}
+
+func printedFields(node parse.Node, visit func(string)) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			printedFields(child, visit)
+		}
+	case *parse.IfNode:
+		printedFields(n.List, visit)
+		printedFields(n.ElseList, visit)
+	case *parse.ActionNode:
+		for _, cmd := range n.Pipe.Cmds {
+			for _, arg := range cmd.Args {
+				if field, ok := arg.(*parse.FieldNode); ok {
+					visit(field.Ident[0])
+				}
+			}
+		}
+	}
+}
+
+func environment(customMap map[string]string) map[string]string {
 	envMap := map[string]string{}
 	for _, env := range OSEnviron() {
 		kvp := strings.SplitN(env, "=", 2)
@@ -58,13 +112,7 @@ func ExecuteEnvTemplate(envTemplate *template.Template, customMap map[string]str
 	for k, v := range customMap {
 		envMap[k] = v
 	}
-
-	var buf bytes.Buffer
-	logrus.Debugf("Executing template %v with environment %v", envTemplate, envMap)
-	if err := envTemplate.Execute(&buf, envMap); err != nil {
-		return "", fmt.Errorf("executing template: %w", err)
-	}
-	return buf.String(), nil
+	return envMap
 }
 
 // EvaluateEnvTemplateMap parses and executes all map values as templates based on OS environment variables
@@ -79,6 +79,49 @@ func TestEnvTemplate_ExecuteEnvTemplate(t *testing.T) {
 	}
 }
 
+func TestUnresolvedEnvVars(t *testing.T) {
+	tests := []struct {
+		description string
+		template    string
+		customMap   map[string]string
+		env         []string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "all resolved",
+			template:    "{{.FOO}}-{{.BAR}}",
+			env:         []string{"FOO=foo"},
+			customMap:   map[string]string{"BAR": "bar"},
+		},
+		{
+			description: "unresolved listed once",
+			template:    "{{.FOO}}/{{.BAR}}:{{.FOO}}",
+			expected:    []string{"FOO", "BAR"},
+		},
+		{
+			description: "variables in conditions may be unset",
+			template:    "{{if .DEBUG}}{{.LEVEL}}{{else}}{{.OTHER}}{{end}}",
+			env:         []string{"OTHER=other"},
+			expected:    []string{"LEVEL"},
+		},
+		{
+			description: "invalid template",
+			template:    "{{.FOO",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&OSEnviron, func() []string { return test.env })
+
+			unresolved, err := UnresolvedEnvVars(test.template, test.customMap)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, unresolved)
+		})
+	}
+}
+
 func TestMapToFlag(t *testing.T) {
 	foo := "foo"
 	bar := "bar"
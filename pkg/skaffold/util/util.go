@@ -139,6 +139,11 @@ func BoolPtr(b bool) *bool {
 	return &o
 }
 
+func IntPtr(i int) *int {
+	o := i
+	return &o
+}
+
 // StringPtr returns a pointer to a string
 func StringPtr(s string) *string {
 	o := s
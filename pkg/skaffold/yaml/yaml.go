@@ -18,6 +18,7 @@ package yaml
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 
 	yaml "gopkg.in/yaml.v3"
@@ -55,3 +56,71 @@ func Marshal(in interface{}) (out []byte, err error) {
 	}
 	return b.Bytes(), nil
 }
+
+// CopyComments copies the comments of the `from` document to the
+// keys and items of the `to` document found at the same path.
+// Comments of keys that don't exist anymore are lost.
+func CopyComments(from, to []byte) ([]byte, error) {
+	var fromDoc, toDoc yaml.Node
+	if err := yaml.Unmarshal(from, &fromDoc); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(to, &toDoc); err != nil {
+		return nil, err
+	}
+
+	byPath := map[string]comments{}
+	walkNodes(&fromDoc, "", func(path string, n *yaml.Node) {
+		byPath[path] = comments{head: n.HeadComment, line: n.LineComment, foot: n.FootComment}
+	})
+	walkNodes(&toDoc, "", func(path string, n *yaml.Node) {
+		c, found := byPath[path]
+		if !found {
+			return
+		}
+		if n.HeadComment == "" {
+			n.HeadComment = c.head
+		}
+		if n.LineComment == "" {
+			n.LineComment = c.line
+		}
+		if n.FootComment == "" {
+			n.FootComment = c.foot
+		}
+	})
+
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&toDoc); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+type comments struct {
+	head, line, foot string
+}
+
+// walkNodes visits each node of a document with its path, for example `$.build.artifacts[0].image`.
+// The keys of a mapping are visited with a `#key` suffix, since their comments differ from the comments of their values.
+func walkNodes(n *yaml.Node, path string, visit func(string, *yaml.Node)) {
+	visit(path, n)
+
+	switch n.Kind {
+	case yaml.DocumentNode:
+		for _, child := range n.Content {
+			walkNodes(child, "$", visit)
+		}
+	case yaml.SequenceNode:
+		for i, child := range n.Content {
+			walkNodes(child, fmt.Sprintf("%s[%d]", path, i), visit)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			keyPath := path + "." + n.Content[i].Value
+			walkNodes(n.Content[i], keyPath+"#key", visit)
+			walkNodes(n.Content[i+1], keyPath, visit)
+		}
+	}
+}
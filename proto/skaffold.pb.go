@@ -1195,6 +1195,9 @@ type Event struct {
 	//	*Event_FileSyncEvent
 	//	*Event_DebuggingContainerEvent
 	//	*Event_DevLoopEvent
+	//	*Event_CloudRunServiceEvent
+	//	*Event_TimingsSummaryEvent
+	//	*Event_ApiServerEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1266,6 +1269,18 @@ type Event_DevLoopEvent struct {
 	DevLoopEvent *DevLoopEvent `protobuf:"bytes,9,opt,name=devLoopEvent,proto3,oneof"`
 }
 
+type Event_CloudRunServiceEvent struct {
+	CloudRunServiceEvent *CloudRunServiceEvent `protobuf:"bytes,10,opt,name=cloudRunServiceEvent,proto3,oneof"`
+}
+
+type Event_TimingsSummaryEvent struct {
+	TimingsSummaryEvent *TimingsSummaryEvent `protobuf:"bytes,11,opt,name=timingsSummaryEvent,proto3,oneof"`
+}
+
+type Event_ApiServerEvent struct {
+	ApiServerEvent *APIServerEvent `protobuf:"bytes,12,opt,name=apiServerEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_BuildEvent) isEvent_EventType() {}
@@ -1284,6 +1299,12 @@ func (*Event_DebuggingContainerEvent) isEvent_EventType() {}
 
 func (*Event_DevLoopEvent) isEvent_EventType() {}
 
+func (*Event_CloudRunServiceEvent) isEvent_EventType() {}
+
+func (*Event_TimingsSummaryEvent) isEvent_EventType() {}
+
+func (*Event_ApiServerEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1354,6 +1375,27 @@ func (m *Event) GetDevLoopEvent() *DevLoopEvent {
 	return nil
 }
 
+func (m *Event) GetCloudRunServiceEvent() *CloudRunServiceEvent {
+	if x, ok := m.GetEventType().(*Event_CloudRunServiceEvent); ok {
+		return x.CloudRunServiceEvent
+	}
+	return nil
+}
+
+func (m *Event) GetTimingsSummaryEvent() *TimingsSummaryEvent {
+	if x, ok := m.GetEventType().(*Event_TimingsSummaryEvent); ok {
+		return x.TimingsSummaryEvent
+	}
+	return nil
+}
+
+func (m *Event) GetApiServerEvent() *APIServerEvent {
+	if x, ok := m.GetEventType().(*Event_ApiServerEvent); ok {
+		return x.ApiServerEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1366,6 +1408,9 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_FileSyncEvent)(nil),
 		(*Event_DebuggingContainerEvent)(nil),
 		(*Event_DevLoopEvent)(nil),
+		(*Event_CloudRunServiceEvent)(nil),
+		(*Event_TimingsSummaryEvent)(nil),
+		(*Event_ApiServerEvent)(nil),
 	}
 }
 
@@ -2105,6 +2150,198 @@ func (m *DebuggingContainerEvent) GetDebugPorts() map[string]uint32 {
 	return nil
 }
 
+// `CloudRunServiceEvent` is emitted when a Cloud Run service has been deployed and can be reached.
+type CloudRunServiceEvent struct {
+	Service              string   `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloudRunServiceEvent) Reset()         { *m = CloudRunServiceEvent{} }
+func (m *CloudRunServiceEvent) String() string { return proto.CompactTextString(m) }
+func (*CloudRunServiceEvent) ProtoMessage()    {}
+func (*CloudRunServiceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{22}
+}
+
+func (m *CloudRunServiceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloudRunServiceEvent.Unmarshal(m, b)
+}
+func (m *CloudRunServiceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloudRunServiceEvent.Marshal(b, m, deterministic)
+}
+func (m *CloudRunServiceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloudRunServiceEvent.Merge(m, src)
+}
+func (m *CloudRunServiceEvent) XXX_Size() int {
+	return xxx_messageInfo_CloudRunServiceEvent.Size(m)
+}
+func (m *CloudRunServiceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloudRunServiceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloudRunServiceEvent proto.InternalMessageInfo
+
+func (m *CloudRunServiceEvent) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *CloudRunServiceEvent) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// `TimingsSummaryEvent` is emitted at the end of a run or dev loop iteration when timings are requested.
+type TimingsSummaryEvent struct {
+	Phases               []*PhaseTiming `protobuf:"bytes,1,rep,name=phases,proto3" json:"phases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TimingsSummaryEvent) Reset()         { *m = TimingsSummaryEvent{} }
+func (m *TimingsSummaryEvent) String() string { return proto.CompactTextString(m) }
+func (*TimingsSummaryEvent) ProtoMessage()    {}
+func (*TimingsSummaryEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{23}
+}
+
+func (m *TimingsSummaryEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimingsSummaryEvent.Unmarshal(m, b)
+}
+func (m *TimingsSummaryEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimingsSummaryEvent.Marshal(b, m, deterministic)
+}
+func (m *TimingsSummaryEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimingsSummaryEvent.Merge(m, src)
+}
+func (m *TimingsSummaryEvent) XXX_Size() int {
+	return xxx_messageInfo_TimingsSummaryEvent.Size(m)
+}
+func (m *TimingsSummaryEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimingsSummaryEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimingsSummaryEvent proto.InternalMessageInfo
+
+func (m *TimingsSummaryEvent) GetPhases() []*PhaseTiming {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+// `PhaseTiming` describes how long a phase of a run or dev loop iteration took.
+type PhaseTiming struct {
+	Phase                string   `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Duration             string   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Failed               bool     `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PhaseTiming) Reset()         { *m = PhaseTiming{} }
+func (m *PhaseTiming) String() string { return proto.CompactTextString(m) }
+func (*PhaseTiming) ProtoMessage()    {}
+func (*PhaseTiming) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{24}
+}
+
+func (m *PhaseTiming) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PhaseTiming.Unmarshal(m, b)
+}
+func (m *PhaseTiming) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PhaseTiming.Marshal(b, m, deterministic)
+}
+func (m *PhaseTiming) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhaseTiming.Merge(m, src)
+}
+func (m *PhaseTiming) XXX_Size() int {
+	return xxx_messageInfo_PhaseTiming.Size(m)
+}
+func (m *PhaseTiming) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhaseTiming.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhaseTiming proto.InternalMessageInfo
+
+func (m *PhaseTiming) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *PhaseTiming) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *PhaseTiming) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+// `APIServerEvent` is emitted when the gRPC and HTTP servers of the Skaffold API are listening.
+type APIServerEvent struct {
+	GrpcPort             int32    `protobuf:"varint,1,opt,name=grpcPort,proto3" json:"grpcPort,omitempty"`
+	HttpPort             int32    `protobuf:"varint,2,opt,name=httpPort,proto3" json:"httpPort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIServerEvent) Reset()         { *m = APIServerEvent{} }
+func (m *APIServerEvent) String() string { return proto.CompactTextString(m) }
+func (*APIServerEvent) ProtoMessage()    {}
+func (*APIServerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{25}
+}
+
+func (m *APIServerEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIServerEvent.Unmarshal(m, b)
+}
+func (m *APIServerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIServerEvent.Marshal(b, m, deterministic)
+}
+func (m *APIServerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIServerEvent.Merge(m, src)
+}
+func (m *APIServerEvent) XXX_Size() int {
+	return xxx_messageInfo_APIServerEvent.Size(m)
+}
+func (m *APIServerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIServerEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIServerEvent proto.InternalMessageInfo
+
+func (m *APIServerEvent) GetGrpcPort() int32 {
+	if m != nil {
+		return m.GrpcPort
+	}
+	return 0
+}
+
+func (m *APIServerEvent) GetHttpPort() int32 {
+	if m != nil {
+		return m.HttpPort
+	}
+	return 0
+}
+
 // LogEntry describes an event and a string description of the event.
 type LogEntry struct {
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -2119,7 +2356,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{22}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{26}
 }
 
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
@@ -2172,7 +2409,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{23}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{27}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -2211,7 +2448,7 @@ func (m *TriggerRequest) Reset()         { *m = TriggerRequest{} }
 func (m *TriggerRequest) String() string { return proto.CompactTextString(m) }
 func (*TriggerRequest) ProtoMessage()    {}
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{24}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{28}
 }
 
 func (m *TriggerRequest) XXX_Unmarshal(b []byte) error {
@@ -2253,7 +2490,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{25}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{29}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2310,6 +2547,7 @@ type Intent struct {
 	Build                bool     `protobuf:"varint,1,opt,name=build,proto3" json:"build,omitempty"`
 	Sync                 bool     `protobuf:"varint,2,opt,name=sync,proto3" json:"sync,omitempty"`
 	Deploy               bool     `protobuf:"varint,3,opt,name=deploy,proto3" json:"deploy,omitempty"`
+	Artifacts            []string `protobuf:"bytes,4,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2319,7 +2557,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{26}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{30}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -2361,6 +2599,13 @@ func (m *Intent) GetDeploy() bool {
 	return false
 }
 
+func (m *Intent) GetArtifacts() []string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
 // Suggestion defines the action a user needs to recover from an error.
 type Suggestion struct {
 	SuggestionCode       SuggestionCode `protobuf:"varint,1,opt,name=suggestionCode,proto3,enum=proto.SuggestionCode" json:"suggestionCode,omitempty"`
@@ -2374,7 +2619,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{27}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{31}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -2446,6 +2691,10 @@ func init() {
 	proto.RegisterType((*FileSyncEvent)(nil), "proto.FileSyncEvent")
 	proto.RegisterType((*DebuggingContainerEvent)(nil), "proto.DebuggingContainerEvent")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.DebuggingContainerEvent.DebugPortsEntry")
+	proto.RegisterType((*CloudRunServiceEvent)(nil), "proto.CloudRunServiceEvent")
+	proto.RegisterType((*TimingsSummaryEvent)(nil), "proto.TimingsSummaryEvent")
+	proto.RegisterType((*PhaseTiming)(nil), "proto.PhaseTiming")
+	proto.RegisterType((*APIServerEvent)(nil), "proto.APIServerEvent")
 	proto.RegisterType((*LogEntry)(nil), "proto.LogEntry")
 	proto.RegisterType((*UserIntentRequest)(nil), "proto.UserIntentRequest")
 	proto.RegisterType((*TriggerRequest)(nil), "proto.TriggerRequest")
@@ -2457,189 +2706,202 @@ func init() {
 func init() { proto.RegisterFile("skaffold.proto", fileDescriptor_4f2d38e344f9dbf5) }
 
 var fileDescriptor_4f2d38e344f9dbf5 = []byte{
-	// 2908 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0x16, 0xb9, 0x24, 0x45, 0xfe, 0x7a, 0x78, 0x3d, 0xb6, 0x6c, 0x9a, 0x56, 0x6c, 0x79, 0x63,
-	0x3b, 0x8e, 0x92, 0x4a, 0x49, 0x5c, 0x14, 0xa9, 0x9b, 0xb4, 0x58, 0xed, 0x8e, 0xc4, 0xb5, 0x56,
-	0xbb, 0xc4, 0x72, 0x99, 0xc4, 0x06, 0x0a, 0x82, 0x26, 0x57, 0x0c, 0x6b, 0x8a, 0xab, 0x2e, 0x97,
-	0x4e, 0xd5, 0x43, 0x0f, 0xbd, 0xf6, 0xd2, 0x36, 0x4d, 0xdf, 0x87, 0xb4, 0x45, 0x6f, 0x7d, 0x5d,
-	0x8b, 0x22, 0x4d, 0x81, 0x1e, 0xfa, 0xb8, 0x16, 0x2d, 0xd0, 0x53, 0x11, 0x20, 0x39, 0xf4, 0x9e,
-	0xf4, 0x5d, 0xa0, 0x98, 0xc7, 0xee, 0xce, 0xf2, 0x61, 0x45, 0x29, 0x8a, 0x9e, 0xc4, 0xf9, 0xe7,
-	0xfb, 0x9f, 0xf3, 0xcf, 0xff, 0xff, 0x3b, 0x82, 0xe5, 0xe1, 0xfd, 0xd6, 0xfe, 0xbe, 0xdf, 0xef,
-	0x6c, 0x1c, 0x06, 0x7e, 0xe8, 0xa3, 0x3c, 0xfd, 0x53, 0x59, 0xed, 0xfa, 0x7e, 0xb7, 0xef, 0x6d,
-	0xb6, 0x0e, 0x7b, 0x9b, 0xad, 0xc1, 0xc0, 0x0f, 0x5b, 0x61, 0xcf, 0x1f, 0x0c, 0x19, 0xa8, 0x72,
-	0x99, 0xef, 0xd2, 0xd5, 0xbd, 0xd1, 0xfe, 0x66, 0xd8, 0x3b, 0xf0, 0x86, 0x61, 0xeb, 0xe0, 0x90,
-	0x03, 0x2e, 0x8e, 0x03, 0xbc, 0x83, 0xc3, 0xf0, 0x88, 0x6d, 0x2a, 0x37, 0x61, 0xa9, 0x1e, 0xb6,
-	0x42, 0xcf, 0xf1, 0x86, 0x87, 0xfe, 0x60, 0xe8, 0x21, 0x05, 0xf2, 0x43, 0x42, 0x28, 0x67, 0xd6,
-	0x32, 0x37, 0x16, 0x9e, 0x59, 0x64, 0xb8, 0x0d, 0x06, 0x62, 0x5b, 0xca, 0x2a, 0x14, 0x63, 0xbc,
-	0x0c, 0xd2, 0xc1, 0xb0, 0x4b, 0xd1, 0x25, 0x87, 0xfc, 0x54, 0x1e, 0x81, 0x79, 0xc7, 0xfb, 0xf4,
-	0xc8, 0x1b, 0x86, 0x08, 0x41, 0x6e, 0xd0, 0x3a, 0xf0, 0xf8, 0x2e, 0xfd, 0xad, 0xbc, 0x96, 0x83,
-	0x3c, 0x95, 0x86, 0x9e, 0x06, 0xb8, 0x37, 0xea, 0xf5, 0x3b, 0x75, 0x41, 0xdf, 0x69, 0xae, 0x6f,
-	0x2b, 0xde, 0x70, 0x04, 0x10, 0xfa, 0x30, 0x2c, 0x74, 0xbc, 0xc3, 0xbe, 0x7f, 0xc4, 0x78, 0xb2,
-	0x94, 0x07, 0x71, 0x1e, 0x3d, 0xd9, 0x71, 0x44, 0x18, 0xaa, 0xc2, 0xf2, 0xbe, 0x1f, 0xbc, 0xd2,
-	0x0a, 0x3a, 0x5e, 0xa7, 0xe6, 0x07, 0xe1, 0xb0, 0x9c, 0x5b, 0x93, 0x6e, 0x2c, 0x3c, 0xb3, 0x26,
-	0x3a, 0xb7, 0xb1, 0x9d, 0x82, 0xe0, 0x41, 0x18, 0x1c, 0x39, 0x63, 0x7c, 0x48, 0x03, 0x99, 0x84,
-	0x60, 0x34, 0xd4, 0x5e, 0xf6, 0xda, 0xf7, 0x99, 0x11, 0x79, 0x6a, 0xc4, 0x79, 0x41, 0x96, 0xb8,
-	0xed, 0x4c, 0x30, 0xa0, 0x5b, 0xb0, 0xb4, 0xdf, 0xeb, 0x7b, 0xf5, 0xa3, 0x41, 0x9b, 0x49, 0x28,
-	0x50, 0x09, 0x67, 0xb9, 0x84, 0x6d, 0x71, 0xcf, 0x49, 0x43, 0x51, 0x0d, 0xce, 0x74, 0xbc, 0x7b,
-	0xa3, 0x6e, 0xb7, 0x37, 0xe8, 0x6a, 0xfe, 0x20, 0x6c, 0xf5, 0x06, 0x5e, 0x30, 0x2c, 0xcf, 0x53,
-	0x7f, 0x2e, 0xc5, 0x81, 0x18, 0x47, 0xe0, 0x07, 0xde, 0x20, 0x74, 0xa6, 0xb1, 0xa2, 0x27, 0xa0,
-	0x78, 0xe0, 0x85, 0xad, 0x4e, 0x2b, 0x6c, 0x95, 0x8b, 0xd4, 0x90, 0x53, 0x5c, 0xcc, 0x1e, 0x27,
-	0x3b, 0x31, 0xa0, 0x52, 0x87, 0x33, 0x53, 0xc2, 0x44, 0x92, 0xe0, 0xbe, 0x77, 0x44, 0x8f, 0x30,
-	0xef, 0x90, 0x9f, 0xe8, 0x3a, 0xe4, 0x1f, 0xb4, 0xfa, 0xa3, 0xe8, 0x88, 0x64, 0x2e, 0x92, 0xf0,
-	0x30, 0x5b, 0xd8, 0xf6, 0xad, 0xec, 0xb3, 0x99, 0xdb, 0xb9, 0xa2, 0x24, 0xe7, 0x94, 0x77, 0x32,
-	0x50, 0x8c, 0x34, 0xa2, 0x75, 0xc8, 0xd3, 0x53, 0xe7, 0x59, 0x71, 0x56, 0xcc, 0x8a, 0xd8, 0x2c,
-	0x06, 0x41, 0x1f, 0x82, 0x02, 0x3b, 0x6c, 0xae, 0x6b, 0x25, 0x95, 0x0e, 0x31, 0x9a, 0x83, 0xd0,
-	0x27, 0x00, 0x5a, 0x9d, 0x4e, 0x8f, 0x5c, 0xa1, 0x56, 0xbf, 0xdc, 0xa6, 0x81, 0xbb, 0x3c, 0xe6,
-	0xf1, 0x86, 0x1a, 0x23, 0x58, 0x1e, 0x08, 0x2c, 0x95, 0xe7, 0xe1, 0xd4, 0xd8, 0xb6, 0xe8, 0x7f,
-	0x89, 0xf9, 0x7f, 0x56, 0xf4, 0xbf, 0x24, 0x78, 0xab, 0xbc, 0x97, 0x85, 0xa5, 0x94, 0x1f, 0xe8,
-	0x49, 0x38, 0x3d, 0x18, 0x1d, 0xdc, 0xf3, 0x02, 0x7b, 0x5f, 0x0d, 0xc2, 0xde, 0x7e, 0xab, 0x1d,
-	0x0e, 0x79, 0x2c, 0x27, 0x37, 0xd0, 0xf3, 0x50, 0xa4, 0x7e, 0x93, 0x63, 0xcf, 0x52, 0xeb, 0xaf,
-	0x4c, 0x8b, 0xce, 0x86, 0x71, 0xd0, 0xea, 0x7a, 0x5b, 0x0c, 0xe9, 0xc4, 0x2c, 0xe8, 0x2a, 0xe4,
-	0xc2, 0xa3, 0x43, 0xaf, 0x2c, 0xad, 0x65, 0x6e, 0x2c, 0xc7, 0xe7, 0x42, 0x71, 0xee, 0xd1, 0xa1,
-	0xe7, 0xd0, 0x5d, 0xa4, 0x4f, 0x09, 0xd2, 0xd5, 0xa9, 0x6a, 0x1e, 0x16, 0x29, 0x13, 0x16, 0x45,
-	0x2b, 0xd0, 0x75, 0xae, 0x3b, 0x43, 0x75, 0x23, 0x51, 0x9e, 0x17, 0x08, 0xda, 0xcf, 0x42, 0xbe,
-	0xed, 0x8f, 0x06, 0x21, 0x0d, 0x5e, 0xde, 0x61, 0x8b, 0xff, 0x36, 0xee, 0xbf, 0xca, 0xc0, 0x72,
-	0x3a, 0x25, 0xd0, 0x73, 0x50, 0x62, 0x49, 0x41, 0x62, 0x99, 0x19, 0xbb, 0x42, 0x22, 0x92, 0x2f,
-	0xbd, 0xc0, 0x49, 0x18, 0xd0, 0x93, 0x30, 0xdf, 0xee, 0x8f, 0x86, 0xa1, 0x17, 0x50, 0x65, 0x89,
-	0x43, 0x1a, 0xa3, 0x52, 0x87, 0x22, 0x48, 0xc5, 0x80, 0x62, 0x24, 0x04, 0x3d, 0x96, 0x8a, 0xc3,
-	0x99, 0x94, 0xca, 0xe3, 0x03, 0xa1, 0xfc, 0x29, 0x03, 0x90, 0xd4, 0x47, 0xf4, 0x71, 0x28, 0xb5,
-	0x84, 0xb4, 0x11, 0x0b, 0x5b, 0x82, 0xda, 0x88, 0x13, 0x88, 0x1d, 0x53, 0xc2, 0x82, 0xd6, 0x60,
-	0xa1, 0x35, 0x0a, 0x7d, 0x37, 0xe8, 0x75, 0xbb, 0xdc, 0x97, 0xa2, 0x23, 0x92, 0x48, 0xa1, 0xe6,
-	0x45, 0xcc, 0xef, 0x44, 0x99, 0x73, 0x3a, 0x5d, 0xef, 0xfc, 0x8e, 0xe7, 0x08, 0xa0, 0xca, 0x73,
-	0xb0, 0x9c, 0xd6, 0x78, 0xa2, 0xb3, 0xfa, 0x2c, 0x2c, 0x08, 0xc5, 0x1c, 0x9d, 0x83, 0x02, 0x13,
-	0xcd, 0xb9, 0xf9, 0xea, 0x7f, 0x62, 0xb9, 0xf2, 0x56, 0x06, 0xe4, 0xf1, 0x22, 0x3e, 0xd3, 0x02,
-	0x1d, 0x4a, 0x81, 0x37, 0xf4, 0x47, 0x41, 0xdb, 0x8b, 0x6e, 0xe3, 0xf5, 0x19, 0x8d, 0x60, 0xc3,
-	0x89, 0x80, 0xfc, 0x04, 0x62, 0xc6, 0x0f, 0x18, 0xdf, 0xb4, 0xbc, 0x13, 0xc5, 0xd7, 0x80, 0xa5,
-	0x54, 0x97, 0xf9, 0xe0, 0x11, 0x56, 0xde, 0xca, 0x41, 0x9e, 0x56, 0x74, 0xf4, 0x14, 0x94, 0x48,
-	0x9f, 0xa0, 0x0b, 0x5e, 0xb7, 0x65, 0xa1, 0xae, 0x52, 0x7a, 0x75, 0xce, 0x49, 0x40, 0xe8, 0x26,
-	0x1f, 0x00, 0x18, 0x4b, 0x76, 0x72, 0x00, 0x88, 0x78, 0x04, 0x18, 0xfa, 0x48, 0x34, 0x02, 0x30,
-	0x2e, 0x69, 0xca, 0x08, 0x10, 0xb1, 0x89, 0x40, 0x62, 0xde, 0x61, 0xd4, 0x7d, 0xca, 0xb9, 0xe9,
-	0x5d, 0x89, 0x98, 0x17, 0x83, 0x10, 0x4e, 0x35, 0x7b, 0xc6, 0x38, 0xb3, 0xd9, 0x47, 0xfc, 0x13,
-	0x2c, 0xe8, 0x93, 0x50, 0x8e, 0x8e, 0x7a, 0x1c, 0xcf, 0x3b, 0x7f, 0xd4, 0x7e, 0x9c, 0x19, 0xb0,
-	0xea, 0x9c, 0x33, 0x53, 0x04, 0x7a, 0x2e, 0x99, 0x26, 0x98, 0xcc, 0xf9, 0xa9, 0xd3, 0x44, 0x24,
-	0x28, 0x0d, 0x46, 0x77, 0xe1, 0x7c, 0x67, 0xfa, 0xb4, 0xc0, 0x87, 0x81, 0x63, 0x66, 0x8a, 0xea,
-	0x9c, 0x33, 0x4b, 0x00, 0xfa, 0x28, 0x2c, 0x76, 0xbc, 0x07, 0xa6, 0xef, 0x1f, 0x32, 0x81, 0x25,
-	0x2a, 0x30, 0x29, 0x77, 0xc9, 0x56, 0x75, 0xce, 0x49, 0x41, 0xb7, 0x16, 0x01, 0x3c, 0xf2, 0xa3,
-	0x49, 0xca, 0xa0, 0xd2, 0x87, 0x45, 0x11, 0x8d, 0x56, 0xa1, 0xd4, 0x0b, 0xbd, 0x80, 0x8e, 0xc1,
-	0xbc, 0x51, 0x26, 0x04, 0x21, 0x97, 0xb3, 0xa9, 0x5c, 0xbe, 0x0e, 0x92, 0x17, 0x04, 0x3c, 0x61,
-	0xa2, 0xf0, 0xa8, 0x6d, 0xda, 0x4f, 0xee, 0xf5, 0x3d, 0x1c, 0x04, 0x0e, 0x01, 0x28, 0x5f, 0xc8,
-	0xc0, 0x52, 0x8a, 0x8c, 0x9e, 0x80, 0x79, 0x2f, 0x08, 0xe8, 0xe5, 0xcc, 0xcc, 0xba, 0x9c, 0x11,
-	0x02, 0x95, 0x61, 0xfe, 0xc0, 0x1b, 0x0e, 0x5b, 0xdd, 0xe8, 0xde, 0x45, 0x4b, 0x74, 0x13, 0x16,
-	0x86, 0xa3, 0x6e, 0xd7, 0x1b, 0xd2, 0xf1, 0xbd, 0x2c, 0xd1, 0x72, 0x11, 0x8b, 0x8a, 0x77, 0x1c,
-	0x11, 0xa5, 0x58, 0x50, 0x8a, 0x6f, 0x0f, 0xb9, 0xd1, 0x1e, 0xb9, 0xec, 0xfc, 0x96, 0xb2, 0x45,
-	0x6a, 0x82, 0xcb, 0x1e, 0x33, 0xc1, 0x29, 0x3f, 0x8b, 0x9a, 0x07, 0x93, 0x58, 0x81, 0x62, 0xd4,
-	0x09, 0xb8, 0xd0, 0x78, 0x3d, 0x33, 0x90, 0x72, 0x12, 0xc8, 0x12, 0x0d, 0x99, 0x18, 0xa0, 0xdc,
-	0xb1, 0x01, 0xba, 0x05, 0x4b, 0x2d, 0x31, 0xbc, 0xfc, 0x4e, 0x4d, 0x3f, 0x91, 0x34, 0x54, 0x79,
-	0x3d, 0x13, 0x75, 0x06, 0x66, 0xfe, 0xac, 0xba, 0xc5, 0x4d, 0xcc, 0x4e, 0x35, 0x51, 0x3a, 0xb9,
-	0x89, 0xb9, 0xf7, 0x6f, 0xe2, 0x9b, 0xe9, 0xfe, 0xf1, 0x70, 0x3b, 0x67, 0x27, 0xcb, 0xff, 0x31,
-	0xc8, 0x7f, 0xce, 0x40, 0x79, 0x56, 0x29, 0x22, 0x09, 0x13, 0x95, 0xa2, 0x28, 0x61, 0xa2, 0xf5,
-	0xcc, 0x84, 0x11, 0xbc, 0x94, 0xa6, 0x7a, 0x99, 0x4b, 0xbc, 0x4c, 0xf7, 0xc2, 0xfc, 0xfb, 0xe8,
-	0x85, 0x93, 0xbe, 0x16, 0xde, 0xbf, 0xaf, 0xdf, 0xcf, 0x42, 0x29, 0x2e, 0xff, 0xa4, 0xb0, 0xf4,
-	0xfd, 0x76, 0xab, 0x4f, 0x28, 0x51, 0x61, 0x89, 0x09, 0xe8, 0x12, 0x40, 0xe0, 0x1d, 0xf8, 0xa1,
-	0x47, 0xb7, 0xd9, 0x48, 0x26, 0x50, 0x88, 0x9b, 0x87, 0x7e, 0xc7, 0x22, 0x1f, 0xbc, 0xdc, 0x4d,
-	0xbe, 0x44, 0x57, 0x61, 0xa9, 0x1d, 0xd5, 0x46, 0xba, 0xcf, 0x1c, 0x4e, 0x13, 0x89, 0x76, 0xf2,
-	0x85, 0x3c, 0x3c, 0x6c, 0xb5, 0x99, 0xe7, 0x25, 0x27, 0x21, 0x90, 0xc0, 0x93, 0xd6, 0x44, 0xd9,
-	0x0b, 0x2c, 0xf0, 0xd1, 0x1a, 0x29, 0xb0, 0x18, 0x1d, 0x02, 0x99, 0x1e, 0x69, 0x0b, 0x28, 0x39,
-	0x29, 0x9a, 0x88, 0xa1, 0x32, 0x8a, 0x69, 0x0c, 0x95, 0x53, 0x86, 0xf9, 0x56, 0xa7, 0x13, 0x78,
-	0xc3, 0x21, 0x2d, 0xd6, 0x25, 0x27, 0x5a, 0x2a, 0x7f, 0xc8, 0x24, 0x23, 0x43, 0x1c, 0x2b, 0xd2,
-	0x4a, 0x34, 0x3a, 0x9f, 0xf2, 0x58, 0xc5, 0x04, 0x52, 0xa9, 0x7a, 0x07, 0x49, 0x5a, 0xb3, 0x85,
-	0x90, 0x20, 0xd2, 0xb4, 0xeb, 0x9a, 0x9b, 0x9a, 0xec, 0xf9, 0x93, 0x27, 0xfb, 0x09, 0x12, 0xe0,
-	0xdd, 0x2c, 0x9c, 0x9f, 0xd1, 0xdb, 0x1e, 0x76, 0x6b, 0xa3, 0x83, 0xce, 0x1e, 0x73, 0xd0, 0xd2,
-	0xb1, 0x07, 0x9d, 0x9b, 0x72, 0xd0, 0x71, 0x49, 0xce, 0x8f, 0x95, 0xe4, 0x32, 0xcc, 0x07, 0xa3,
-	0x41, 0xd8, 0x8b, 0x73, 0x20, 0x5a, 0x92, 0xe4, 0x7c, 0xc5, 0x0f, 0xee, 0xf7, 0x06, 0x5d, 0xbd,
-	0x17, 0xf0, 0x04, 0x10, 0x28, 0xc8, 0x02, 0xa0, 0x7d, 0x9a, 0xbd, 0x7f, 0x14, 0x69, 0xef, 0xd9,
-	0x78, 0x78, 0x6f, 0x67, 0x74, 0xe1, 0x35, 0x44, 0x90, 0x40, 0xbe, 0xc6, 0xc6, 0xb6, 0x8f, 0x9b,
-	0x40, 0x97, 0xc4, 0x09, 0xf4, 0x73, 0x50, 0x34, 0xfd, 0x2e, 0xe3, 0x7b, 0x16, 0x4a, 0xf1, 0x9b,
-	0x15, 0x1f, 0x1c, 0x2b, 0x1b, 0xec, 0xd1, 0x6a, 0x23, 0x7a, 0xb4, 0xda, 0x70, 0x23, 0x84, 0x93,
-	0x80, 0x91, 0x02, 0x79, 0x4f, 0x98, 0x1d, 0xa3, 0xc7, 0x2a, 0xfe, 0xc2, 0xe0, 0xa5, 0x7b, 0xa6,
-	0x24, 0xf4, 0x4c, 0xe5, 0x16, 0x9c, 0x6e, 0x0c, 0xbd, 0xc0, 0x18, 0x84, 0x04, 0xca, 0x9f, 0xab,
-	0xae, 0x41, 0xa1, 0x47, 0x09, 0xdc, 0x8a, 0x25, 0x2e, 0x8f, 0xa3, 0xf8, 0xa6, 0xf2, 0x31, 0x58,
-	0xe6, 0xd3, 0x6f, 0xc4, 0xf8, 0x78, 0xfa, 0xd1, 0x2c, 0x1a, 0x71, 0x38, 0x2a, 0xf5, 0x76, 0xf6,
-	0x34, 0x2c, 0x8a, 0x64, 0x54, 0x81, 0x79, 0x8f, 0x26, 0x23, 0x7b, 0xeb, 0x28, 0x56, 0xe7, 0x9c,
-	0x88, 0xb0, 0x95, 0x07, 0xe9, 0x41, 0xab, 0xaf, 0xdc, 0x86, 0x02, 0xb3, 0x80, 0xf8, 0x92, 0x3c,
-	0x8b, 0x14, 0xa3, 0x07, 0x10, 0x04, 0xb9, 0xe1, 0xd1, 0xa0, 0xcd, 0xa7, 0x73, 0xfa, 0x9b, 0xa4,
-	0x2e, 0x7f, 0x14, 0x91, 0x28, 0x95, 0xaf, 0x94, 0x36, 0x40, 0x32, 0x69, 0xa0, 0xe7, 0x61, 0x39,
-	0x99, 0x35, 0x84, 0xf9, 0x66, 0x65, 0x62, 0x28, 0xa1, 0x17, 0x6e, 0x0c, 0x4c, 0x94, 0xb0, 0xcb,
-	0x14, 0xd5, 0x7b, 0xb6, 0x5a, 0xf7, 0x61, 0x41, 0xf8, 0xa8, 0x47, 0x65, 0x38, 0xdb, 0xb0, 0x76,
-	0x2d, 0xfb, 0x45, 0xab, 0xb9, 0xd5, 0x30, 0x4c, 0x1d, 0x3b, 0x4d, 0xf7, 0x4e, 0x0d, 0xcb, 0x73,
-	0x68, 0x1e, 0xa4, 0xdb, 0xc6, 0x96, 0x9c, 0x41, 0x25, 0xc8, 0x6f, 0xa9, 0x77, 0xb1, 0x29, 0x67,
-	0xd1, 0x32, 0x00, 0x45, 0xd5, 0x54, 0x6d, 0xb7, 0x2e, 0x4b, 0x08, 0xa0, 0xa0, 0x35, 0xea, 0xae,
-	0xbd, 0x27, 0xe7, 0xc8, 0xef, 0x5d, 0xd5, 0x32, 0x76, 0x6d, 0x39, 0x4f, 0x7e, 0xeb, 0xb6, 0xb6,
-	0x8b, 0x1d, 0xb9, 0xb0, 0xae, 0x43, 0x29, 0x7e, 0xc1, 0x40, 0xe7, 0x00, 0xa5, 0xd4, 0x45, 0xca,
-	0x16, 0x60, 0x5e, 0x33, 0x1b, 0x75, 0x17, 0x3b, 0x72, 0x86, 0x68, 0xde, 0xd1, 0xb6, 0xe4, 0x2c,
-	0xd1, 0x6c, 0xda, 0x9a, 0x6a, 0xca, 0xd2, 0xba, 0x4d, 0xc6, 0xcc, 0xe4, 0x1b, 0x1c, 0x5d, 0x80,
-	0x95, 0x48, 0x90, 0x8e, 0x6b, 0xa6, 0x7d, 0x27, 0x31, 0xbc, 0x08, 0xb9, 0x2a, 0x36, 0xf7, 0xe4,
-	0x0c, 0x5a, 0x82, 0xd2, 0x2e, 0x35, 0xcf, 0xb8, 0x8b, 0xe5, 0x2c, 0x51, 0xb2, 0xdb, 0xd8, 0xc2,
-	0x9a, 0x4b, 0x04, 0x1a, 0xb0, 0x20, 0xbc, 0x05, 0x88, 0x71, 0xe0, 0x86, 0x44, 0xe2, 0x16, 0xa1,
-	0xb8, 0x67, 0x58, 0x06, 0xe1, 0xe4, 0xb6, 0xed, 0x62, 0x66, 0x9b, 0xed, 0x56, 0xb1, 0x23, 0x4b,
-	0xeb, 0x6f, 0x2c, 0x00, 0x24, 0xa5, 0x0f, 0x15, 0x20, 0x6b, 0xef, 0xca, 0x73, 0xa8, 0x0c, 0x67,
-	0xea, 0xae, 0xea, 0x36, 0xea, 0x5a, 0x15, 0x6b, 0xbb, 0xcd, 0x7a, 0x43, 0xd3, 0x70, 0xbd, 0x2e,
-	0xff, 0x3a, 0x83, 0x10, 0x2c, 0x31, 0xef, 0x23, 0xda, 0x6f, 0x32, 0xe8, 0x0c, 0x2c, 0x33, 0x47,
-	0x62, 0xe2, 0x6f, 0x33, 0x68, 0x15, 0xca, 0x0c, 0x58, 0x6b, 0xd4, 0xab, 0x4d, 0x95, 0xd2, 0x9b,
-	0x3a, 0xb6, 0x0c, 0xac, 0xcb, 0x1e, 0xba, 0x08, 0xe7, 0xf9, 0xae, 0x63, 0xdf, 0xc6, 0x9a, 0xdb,
-	0xb4, 0x6c, 0xb7, 0xb9, 0x6d, 0x37, 0x2c, 0x5d, 0xde, 0x47, 0x8f, 0xc2, 0x65, 0xb6, 0xc9, 0x0e,
-	0xa2, 0xa9, 0xab, 0x78, 0xcf, 0xb6, 0x28, 0xc4, 0x69, 0x58, 0x96, 0x61, 0xed, 0xc8, 0x5d, 0x74,
-	0x19, 0x2a, 0xa2, 0x89, 0xc6, 0x9e, 0xba, 0x83, 0x9b, 0xb5, 0x86, 0x69, 0x36, 0xb1, 0xe3, 0xc8,
-	0x3f, 0xc8, 0xa2, 0x47, 0xe1, 0x92, 0x08, 0xd0, 0x6c, 0xcb, 0x55, 0x0d, 0x0b, 0x3b, 0x4d, 0xcd,
-	0xc1, 0xaa, 0x4b, 0x84, 0xfc, 0x30, 0x8b, 0x14, 0x78, 0x44, 0x04, 0x39, 0x0d, 0x4b, 0x00, 0x12,
-	0x41, 0x3f, 0xca, 0xa2, 0x6b, 0xb0, 0x36, 0x5d, 0x90, 0x8b, 0x9d, 0x3d, 0xc3, 0x52, 0x5d, 0xac,
-	0xcb, 0x3f, 0xce, 0xa2, 0x27, 0xe0, 0xba, 0x08, 0x63, 0x11, 0xd9, 0xc3, 0x96, 0xdb, 0x74, 0x6c,
-	0xd3, 0xb4, 0x1b, 0x6e, 0xb3, 0x86, 0x2d, 0x9d, 0xe8, 0xfd, 0xc9, 0x43, 0x64, 0x3a, 0xb8, 0xee,
-	0xaa, 0x0e, 0x35, 0xef, 0xed, 0x2c, 0xaa, 0xc0, 0x8a, 0x08, 0x6b, 0x58, 0x55, 0xac, 0x9a, 0x6e,
-	0xf5, 0x8e, 0xfc, 0xce, 0x84, 0x08, 0xcb, 0xd6, 0x71, 0x73, 0x0f, 0xef, 0xd9, 0xce, 0x9d, 0x66,
-	0xcd, 0xc1, 0xf5, 0x7a, 0xc3, 0xc1, 0xf2, 0x17, 0xa5, 0xf1, 0x30, 0x50, 0x98, 0x6e, 0xd4, 0x77,
-	0x13, 0xd0, 0x97, 0x24, 0xf4, 0x38, 0x5c, 0x9d, 0x00, 0x59, 0xd8, 0x7d, 0xd1, 0x76, 0x88, 0x52,
-	0xf5, 0x05, 0xd5, 0x30, 0xd5, 0x2d, 0x13, 0xcb, 0x5f, 0x96, 0xc6, 0x23, 0x46, 0xa1, 0x35, 0x43,
-	0x4f, 0xc4, 0xbd, 0x3a, 0x5d, 0x67, 0xc3, 0x22, 0x2b, 0xbd, 0xc1, 0x04, 0x7d, 0x45, 0x42, 0x57,
-	0x60, 0x75, 0x0a, 0xc8, 0xc1, 0xaa, 0x56, 0xa5, 0x90, 0xd7, 0xa4, 0xf1, 0x33, 0x66, 0x66, 0x91,
-	0x2c, 0xc0, 0xaa, 0x7e, 0x47, 0xfe, 0xea, 0x84, 0x31, 0xdb, 0xaa, 0x61, 0x62, 0xbd, 0xc9, 0x15,
-	0x91, 0x18, 0x7e, 0x4d, 0x42, 0x8f, 0x81, 0x22, 0x62, 0xf8, 0x35, 0x22, 0x21, 0xb7, 0xb0, 0xe6,
-	0x1a, 0xb6, 0x45, 0xcf, 0xf9, 0x1b, 0x13, 0x56, 0x47, 0x40, 0xe2, 0xdc, 0xae, 0x61, 0x9a, 0x58,
-	0x97, 0xbf, 0x39, 0x11, 0xa9, 0x58, 0x9a, 0x69, 0x90, 0x93, 0xde, 0xc6, 0xae, 0x56, 0xa5, 0xf2,
-	0xbe, 0x25, 0x8d, 0x1f, 0x90, 0x90, 0x10, 0x09, 0xec, 0xdb, 0x13, 0x71, 0xa8, 0xd9, 0x7a, 0xd3,
-	0xb0, 0x0c, 0xd7, 0x50, 0x4d, 0xe3, 0x2e, 0x71, 0xe1, 0x97, 0x12, 0xb9, 0x74, 0xd1, 0x0d, 0xc7,
-	0x8e, 0x63, 0x3b, 0xf2, 0xbb, 0xd2, 0xf8, 0x15, 0xe5, 0xfb, 0xf2, 0x7b, 0x12, 0xba, 0x0e, 0x57,
-	0xa6, 0xec, 0x8c, 0x1d, 0xc0, 0x5f, 0x24, 0xb4, 0x0e, 0xd7, 0xa6, 0xe7, 0xe0, 0x8b, 0xaa, 0x41,
-	0x12, 0x30, 0x96, 0xf9, 0x57, 0x09, 0x5d, 0x82, 0x0b, 0xd3, 0x64, 0xe2, 0x17, 0xb0, 0xe5, 0xca,
-	0xff, 0x96, 0x84, 0x12, 0x10, 0x31, 0xfd, 0x4d, 0x42, 0xa7, 0x61, 0xb1, 0x7e, 0xc7, 0xd2, 0x62,
-	0xd2, 0xdf, 0xa5, 0xa4, 0x7c, 0x44, 0xb4, 0x7f, 0x48, 0xe8, 0x2c, 0x9c, 0xd2, 0xf1, 0x0b, 0xc4,
-	0xe7, 0x98, 0xfa, 0x4f, 0x4a, 0xd5, 0x4c, 0xac, 0x5a, 0x8d, 0x5a, 0x4c, 0xfd, 0x17, 0xa5, 0x52,
-	0x91, 0x14, 0xcd, 0x62, 0xf1, 0xc7, 0x1c, 0x5a, 0x83, 0x8b, 0x91, 0x04, 0x07, 0xef, 0x18, 0xb4,
-	0x04, 0xf2, 0x0a, 0x82, 0x6b, 0x75, 0xf9, 0x8d, 0x3c, 0xc9, 0xa4, 0x09, 0x84, 0x8b, 0xeb, 0x2e,
-	0x03, 0xfc, 0x3c, 0x4f, 0x4e, 0x61, 0x02, 0xc0, 0x3d, 0xa2, 0x90, 0x37, 0xf3, 0x53, 0xb5, 0x68,
-	0xb6, 0xb5, 0x6d, 0xec, 0x10, 0x88, 0xfc, 0x8b, 0xfc, 0x78, 0xbe, 0x36, 0xea, 0x04, 0xa1, 0x5a,
-	0x1a, 0xa6, 0xd9, 0xf3, 0x7a, 0x61, 0x3c, 0x5f, 0x75, 0xac, 0xea, 0xa6, 0x61, 0xe1, 0x26, 0x7e,
-	0x49, 0xc3, 0x58, 0xc7, 0xba, 0xfc, 0x9d, 0x02, 0x71, 0x91, 0xd9, 0x9e, 0x70, 0x7e, 0xb7, 0x80,
-	0x56, 0x40, 0xe6, 0xe6, 0x24, 0xe4, 0xef, 0x15, 0xd6, 0x7f, 0x9f, 0x83, 0xe5, 0x74, 0x37, 0x25,
-	0x65, 0xde, 0x32, 0x4c, 0x79, 0x0e, 0x9d, 0x05, 0x59, 0xd5, 0x49, 0x08, 0xb6, 0xd5, 0x86, 0x49,
-	0x6c, 0xae, 0xd9, 0x72, 0x87, 0xb4, 0xb1, 0x48, 0xb9, 0x40, 0x27, 0x23, 0xe6, 0xda, 0x24, 0xbd,
-	0xb9, 0x63, 0xda, 0x5b, 0xaa, 0xc9, 0xdd, 0x94, 0xf7, 0xd1, 0x1a, 0xac, 0xee, 0x68, 0xa6, 0xdd,
-	0x88, 0x6b, 0xb3, 0xda, 0x70, 0xab, 0x7c, 0x9b, 0x5c, 0xfe, 0x2e, 0xe9, 0x6e, 0xd3, 0xb7, 0x5e,
-	0x26, 0x8d, 0x8a, 0xa9, 0xe0, 0x22, 0x78, 0xed, 0x97, 0x7b, 0xc9, 0x0e, 0x67, 0x8d, 0xca, 0xfc,
-	0xa7, 0xd0, 0x85, 0x68, 0x27, 0x49, 0x4f, 0xd3, 0xde, 0xa9, 0x93, 0xda, 0x5d, 0x81, 0x15, 0x5e,
-	0xb5, 0xb1, 0xaa, 0x1b, 0x16, 0xe9, 0x2f, 0x35, 0xc7, 0xde, 0xc2, 0xa4, 0x66, 0xc7, 0x7b, 0x09,
-	0x1b, 0xed, 0x10, 0xa4, 0x50, 0x5f, 0x81, 0x55, 0x55, 0xd7, 0x49, 0xb9, 0x9a, 0x59, 0x34, 0x2f,
-	0x43, 0x25, 0x05, 0x99, 0x28, 0x98, 0xd7, 0x60, 0x2d, 0x05, 0x98, 0x51, 0x2c, 0x2f, 0xc1, 0x85,
-	0x14, 0x6c, 0xbc, 0x50, 0x8e, 0xeb, 0x99, 0x28, 0x92, 0x8f, 0x40, 0x79, 0x0c, 0x90, 0x2a, 0x90,
-	0x17, 0xe1, 0x5c, 0xda, 0x0c, 0xb1, 0x38, 0x0a, 0xca, 0xa7, 0x16, 0xc6, 0x38, 0x46, 0x55, 0xbb,
-	0xee, 0x0a, 0xf5, 0x50, 0xfe, 0xba, 0xf4, 0xcc, 0x4f, 0xf3, 0x70, 0xaa, 0xce, 0xff, 0x6b, 0x5c,
-	0xf7, 0x82, 0x07, 0xbd, 0xb6, 0x87, 0x34, 0x28, 0xee, 0x78, 0x21, 0x7f, 0xd8, 0x9d, 0x18, 0xa4,
-	0xf1, 0xc1, 0x61, 0x78, 0x54, 0x49, 0xfd, 0x5f, 0x57, 0x39, 0xfd, 0xf9, 0xdf, 0xbd, 0xfd, 0x6a,
-	0x76, 0x01, 0x95, 0x36, 0x1f, 0x3c, 0xbd, 0x49, 0xe7, 0x54, 0xb4, 0x03, 0x45, 0x3a, 0x46, 0x9b,
-	0x7e, 0x17, 0x45, 0xcf, 0x49, 0xd1, 0xc4, 0x5e, 0x19, 0x27, 0x28, 0x2b, 0x54, 0xc0, 0x29, 0xb4,
-	0x44, 0x04, 0xb0, 0x97, 0xbb, 0xbe, 0xdf, 0xbd, 0x91, 0x79, 0x2a, 0x83, 0x76, 0xa0, 0x40, 0x05,
-	0x0d, 0x67, 0xda, 0x32, 0x21, 0x0d, 0x51, 0x69, 0x8b, 0x08, 0x62, 0x69, 0xc3, 0xa7, 0x32, 0xe8,
-	0x25, 0x98, 0xc7, 0x9f, 0xf1, 0xda, 0xa3, 0xd0, 0x43, 0x65, 0xce, 0x31, 0x31, 0xc2, 0x57, 0x66,
-	0xe8, 0x50, 0x2e, 0x52, 0x91, 0x2b, 0xca, 0x02, 0x15, 0xc9, 0xc4, 0xdc, 0xe2, 0x03, 0x3d, 0x6a,
-	0x41, 0x49, 0x1d, 0x85, 0x3e, 0x1d, 0x21, 0xd1, 0x4a, 0x7a, 0x78, 0x3f, 0x4e, 0xf0, 0x35, 0x2a,
-	0xf8, 0x72, 0xe5, 0x1c, 0x11, 0x4c, 0xe7, 0xf1, 0xcd, 0xd6, 0x28, 0xf4, 0x9b, 0x91, 0x0e, 0x36,
-	0xf6, 0xa3, 0x26, 0x14, 0x89, 0x0a, 0xf2, 0xf9, 0x7c, 0x52, 0x0d, 0x57, 0xa9, 0x86, 0x4b, 0x95,
-	0x15, 0x7a, 0x38, 0x47, 0x83, 0xf6, 0x54, 0x05, 0x6d, 0x00, 0xa2, 0x80, 0x0d, 0xb0, 0x27, 0x55,
-	0x71, 0x9d, 0xaa, 0x58, 0xab, 0x9c, 0x27, 0x2a, 0xd8, 0x97, 0xc2, 0x54, 0x25, 0x26, 0x14, 0xaa,
-	0xad, 0x41, 0xa7, 0xef, 0xa1, 0xd4, 0xa7, 0xd6, 0x4c, 0xb9, 0xab, 0x54, 0xee, 0x39, 0xe5, 0x74,
-	0x72, 0x90, 0x9b, 0x2f, 0x53, 0x01, 0xb7, 0x32, 0xeb, 0xf7, 0x0a, 0x14, 0x7d, 0xf3, 0x3f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa3, 0xe7, 0x74, 0x82, 0xf7, 0x20, 0x00, 0x00,
+	// 3113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x8c, 0x1b, 0xc7,
+	0x95, 0x1e, 0xb2, 0x49, 0x0e, 0xf9, 0xe6, 0x47, 0xad, 0xd2, 0x8c, 0x44, 0x51, 0x63, 0x69, 0xd4,
+	0x96, 0x64, 0x79, 0xec, 0x1d, 0xd9, 0xd6, 0x62, 0xe1, 0xd5, 0xda, 0x6b, 0xf4, 0xb0, 0x4b, 0xc3,
+	0xf6, 0xf4, 0x34, 0xb9, 0xc5, 0xa6, 0x65, 0x09, 0x58, 0x10, 0x2d, 0xb2, 0x87, 0xe2, 0x8a, 0x43,
+	0x72, 0x9b, 0x4d, 0x39, 0x93, 0x43, 0x0e, 0xb9, 0xe6, 0x92, 0xc4, 0x71, 0xfe, 0x0f, 0x4e, 0x82,
+	0xdc, 0x12, 0x27, 0xd7, 0x20, 0x70, 0x1c, 0x20, 0x08, 0xf2, 0x73, 0x0d, 0x12, 0x20, 0xa7, 0x20,
+	0x80, 0x7d, 0xc8, 0xdd, 0xce, 0x7f, 0x80, 0xa0, 0x7e, 0xba, 0xbb, 0x9a, 0x3f, 0x1a, 0xcb, 0x41,
+	0x90, 0x93, 0x58, 0xaf, 0xbe, 0xf7, 0xbd, 0x57, 0xaf, 0x5e, 0xbd, 0x7a, 0x5d, 0x1a, 0x58, 0x1d,
+	0xdd, 0x77, 0x0f, 0x0e, 0x06, 0xbd, 0xf6, 0xf6, 0xd0, 0x1f, 0x04, 0x03, 0x94, 0x65, 0xff, 0x94,
+	0x36, 0x3a, 0x83, 0x41, 0xa7, 0xe7, 0x5d, 0x73, 0x87, 0xdd, 0x6b, 0x6e, 0xbf, 0x3f, 0x08, 0xdc,
+	0xa0, 0x3b, 0xe8, 0x8f, 0x38, 0xa8, 0x74, 0x41, 0xcc, 0xb2, 0xd1, 0xdd, 0xf1, 0xc1, 0xb5, 0xa0,
+	0x7b, 0xe8, 0x8d, 0x02, 0xf7, 0x70, 0x28, 0x00, 0xe7, 0x26, 0x01, 0xde, 0xe1, 0x30, 0x38, 0xe2,
+	0x93, 0xda, 0x75, 0x58, 0xa9, 0x07, 0x6e, 0xe0, 0x11, 0x6f, 0x34, 0x1c, 0xf4, 0x47, 0x1e, 0xd2,
+	0x20, 0x3b, 0xa2, 0x82, 0x62, 0x6a, 0x33, 0x75, 0x75, 0xe9, 0xb9, 0x65, 0x8e, 0xdb, 0xe6, 0x20,
+	0x3e, 0xa5, 0x6d, 0x40, 0x3e, 0xc2, 0xab, 0xa0, 0x1c, 0x8e, 0x3a, 0x0c, 0x5d, 0x20, 0xf4, 0xa7,
+	0xf6, 0x18, 0x2c, 0x12, 0xef, 0xff, 0xc7, 0xde, 0x28, 0x40, 0x08, 0x32, 0x7d, 0xf7, 0xd0, 0x13,
+	0xb3, 0xec, 0xb7, 0xf6, 0x46, 0x06, 0xb2, 0x8c, 0x0d, 0x3d, 0x0b, 0x70, 0x77, 0xdc, 0xed, 0xb5,
+	0xeb, 0x92, 0xbd, 0x93, 0xc2, 0xde, 0x4e, 0x34, 0x41, 0x24, 0x10, 0xfa, 0x77, 0x58, 0x6a, 0x7b,
+	0xc3, 0xde, 0xe0, 0x88, 0xeb, 0xa4, 0x99, 0x0e, 0x12, 0x3a, 0x46, 0x3c, 0x43, 0x64, 0x18, 0xaa,
+	0xc0, 0xea, 0xc1, 0xc0, 0x7f, 0xcd, 0xf5, 0xdb, 0x5e, 0xbb, 0x36, 0xf0, 0x83, 0x51, 0x31, 0xb3,
+	0xa9, 0x5c, 0x5d, 0x7a, 0x6e, 0x53, 0x5e, 0xdc, 0xf6, 0xcd, 0x04, 0x04, 0xf7, 0x03, 0xff, 0x88,
+	0x4c, 0xe8, 0xa1, 0x32, 0xa8, 0x34, 0x04, 0xe3, 0x51, 0xf9, 0x9e, 0xd7, 0xba, 0xcf, 0x9d, 0xc8,
+	0x32, 0x27, 0xce, 0x48, 0x5c, 0xf2, 0x34, 0x99, 0x52, 0x40, 0x37, 0x60, 0xe5, 0xa0, 0xdb, 0xf3,
+	0xea, 0x47, 0xfd, 0x16, 0x67, 0xc8, 0x31, 0x86, 0x35, 0xc1, 0x70, 0x53, 0x9e, 0x23, 0x49, 0x28,
+	0xaa, 0xc1, 0xa9, 0xb6, 0x77, 0x77, 0xdc, 0xe9, 0x74, 0xfb, 0x9d, 0xf2, 0xa0, 0x1f, 0xb8, 0xdd,
+	0xbe, 0xe7, 0x8f, 0x8a, 0x8b, 0x6c, 0x3d, 0xe7, 0xa3, 0x40, 0x4c, 0x22, 0xf0, 0x03, 0xaf, 0x1f,
+	0x90, 0x59, 0xaa, 0xe8, 0x29, 0xc8, 0x1f, 0x7a, 0x81, 0xdb, 0x76, 0x03, 0xb7, 0x98, 0x67, 0x8e,
+	0x9c, 0x10, 0x34, 0xfb, 0x42, 0x4c, 0x22, 0x40, 0xa9, 0x0e, 0xa7, 0x66, 0x84, 0x89, 0x26, 0xc1,
+	0x7d, 0xef, 0x88, 0x6d, 0x61, 0x96, 0xd0, 0x9f, 0xe8, 0x0a, 0x64, 0x1f, 0xb8, 0xbd, 0x71, 0xb8,
+	0x45, 0xaa, 0xa0, 0xa4, 0x3a, 0xdc, 0x17, 0x3e, 0x7d, 0x23, 0xfd, 0x7c, 0xea, 0xe5, 0x4c, 0x5e,
+	0x51, 0x33, 0xda, 0x7b, 0x29, 0xc8, 0x87, 0x16, 0xd1, 0x16, 0x64, 0xd9, 0xae, 0x17, 0x53, 0x89,
+	0xd0, 0xb0, 0xac, 0x88, 0xdc, 0xe2, 0x10, 0xf4, 0x6f, 0x90, 0xe3, 0x9b, 0x2d, 0x6c, 0xad, 0x27,
+	0xd2, 0x21, 0x42, 0x0b, 0x10, 0x7a, 0x09, 0xc0, 0x6d, 0xb7, 0xbb, 0xf4, 0x08, 0xb9, 0xbd, 0x62,
+	0x8b, 0x05, 0xee, 0xc2, 0xc4, 0x8a, 0xb7, 0xf5, 0x08, 0xc1, 0xf3, 0x40, 0x52, 0x29, 0xbd, 0x08,
+	0x27, 0x26, 0xa6, 0xe5, 0xf5, 0x17, 0xf8, 0xfa, 0xd7, 0xe4, 0xf5, 0x17, 0xa4, 0xd5, 0x6a, 0x1f,
+	0xa4, 0x61, 0x25, 0xb1, 0x0e, 0xf4, 0x34, 0x9c, 0xec, 0x8f, 0x0f, 0xef, 0x7a, 0x7e, 0xf5, 0x40,
+	0xf7, 0x83, 0xee, 0x81, 0xdb, 0x0a, 0x46, 0x22, 0x96, 0xd3, 0x13, 0xe8, 0x45, 0xc8, 0xb3, 0x75,
+	0xd3, 0x6d, 0x4f, 0x33, 0xef, 0x2f, 0xce, 0x8a, 0xce, 0xb6, 0x79, 0xe8, 0x76, 0xbc, 0x1d, 0x8e,
+	0x24, 0x91, 0x0a, 0xba, 0x04, 0x99, 0xe0, 0x68, 0xe8, 0x15, 0x95, 0xcd, 0xd4, 0xd5, 0xd5, 0x68,
+	0x5f, 0x18, 0xce, 0x39, 0x1a, 0x7a, 0x84, 0xcd, 0x22, 0x63, 0x46, 0x90, 0x2e, 0xcd, 0x34, 0xf3,
+	0xb0, 0x48, 0x59, 0xb0, 0x2c, 0x7b, 0x81, 0xae, 0x08, 0xdb, 0x29, 0x66, 0x1b, 0xc9, 0x7c, 0x9e,
+	0x2f, 0x59, 0x5f, 0x83, 0x6c, 0x6b, 0x30, 0xee, 0x07, 0x2c, 0x78, 0x59, 0xc2, 0x07, 0xff, 0x68,
+	0xdc, 0x7f, 0x92, 0x82, 0xd5, 0x64, 0x4a, 0xa0, 0x17, 0xa0, 0xc0, 0x93, 0x82, 0xc6, 0x32, 0x35,
+	0x71, 0x84, 0x64, 0xa4, 0x18, 0x7a, 0x3e, 0x89, 0x15, 0xd0, 0xd3, 0xb0, 0xd8, 0xea, 0x8d, 0x47,
+	0x81, 0xe7, 0x17, 0xd3, 0x89, 0x05, 0x95, 0xb9, 0x94, 0x2d, 0x28, 0x84, 0x94, 0x4c, 0xc8, 0x87,
+	0x24, 0xe8, 0x89, 0x44, 0x1c, 0x4e, 0x25, 0x4c, 0x1e, 0x1f, 0x08, 0xed, 0x37, 0x29, 0x80, 0xb8,
+	0x3e, 0xa2, 0xff, 0x86, 0x82, 0x2b, 0xa5, 0x8d, 0x5c, 0xd8, 0x62, 0xd4, 0x76, 0x94, 0x40, 0x7c,
+	0x9b, 0x62, 0x15, 0xb4, 0x09, 0x4b, 0xee, 0x38, 0x18, 0x38, 0x7e, 0xb7, 0xd3, 0x11, 0x6b, 0xc9,
+	0x13, 0x59, 0x44, 0x0b, 0xb5, 0x28, 0x62, 0x83, 0x76, 0x98, 0x39, 0x27, 0x93, 0xf5, 0x6e, 0xd0,
+	0xf6, 0x88, 0x04, 0x2a, 0xbd, 0x00, 0xab, 0x49, 0x8b, 0x8f, 0xb4, 0x57, 0x1f, 0x87, 0x25, 0xa9,
+	0x98, 0xa3, 0xd3, 0x90, 0xe3, 0xd4, 0x42, 0x5b, 0x8c, 0xfe, 0x29, 0x9e, 0x6b, 0xbf, 0x4d, 0x81,
+	0x3a, 0x59, 0xc4, 0xe7, 0x7a, 0x60, 0x40, 0xc1, 0xf7, 0x46, 0x83, 0xb1, 0xdf, 0xf2, 0xc2, 0xd3,
+	0x78, 0x65, 0xce, 0x45, 0xb0, 0x4d, 0x42, 0xa0, 0xd8, 0x81, 0x48, 0xf1, 0x23, 0xc6, 0x37, 0xc9,
+	0xf7, 0x48, 0xf1, 0x35, 0x61, 0x25, 0x71, 0xcb, 0x7c, 0xf4, 0x08, 0x6b, 0x3f, 0xce, 0x41, 0x96,
+	0x55, 0x74, 0xf4, 0x0c, 0x14, 0xe8, 0x3d, 0xc1, 0x06, 0xa2, 0x6e, 0xab, 0x52, 0x5d, 0x65, 0xf2,
+	0xca, 0x02, 0x89, 0x41, 0xe8, 0xba, 0x68, 0x00, 0xb8, 0x4a, 0x7a, 0xba, 0x01, 0x08, 0x75, 0x24,
+	0x18, 0xfa, 0x8f, 0xb0, 0x05, 0xe0, 0x5a, 0xca, 0x8c, 0x16, 0x20, 0x54, 0x93, 0x81, 0xd4, 0xbd,
+	0x61, 0x78, 0xfb, 0x14, 0x33, 0xb3, 0x6f, 0x25, 0xea, 0x5e, 0x04, 0x42, 0x38, 0x71, 0xd9, 0x73,
+	0xc5, 0xb9, 0x97, 0x7d, 0xa8, 0x3f, 0xa5, 0x82, 0xfe, 0x17, 0x8a, 0xe1, 0x56, 0x4f, 0xe2, 0xc5,
+	0xcd, 0x1f, 0x5e, 0x3f, 0x64, 0x0e, 0xac, 0xb2, 0x40, 0xe6, 0x52, 0xa0, 0x17, 0xe2, 0x6e, 0x82,
+	0x73, 0x2e, 0xce, 0xec, 0x26, 0x42, 0xa2, 0x24, 0x18, 0xdd, 0x81, 0x33, 0xed, 0xd9, 0xdd, 0x82,
+	0x68, 0x06, 0x8e, 0xe9, 0x29, 0x2a, 0x0b, 0x64, 0x1e, 0x01, 0xfa, 0x4f, 0x58, 0x6e, 0x7b, 0x0f,
+	0xac, 0xc1, 0x60, 0xc8, 0x09, 0x0b, 0x8c, 0x30, 0x2e, 0x77, 0xf1, 0x54, 0x65, 0x81, 0x24, 0xa0,
+	0xe8, 0x7f, 0x60, 0xad, 0xd5, 0x1b, 0x8c, 0xdb, 0x64, 0xdc, 0xaf, 0x7b, 0xfe, 0x83, 0x6e, 0xcb,
+	0xe3, 0x14, 0xc0, 0x28, 0xce, 0x45, 0x85, 0x76, 0x1a, 0x52, 0x59, 0x20, 0x33, 0x55, 0x91, 0x0d,
+	0xa7, 0x82, 0xee, 0x61, 0xb7, 0xdf, 0x19, 0xd5, 0xc7, 0x87, 0x87, 0xae, 0x2f, 0xf2, 0x67, 0x89,
+	0x31, 0x96, 0x04, 0xa3, 0x33, 0x8d, 0xa8, 0x2c, 0x90, 0x59, 0x8a, 0xe8, 0x25, 0x58, 0x75, 0x87,
+	0x5d, 0x6a, 0x22, 0x0c, 0xd8, 0x72, 0xa2, 0xfd, 0xd0, 0x6b, 0xa6, 0x34, 0x59, 0x59, 0x20, 0x13,
+	0xf0, 0x9d, 0x65, 0x00, 0x8f, 0xfe, 0x68, 0xd2, 0x52, 0xaf, 0xf5, 0x60, 0x59, 0x8e, 0x08, 0xda,
+	0x80, 0x42, 0x37, 0xf0, 0x7c, 0xd6, 0xea, 0x8b, 0x66, 0x20, 0x16, 0x48, 0xe7, 0x35, 0x9d, 0x38,
+	0xaf, 0x57, 0x40, 0xf1, 0x7c, 0xbf, 0xa8, 0x24, 0x52, 0x40, 0x6f, 0x51, 0x1d, 0xf7, 0x6e, 0xcf,
+	0xc3, 0xbe, 0x4f, 0x28, 0x40, 0xfb, 0x54, 0x0a, 0x56, 0x12, 0x62, 0xf4, 0x14, 0x2c, 0x7a, 0xbe,
+	0xcf, 0x0a, 0x50, 0x6a, 0x5e, 0x01, 0x0a, 0x11, 0xa8, 0x08, 0x8b, 0x87, 0xde, 0x68, 0xe4, 0x76,
+	0xc2, 0xda, 0x12, 0x0e, 0xd1, 0x75, 0x58, 0x1a, 0x8d, 0x3b, 0x1d, 0x6f, 0x44, 0xb9, 0x47, 0x45,
+	0x85, 0x95, 0xc4, 0x88, 0x2a, 0x9a, 0x21, 0x32, 0x4a, 0xb3, 0xa1, 0x10, 0x55, 0x08, 0x5a, 0xb5,
+	0x3c, 0x5a, 0xd0, 0x44, 0x25, 0xe2, 0x83, 0x44, 0x97, 0x9a, 0x3e, 0xa6, 0x4b, 0xd5, 0xbe, 0x1f,
+	0x5e, 0x90, 0x9c, 0xb1, 0x04, 0xf9, 0xf0, 0xb6, 0x13, 0xa4, 0xd1, 0x78, 0x6e, 0x20, 0xd5, 0x38,
+	0x90, 0x05, 0x16, 0x32, 0x39, 0x40, 0x99, 0x63, 0x03, 0x74, 0x03, 0x56, 0x5c, 0x39, 0xbc, 0xc5,
+	0xec, 0x43, 0x76, 0x24, 0x09, 0xd5, 0xde, 0x4c, 0x85, 0xb7, 0x1f, 0x77, 0x7f, 0x5e, 0x6d, 0x16,
+	0x2e, 0xa6, 0x67, 0xba, 0xa8, 0x3c, 0xba, 0x8b, 0x99, 0x0f, 0xef, 0xe2, 0x3b, 0xc9, 0x3b, 0xf2,
+	0xe1, 0x7e, 0xce, 0x4f, 0x96, 0x7f, 0x61, 0x90, 0x7f, 0x97, 0x82, 0xe2, 0xbc, 0x72, 0x4b, 0x13,
+	0x26, 0x2c, 0xb7, 0x61, 0xc2, 0x84, 0xe3, 0xb9, 0x09, 0x23, 0xad, 0x52, 0x99, 0xb9, 0xca, 0x4c,
+	0xbc, 0xca, 0xe4, 0x7d, 0x9f, 0xfd, 0x10, 0xf7, 0xfd, 0xf4, 0x5a, 0x73, 0x1f, 0x7e, 0xad, 0xdf,
+	0x4c, 0x43, 0x21, 0xba, 0xe2, 0x68, 0x61, 0xe9, 0x0d, 0x5a, 0x6e, 0x8f, 0x4a, 0xc2, 0xc2, 0x12,
+	0x09, 0xd0, 0x79, 0x00, 0xdf, 0x3b, 0x1c, 0x04, 0x1e, 0x9b, 0xe6, 0x6d, 0xa7, 0x24, 0xa1, 0xcb,
+	0x1c, 0x0e, 0xda, 0xb6, 0x7b, 0x18, 0x2d, 0x53, 0x0c, 0xd1, 0x25, 0x58, 0x69, 0x85, 0xf5, 0x9f,
+	0xcd, 0xf3, 0x05, 0x27, 0x85, 0xd4, 0x3a, 0x7d, 0x05, 0x18, 0x0d, 0xdd, 0x16, 0x5f, 0x79, 0x81,
+	0xc4, 0x02, 0x1a, 0x78, 0x7a, 0xfd, 0x32, 0xf5, 0x1c, 0x0f, 0x7c, 0x38, 0x46, 0x1a, 0x2c, 0x87,
+	0x9b, 0x40, 0x3b, 0x64, 0x76, 0xcd, 0x15, 0x48, 0x42, 0x26, 0x63, 0x18, 0x47, 0x3e, 0x89, 0x61,
+	0x3c, 0x45, 0x58, 0x74, 0xdb, 0x6d, 0xdf, 0x1b, 0x8d, 0xd8, 0x85, 0x54, 0x20, 0xe1, 0x50, 0xfb,
+	0x55, 0x2a, 0x6e, 0x8b, 0xa2, 0x58, 0xd1, 0xeb, 0xb2, 0xcc, 0x7a, 0x70, 0x11, 0xab, 0x48, 0x40,
+	0x2b, 0x55, 0xf7, 0x30, 0x4e, 0x6b, 0x3e, 0x90, 0x12, 0x44, 0x99, 0x75, 0x5c, 0x33, 0x33, 0x93,
+	0x3d, 0xfb, 0xe8, 0xc9, 0xfe, 0x08, 0x09, 0xf0, 0x7e, 0x1a, 0xce, 0xcc, 0xb9, 0xbf, 0x1f, 0x76,
+	0x6a, 0xc3, 0x8d, 0x4e, 0x1f, 0xb3, 0xd1, 0xca, 0xb1, 0x1b, 0x9d, 0x99, 0xb1, 0xd1, 0x51, 0x49,
+	0xce, 0x4e, 0x94, 0xe4, 0x22, 0x2c, 0xfa, 0xe3, 0x3e, 0x7d, 0xc5, 0x12, 0x39, 0x10, 0x0e, 0x69,
+	0x72, 0xbe, 0x36, 0xf0, 0xef, 0x77, 0xfb, 0x1d, 0xa3, 0xeb, 0x8b, 0x04, 0x90, 0x24, 0xc8, 0x06,
+	0x60, 0xbd, 0x08, 0x7f, 0xe3, 0xc9, 0xb3, 0xbb, 0x67, 0xfb, 0xe1, 0xfd, 0xcb, 0xb6, 0x11, 0x29,
+	0x88, 0xef, 0xd7, 0x98, 0x81, 0x7e, 0x71, 0x4e, 0x4c, 0x1f, 0xd7, 0x65, 0xaf, 0xc8, 0x5d, 0xf6,
+	0x0e, 0xac, 0xcd, 0xea, 0x50, 0xe8, 0x02, 0x47, 0x7c, 0x2c, 0x78, 0xc2, 0x21, 0x65, 0x1f, 0xfb,
+	0xbd, 0xb0, 0xa4, 0x8f, 0xfd, 0x9e, 0xa6, 0xc3, 0xa9, 0x19, 0x3d, 0x09, 0xda, 0x82, 0xdc, 0xf0,
+	0x9e, 0x3b, 0xf2, 0xc2, 0x0f, 0xbe, 0xb0, 0xff, 0xad, 0x51, 0x21, 0x57, 0x20, 0x02, 0xa1, 0xdd,
+	0x82, 0x25, 0x49, 0x4c, 0xfd, 0x65, 0x13, 0xe1, 0xfd, 0xca, 0x06, 0x74, 0x43, 0xda, 0x63, 0xd1,
+	0x6d, 0x70, 0xf3, 0xd1, 0x98, 0xa6, 0xc8, 0x81, 0xdb, 0xed, 0x79, 0x6d, 0xb6, 0xd3, 0x79, 0x22,
+	0x46, 0x5a, 0x05, 0x56, 0x93, 0x4d, 0x0e, 0x65, 0xe9, 0xf8, 0xc3, 0x96, 0x54, 0x5a, 0xa2, 0x31,
+	0x9d, 0xbb, 0x17, 0x04, 0x43, 0xa9, 0xae, 0x44, 0x63, 0xed, 0x13, 0x90, 0xb7, 0x06, 0x1d, 0x1e,
+	0xe1, 0xe7, 0xa1, 0x10, 0xbd, 0x60, 0x8a, 0xcf, 0x88, 0xd2, 0x36, 0x7f, 0xc2, 0xdc, 0x0e, 0x9f,
+	0x30, 0xb7, 0x9d, 0x10, 0x41, 0x62, 0x30, 0x7d, 0xba, 0xf4, 0xa4, 0x2f, 0x89, 0xf0, 0xe9, 0x52,
+	0xbc, 0x37, 0x79, 0xc9, 0xee, 0x42, 0x91, 0xba, 0x0b, 0xed, 0x06, 0x9c, 0x6c, 0x8c, 0x3c, 0xdf,
+	0xec, 0x07, 0x14, 0x2a, 0x1e, 0x2f, 0x2f, 0x43, 0xae, 0xcb, 0x04, 0xc2, 0x8b, 0x15, 0xc1, 0x27,
+	0x50, 0x62, 0x52, 0xfb, 0x2f, 0x58, 0x15, 0xdf, 0x42, 0xa1, 0xe2, 0x93, 0xc9, 0x27, 0xd4, 0xb0,
+	0xe1, 0x15, 0xa8, 0xc4, 0x4b, 0xea, 0xb3, 0xb0, 0x2c, 0x8b, 0x51, 0x09, 0x16, 0x3d, 0x76, 0x6c,
+	0xf9, 0xcb, 0x57, 0xbe, 0xb2, 0x40, 0x42, 0xc1, 0x4e, 0x16, 0x94, 0x07, 0x6e, 0x4f, 0xbb, 0x07,
+	0x39, 0xee, 0x01, 0x5d, 0x4b, 0xfc, 0x48, 0x96, 0x0f, 0x9f, 0xc3, 0x10, 0x64, 0x46, 0x47, 0xfd,
+	0x96, 0xf8, 0x56, 0x63, 0xbf, 0xe9, 0x0e, 0x8a, 0x27, 0x32, 0xb1, 0x83, 0x7c, 0x44, 0x0f, 0x69,
+	0xfc, 0x74, 0x40, 0xdf, 0x44, 0x0b, 0xd2, 0xc3, 0x80, 0xd6, 0x02, 0x88, 0x3b, 0x36, 0xf4, 0x22,
+	0xac, 0xc6, 0x3d, 0x9b, 0xd4, 0x27, 0xae, 0x4f, 0x35, 0x77, 0x74, 0x92, 0x4c, 0x80, 0xa9, 0x0b,
+	0xbc, 0x28, 0x85, 0xf7, 0x26, 0x1f, 0x6d, 0x0d, 0x60, 0x49, 0x7a, 0x00, 0x42, 0x45, 0x58, 0x6b,
+	0xd8, 0x7b, 0x76, 0xf5, 0x96, 0xdd, 0xdc, 0x69, 0x98, 0x96, 0x81, 0x49, 0xd3, 0xb9, 0x5d, 0xc3,
+	0xea, 0x02, 0x5a, 0x04, 0xe5, 0x65, 0x73, 0x47, 0x4d, 0xa1, 0x02, 0x64, 0x77, 0xf4, 0x3b, 0xd8,
+	0x52, 0xd3, 0x68, 0x15, 0x80, 0xa1, 0x6a, 0x7a, 0x79, 0xaf, 0xae, 0x2a, 0x08, 0x20, 0x57, 0x6e,
+	0xd4, 0x9d, 0xea, 0xbe, 0x9a, 0xa1, 0xbf, 0xf7, 0x74, 0xdb, 0xdc, 0xab, 0xaa, 0x59, 0xfa, 0xdb,
+	0xa8, 0x96, 0xf7, 0x30, 0x51, 0x73, 0x5b, 0x06, 0x14, 0xa2, 0xd7, 0x2e, 0x74, 0x1a, 0x50, 0xc2,
+	0x5c, 0x68, 0x6c, 0x09, 0x16, 0xcb, 0x56, 0xa3, 0xee, 0x60, 0xa2, 0xa6, 0xa8, 0xe5, 0xdd, 0xf2,
+	0x8e, 0x9a, 0xa6, 0x96, 0xad, 0x6a, 0x59, 0xb7, 0x54, 0x65, 0xab, 0x4a, 0xdb, 0xf5, 0xf8, 0xbd,
+	0x06, 0x9d, 0x85, 0xf5, 0x90, 0xc8, 0xc0, 0x35, 0xab, 0x7a, 0x3b, 0x76, 0x3c, 0x0f, 0x99, 0x0a,
+	0xb6, 0xf6, 0xd5, 0x14, 0x5a, 0x81, 0xc2, 0x1e, 0x73, 0xcf, 0xbc, 0x83, 0xd5, 0x34, 0x35, 0xb2,
+	0xd7, 0xd8, 0xc1, 0x65, 0x87, 0x12, 0x9a, 0xb0, 0x24, 0xbd, 0x1b, 0xc9, 0x71, 0x10, 0x8e, 0x84,
+	0x74, 0xcb, 0x90, 0xdf, 0x37, 0x6d, 0x93, 0x6a, 0x0a, 0xdf, 0xf6, 0x30, 0xf7, 0xad, 0xea, 0x54,
+	0x30, 0x51, 0x95, 0xad, 0xb7, 0x97, 0x00, 0xe2, 0x2b, 0x04, 0xe5, 0x20, 0x5d, 0xdd, 0x53, 0x17,
+	0x50, 0x11, 0x4e, 0xd5, 0x1d, 0xdd, 0x69, 0xd4, 0xcb, 0x15, 0x5c, 0xde, 0x6b, 0xd6, 0x1b, 0xe5,
+	0x32, 0xae, 0xd7, 0xd5, 0x9f, 0xa6, 0x10, 0x82, 0x15, 0xbe, 0xfa, 0x50, 0xf6, 0xb3, 0x14, 0x3a,
+	0x05, 0xab, 0x7c, 0x21, 0x91, 0xf0, 0xe7, 0x29, 0xb4, 0x01, 0x45, 0x0e, 0xac, 0x35, 0xea, 0x95,
+	0xa6, 0xce, 0xe4, 0x4d, 0x03, 0xdb, 0x26, 0x36, 0x54, 0x0f, 0x9d, 0x83, 0x33, 0x62, 0x96, 0x54,
+	0x5f, 0xc6, 0x65, 0xa7, 0x69, 0x57, 0x9d, 0xe6, 0xcd, 0x6a, 0xc3, 0x36, 0xd4, 0x03, 0xf4, 0x38,
+	0x5c, 0xe0, 0x93, 0x7c, 0x23, 0x9a, 0x86, 0x8e, 0xf7, 0xab, 0x36, 0x83, 0x90, 0x86, 0x6d, 0x9b,
+	0xf6, 0xae, 0xda, 0x41, 0x17, 0xa0, 0x24, 0xbb, 0x68, 0xee, 0xeb, 0xbb, 0xb8, 0x59, 0x6b, 0x58,
+	0x56, 0x13, 0x13, 0xa2, 0x7e, 0x2b, 0x8d, 0x1e, 0x87, 0xf3, 0x32, 0xa0, 0x5c, 0xb5, 0x1d, 0xdd,
+	0xb4, 0x31, 0x69, 0x96, 0x09, 0xd6, 0x1d, 0x4a, 0xf2, 0xed, 0x34, 0xd2, 0xe0, 0x31, 0x19, 0x44,
+	0x1a, 0xb6, 0x04, 0xa4, 0x44, 0x6f, 0xa5, 0xd1, 0x65, 0xd8, 0x9c, 0x4d, 0xe4, 0x60, 0xb2, 0x6f,
+	0xda, 0xba, 0x83, 0x0d, 0xf5, 0x3b, 0x69, 0xf4, 0x14, 0x5c, 0x91, 0x61, 0x3c, 0x22, 0xfb, 0xd8,
+	0x76, 0x9a, 0xa4, 0x6a, 0x59, 0xd5, 0x86, 0xd3, 0xac, 0x61, 0xdb, 0xa0, 0x76, 0xbf, 0xfb, 0x10,
+	0x4e, 0x82, 0xeb, 0x8e, 0x4e, 0x98, 0x7b, 0xef, 0xa6, 0x51, 0x09, 0xd6, 0x65, 0x58, 0xc3, 0xae,
+	0x60, 0xdd, 0x72, 0x2a, 0xb7, 0xd5, 0xf7, 0xa6, 0x28, 0xec, 0xaa, 0x81, 0x9b, 0xfb, 0x78, 0xbf,
+	0x4a, 0x6e, 0x37, 0x6b, 0x04, 0xd7, 0xeb, 0x0d, 0x82, 0xd5, 0x4f, 0x2b, 0x93, 0x61, 0x60, 0x30,
+	0xc3, 0xac, 0xef, 0xc5, 0xa0, 0xcf, 0x28, 0xe8, 0x49, 0xb8, 0x34, 0x05, 0xb2, 0xb1, 0x73, 0xab,
+	0x4a, 0xa8, 0x51, 0xfd, 0x15, 0xdd, 0xb4, 0xf4, 0x1d, 0x0b, 0xab, 0x9f, 0x55, 0x26, 0x23, 0xc6,
+	0xa0, 0x35, 0xd3, 0x88, 0xe9, 0x5e, 0x9f, 0x6d, 0xb3, 0x61, 0xd3, 0x91, 0xd1, 0xe0, 0x44, 0x9f,
+	0x53, 0xd0, 0x45, 0xd8, 0x98, 0x01, 0x22, 0x58, 0x2f, 0x57, 0x18, 0xe4, 0x0d, 0x65, 0x72, 0x8f,
+	0xb9, 0x5b, 0x34, 0x0b, 0xb0, 0x6e, 0xdc, 0x56, 0x3f, 0x3f, 0xe5, 0xcc, 0x4d, 0xdd, 0xb4, 0xb0,
+	0xd1, 0x14, 0x86, 0x68, 0x0c, 0xbf, 0xa0, 0xa0, 0x27, 0x40, 0x93, 0x31, 0xe2, 0x18, 0xd1, 0x90,
+	0xdb, 0xb8, 0xec, 0x98, 0x55, 0x9b, 0xed, 0xf3, 0x97, 0xa6, 0xbc, 0x0e, 0x81, 0x74, 0x71, 0x7b,
+	0xa6, 0x65, 0x61, 0x43, 0xfd, 0xf2, 0x54, 0xa4, 0x22, 0x36, 0xcb, 0xa4, 0x3b, 0x7d, 0x13, 0x3b,
+	0xe5, 0x0a, 0xe3, 0xfb, 0x8a, 0x32, 0xb9, 0x41, 0x52, 0x42, 0xc4, 0xb0, 0xaf, 0x4e, 0xc5, 0xa1,
+	0x56, 0x35, 0x9a, 0xa6, 0x6d, 0x3a, 0xa6, 0x6e, 0x99, 0x77, 0xe8, 0x12, 0x7e, 0xa4, 0xd0, 0x43,
+	0x17, 0x9e, 0x70, 0x4c, 0x48, 0x95, 0xa8, 0xef, 0x2b, 0x93, 0x47, 0x54, 0xcc, 0xab, 0x1f, 0x28,
+	0xe8, 0x0a, 0x5c, 0x9c, 0x31, 0x33, 0xb1, 0x01, 0xbf, 0x57, 0xd0, 0x16, 0x5c, 0x9e, 0x9d, 0x83,
+	0xb7, 0x74, 0x93, 0x26, 0x60, 0xc4, 0xf9, 0x07, 0x05, 0x9d, 0x87, 0xb3, 0xb3, 0x38, 0xf1, 0x2b,
+	0xd8, 0x76, 0xd4, 0xbf, 0x29, 0x52, 0x09, 0x08, 0x95, 0xfe, 0xa8, 0xa0, 0x93, 0xb0, 0x5c, 0xbf,
+	0x6d, 0x97, 0x23, 0xd1, 0x9f, 0x94, 0xb8, 0x7c, 0x84, 0xb2, 0x3f, 0x2b, 0x68, 0x0d, 0x4e, 0x18,
+	0xf8, 0x15, 0xba, 0xe6, 0x48, 0xfa, 0x17, 0x26, 0x2d, 0x5b, 0x58, 0xb7, 0x1b, 0xb5, 0x48, 0xfa,
+	0x57, 0x26, 0x65, 0x94, 0x0c, 0xcd, 0x63, 0xf1, 0xeb, 0x0c, 0xda, 0x84, 0x73, 0x21, 0x03, 0xc1,
+	0xbb, 0x26, 0x2b, 0x81, 0xa2, 0x82, 0xe0, 0x5a, 0x5d, 0x7d, 0x3b, 0x4b, 0x33, 0x69, 0x0a, 0xe1,
+	0xe0, 0xba, 0xc3, 0x01, 0x3f, 0xc8, 0xd2, 0x5d, 0x98, 0x02, 0x88, 0x15, 0x31, 0xc8, 0x3b, 0xd9,
+	0x99, 0x56, 0xca, 0x55, 0xfb, 0xa6, 0xb9, 0x4b, 0x21, 0xea, 0x0f, 0xb3, 0x93, 0xf9, 0xda, 0xa8,
+	0x53, 0x84, 0x6e, 0x97, 0x31, 0xcb, 0x9e, 0x37, 0x73, 0x93, 0xf9, 0x6a, 0x60, 0xdd, 0xb0, 0x4c,
+	0x1b, 0x37, 0xf1, 0xab, 0x65, 0x8c, 0x0d, 0x6c, 0xa8, 0x5f, 0xcb, 0xd1, 0x25, 0x72, 0xdf, 0x63,
+	0xcd, 0xaf, 0xe7, 0xd0, 0x3a, 0xa8, 0xc2, 0x9d, 0x58, 0xfc, 0x8d, 0xdc, 0xd6, 0x2f, 0x33, 0xb0,
+	0x9a, 0xbc, 0x4d, 0x69, 0x99, 0xb7, 0x4d, 0x4b, 0x5d, 0x40, 0x6b, 0xa0, 0xea, 0x06, 0x0d, 0xc1,
+	0x4d, 0xbd, 0x61, 0x51, 0x9f, 0x6b, 0x55, 0xb5, 0x4d, 0xaf, 0xb1, 0xd0, 0xb8, 0x24, 0xa7, 0xad,
+	0xfa, 0xe6, 0xb4, 0xbc, 0xb9, 0x6b, 0x55, 0x77, 0x74, 0x4b, 0x2c, 0x53, 0x3d, 0x40, 0x9b, 0xb0,
+	0xb1, 0x5b, 0xb6, 0xaa, 0x8d, 0xa8, 0x36, 0xeb, 0x0d, 0xa7, 0x22, 0xa6, 0xe9, 0xe1, 0xef, 0xd0,
+	0xdb, 0x6d, 0xf6, 0xd4, 0x3d, 0x7a, 0x51, 0x71, 0x13, 0x82, 0x42, 0xd4, 0x7e, 0xb5, 0x1b, 0xcf,
+	0x08, 0xd5, 0xb0, 0xcc, 0xff, 0x1f, 0x3a, 0x0b, 0x6b, 0x93, 0xe9, 0x69, 0x55, 0x77, 0xeb, 0xb4,
+	0x76, 0x97, 0x60, 0x9d, 0x4f, 0xd1, 0x72, 0x60, 0xda, 0xf4, 0x7e, 0xa9, 0x91, 0xea, 0x0e, 0x56,
+	0xdf, 0x92, 0xe6, 0x62, 0x35, 0x76, 0x43, 0xd0, 0x42, 0x7d, 0x11, 0x36, 0x74, 0xc3, 0xa0, 0xe5,
+	0x6a, 0x6e, 0xd1, 0xbc, 0x00, 0xa5, 0x04, 0x64, 0xaa, 0x60, 0x5e, 0x86, 0xcd, 0x04, 0x60, 0x4e,
+	0xb1, 0x3c, 0x0f, 0x67, 0x13, 0xb0, 0xc9, 0x42, 0x39, 0x69, 0x67, 0xaa, 0x48, 0x3e, 0x06, 0xc5,
+	0x09, 0x40, 0xa2, 0x40, 0x9e, 0x83, 0xd3, 0x49, 0x37, 0xe4, 0xe2, 0x28, 0x19, 0x9f, 0x59, 0x18,
+	0xa3, 0x18, 0x55, 0xaa, 0x75, 0x47, 0xaa, 0x87, 0xea, 0x17, 0x95, 0xe7, 0xbe, 0x97, 0x85, 0x13,
+	0x75, 0xf1, 0x17, 0x06, 0xe2, 0x83, 0x04, 0x95, 0x21, 0xbf, 0xeb, 0x05, 0xe2, 0x3f, 0x01, 0xa6,
+	0xda, 0x6c, 0x4c, 0xff, 0x52, 0xa0, 0x94, 0xf8, 0x1b, 0x00, 0xed, 0xe4, 0x27, 0x7f, 0xf1, 0xee,
+	0xeb, 0xe9, 0x25, 0x54, 0xb8, 0xf6, 0xe0, 0xd9, 0x6b, 0xac, 0x8b, 0x45, 0xbb, 0x90, 0x67, 0x4d,
+	0xb6, 0x35, 0xe8, 0xa0, 0xf0, 0x59, 0x2e, 0xec, 0xe7, 0x4b, 0x93, 0x02, 0x6d, 0x9d, 0x11, 0x9c,
+	0x40, 0x2b, 0x94, 0x80, 0xbf, 0x80, 0xf6, 0x06, 0x9d, 0xab, 0xa9, 0x67, 0x52, 0x68, 0x17, 0x72,
+	0x8c, 0x68, 0x34, 0xd7, 0x97, 0x29, 0x36, 0xc4, 0xd8, 0x96, 0x11, 0x44, 0x6c, 0xa3, 0x67, 0x52,
+	0xe8, 0x55, 0x58, 0xc4, 0x1f, 0xf3, 0x5a, 0xe3, 0xc0, 0x43, 0x45, 0xa1, 0x31, 0xd5, 0xe0, 0x97,
+	0xe6, 0xd8, 0xd0, 0xce, 0x31, 0xca, 0x75, 0x6d, 0x89, 0x51, 0x72, 0x9a, 0x1b, 0xa2, 0xdd, 0x47,
+	0x2e, 0x14, 0xf4, 0x71, 0x30, 0x60, 0x2d, 0x24, 0x5a, 0x4f, 0xb6, 0xf6, 0xc7, 0x11, 0x5f, 0x66,
+	0xc4, 0x17, 0x4a, 0xa7, 0x29, 0x31, 0xeb, 0xd6, 0xaf, 0xd1, 0xff, 0x4a, 0x69, 0x86, 0x36, 0xf8,
+	0x47, 0x01, 0x6a, 0x42, 0x9e, 0x9a, 0xa0, 0xcf, 0x10, 0x8f, 0x6a, 0xe1, 0x12, 0xb3, 0x70, 0xbe,
+	0xb4, 0xce, 0x36, 0xe7, 0xa8, 0xdf, 0x9a, 0x69, 0xa0, 0x05, 0x40, 0x0d, 0xf0, 0x06, 0xf6, 0x51,
+	0x4d, 0x5c, 0x61, 0x26, 0x36, 0x4b, 0x67, 0xa8, 0x09, 0xfe, 0x1d, 0x31, 0xd3, 0x88, 0x05, 0xb9,
+	0x8a, 0xdb, 0x6f, 0xf7, 0x3c, 0x94, 0xf8, 0x10, 0x9b, 0xcb, 0xbb, 0xc1, 0x78, 0x4f, 0x6b, 0x27,
+	0xe3, 0x8d, 0xbc, 0x76, 0x8f, 0x11, 0xdc, 0x48, 0x6d, 0xdd, 0xcd, 0x31, 0xf4, 0xf5, 0xbf, 0x0f,
+	0x00, 0x5b, 0x60, 0x2a, 0x73, 0x23, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.